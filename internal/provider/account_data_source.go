@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &AccountDataSource{}
+)
+
+func NewAccountDataSource() datasource.DataSource {
+	return &AccountDataSource{}
+}
+
+type AccountDataSource struct {
+	client *Client
+}
+
+type AccountDataSourceModel struct {
+	Name           types.String `tfsdk:"name"`
+	Subdomain      types.String `tfsdk:"subdomain"`
+	URL            types.String `tfsdk:"url"`
+	OwnerID        types.String `tfsdk:"owner_id"`
+	IsSandbox      types.Bool   `tfsdk:"is_sandbox"`
+	IsMultiproduct types.Bool   `tfsdk:"is_multiproduct"`
+	PlanName       types.String `tfsdk:"plan_name"`
+	PlanType       types.String `tfsdk:"plan_type"`
+	MaxAgents      types.Int64  `tfsdk:"max_agents"`
+	AgentCount     types.Int64  `tfsdk:"agent_count"`
+	BrandCount     types.Int64  `tfsdk:"brand_count"`
+	HasCustomRoles types.Bool   `tfsdk:"has_custom_roles"`
+	HasMultibrand  types.Bool   `tfsdk:"has_multibrand"`
+	HasChat        types.Bool   `tfsdk:"has_chat"`
+	HasTalk        types.Bool   `tfsdk:"has_talk"`
+	HasGuide       types.Bool   `tfsdk:"has_guide"`
+	HasExplore     types.Bool   `tfsdk:"has_explore"`
+}
+
+func (d *AccountDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account"
+}
+
+func (d *AccountDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads what the Zendesk account is and what it can do, so that modules can branch on the plan and enabled products instead of failing on 403 responses.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The name of the account.",
+				Computed:    true,
+			},
+			"subdomain": schema.StringAttribute{
+				Description: "The subdomain of the account.",
+				Computed:    true,
+			},
+			"url": schema.StringAttribute{
+				Description: "The URL of the account.",
+				Computed:    true,
+			},
+			"owner_id": schema.StringAttribute{
+				Description: "The ID of the account owner.",
+				Computed:    true,
+			},
+			"is_sandbox": schema.BoolAttribute{
+				Description: "Whether the account is a sandbox.",
+				Computed:    true,
+			},
+			"is_multiproduct": schema.BoolAttribute{
+				Description: "Whether the account was created without Zendesk Support, e.g. a Chat-only account.",
+				Computed:    true,
+			},
+			"plan_name": schema.StringAttribute{
+				Description: "The name of the plan. Null when the subscription is not visible to the authenticated user.",
+				Computed:    true,
+			},
+			"plan_type": schema.StringAttribute{
+				Description: "The type of the plan. Null when the subscription is not visible to the authenticated user.",
+				Computed:    true,
+			},
+			"max_agents": schema.Int64Attribute{
+				Description: "The number of agent seats of the plan. Null when the subscription is not visible to the authenticated user.",
+				Computed:    true,
+			},
+			"agent_count": schema.Int64Attribute{
+				Description: "The number of agents and admins of the account.",
+				Computed:    true,
+			},
+			"brand_count": schema.Int64Attribute{
+				Description: "The number of brands of the account.",
+				Computed:    true,
+			},
+			"has_custom_roles": schema.BoolAttribute{
+				Description: "Whether the plan includes custom agent roles.",
+				Computed:    true,
+			},
+			"has_multibrand": schema.BoolAttribute{
+				Description: "Whether the multibrand feature is active or the account already has more than one brand.",
+				Computed:    true,
+			},
+			"has_chat": schema.BoolAttribute{
+				Description: "Whether Zendesk Chat is enabled.",
+				Computed:    true,
+			},
+			"has_talk": schema.BoolAttribute{
+				Description: "Whether Zendesk Talk is enabled.",
+				Computed:    true,
+			},
+			"has_guide": schema.BoolAttribute{
+				Description: "Whether any brand has a Help Center.",
+				Computed:    true,
+			},
+			"has_explore": schema.BoolAttribute{
+				Description: "Whether Zendesk Explore is enabled.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *AccountDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AccountDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state AccountDataSourceModel
+
+	account, err := d.client.ReadAccount(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Account",
+			fmt.Sprintf("Could not read account: %v", err),
+		)
+		return
+	}
+
+	settings, err := d.client.ReadAccountSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Account",
+			fmt.Sprintf("Could not read account settings: %v", err),
+		)
+		return
+	}
+
+	subscription, err := d.client.ReadAccountSubscription(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Account",
+			fmt.Sprintf("Could not read account subscription: %v", err),
+		)
+		return
+	}
+
+	agentCount, err := d.client.CountAgents(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Account",
+			fmt.Sprintf("Could not count agents: %v", err),
+		)
+		return
+	}
+
+	brands, err := d.client.ListBrands(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Account",
+			fmt.Sprintf("Could not list brands: %v", err),
+		)
+		return
+	}
+
+	hasCustomRoles, err := d.client.HasCustomRoles(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Account",
+			fmt.Sprintf("Could not check for custom roles: %v", err),
+		)
+		return
+	}
+
+	feature := func(name string) bool {
+		enabled, _ := settings["active_features"][name].(bool)
+		return enabled
+	}
+
+	hasGuide := false
+	for _, brand := range brands {
+		hasGuide = hasGuide || brand.HasHelpCenter
+	}
+
+	state.Name = types.StringValue(account.Name)
+	state.Subdomain = types.StringValue(account.Subdomain)
+	state.URL = types.StringValue(account.URL)
+	state.OwnerID = types.StringValue(strconv.FormatInt(account.OwnerID, 10))
+	state.IsSandbox = types.BoolValue(account.Sandbox)
+	state.IsMultiproduct = types.BoolValue(account.Multiproduct)
+	state.PlanName = types.StringNull()
+	state.PlanType = types.StringNull()
+	state.MaxAgents = types.Int64Null()
+	if subscription != nil {
+		state.PlanName = types.StringValue(subscription.PlanName)
+		state.PlanType = types.StringValue(subscription.PlanType)
+		state.MaxAgents = types.Int64Value(subscription.MaxAgents)
+	}
+	state.AgentCount = types.Int64Value(agentCount)
+	state.BrandCount = types.Int64Value(int64(len(brands)))
+	state.HasCustomRoles = types.BoolValue(hasCustomRoles)
+	state.HasMultibrand = types.BoolValue(feature("multibrand") || len(brands) > 1)
+	state.HasChat = types.BoolValue(feature("chat"))
+	state.HasTalk = types.BoolValue(feature("voice"))
+	state.HasGuide = types.BoolValue(hasGuide)
+	state.HasExplore = types.BoolValue(feature("explore"))
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
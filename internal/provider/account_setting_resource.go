@@ -0,0 +1,320 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &AccountSettingResource{}
+	_ resource.ResourceWithImportState = &AccountSettingResource{}
+)
+
+// accountSettingsID is the fixed ID of the account settings, which are a singleton.
+const accountSettingsID = "account_settings"
+
+func NewAccountSettingResource() resource.Resource {
+	return &AccountSettingResource{}
+}
+
+type AccountSettingResource struct {
+	client *Client
+}
+
+type AccountSettingResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Tickets      types.Map    `tfsdk:"tickets"`
+	Agents       types.Map    `tfsdk:"agents"`
+	API          types.Map    `tfsdk:"api"`
+	Brands       types.Map    `tfsdk:"brands"`
+	Localization types.Map    `tfsdk:"localization"`
+	User         types.Map    `tfsdk:"user"`
+	Groups       types.Map    `tfsdk:"groups"`
+	Rule         types.Map    `tfsdk:"rule"`
+}
+
+// sections returns the section attributes of the model, keyed by API section name.
+func (m *AccountSettingResourceModel) sections() map[string]*types.Map {
+	return map[string]*types.Map{
+		"tickets":      &m.Tickets,
+		"agents":       &m.Agents,
+		"api":          &m.API,
+		"brands":       &m.Brands,
+		"localization": &m.Localization,
+		"user":         &m.User,
+		"groups":       &m.Groups,
+		"rule":         &m.Rule,
+	}
+}
+
+func (r *AccountSettingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_setting"
+}
+
+func (r *AccountSettingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	sectionAttribute := func(section string) schema.MapAttribute {
+		return schema.MapAttribute{
+			Description: fmt.Sprintf("The %s settings to manage, keyed by setting name. Values are strings, as for the account settings data source; nested values are encoded as JSON.", section),
+			Optional:    true,
+			ElementType: types.StringType,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages selected settings of the Zendesk account. Only the settings declared are managed; the others are left unchanged.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: fmt.Sprintf("Always %q, as the account has a single set of settings.", accountSettingsID),
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tickets":      sectionAttribute("ticket"),
+			"agents":       sectionAttribute("agent"),
+			"api":          sectionAttribute("API"),
+			"brands":       sectionAttribute("brand"),
+			"localization": sectionAttribute("localization"),
+			"user":         sectionAttribute("user"),
+			"groups":       sectionAttribute("group"),
+			"rule":         sectionAttribute("business rule"),
+		},
+	}
+}
+
+func (r *AccountSettingResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AccountSettingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AccountSettingResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *AccountSettingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AccountSettingResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.ReadAccountSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Account Settings",
+			fmt.Sprintf("Could not read account settings: %v", err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flattenAccountSettings(ctx, settings, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *AccountSettingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan AccountSettingResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete leaves the settings unchanged, as there is nothing to reset them to.
+func (r *AccountSettingResource) Delete(_ context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.AddWarning(
+		"Account Settings Left Unchanged",
+		"Destroying zendesk_account_setting only removes it from the Terraform state. The account settings keep their current values.",
+	)
+}
+
+// ImportState reads all the settings of the supported sections, so that the first plan shows the
+// current value of every setting declared in the configuration.
+func (r *AccountSettingResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	settings, err := r.client.ReadAccountSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Account Settings",
+			fmt.Sprintf("Could not read account settings: %v", err),
+		)
+		return
+	}
+
+	state := AccountSettingResourceModel{ID: types.StringValue(accountSettingsID)}
+	for name, section := range state.sections() {
+		value, diags := stringMapValue(ctx, settings[name])
+		resp.Diagnostics.Append(diags...)
+		*section = value
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// apply sends the declared settings and refreshes them from the response.
+func (r *AccountSettingResource) apply(ctx context.Context, model *AccountSettingResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	current, err := r.client.ReadAccountSettings(ctx)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Account Settings",
+			fmt.Sprintf("Could not read account settings: %v", err),
+		)
+		return diags
+	}
+
+	settings, d := expandAccountSettings(ctx, *model, current)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	updated, err := r.client.UpdateAccountSettings(ctx, settings)
+	if err != nil {
+		diags.AddError(
+			"Error Updating Account Settings",
+			fmt.Sprintf("Could not update account settings: %v", err),
+		)
+		return diags
+	}
+
+	model.ID = types.StringValue(accountSettingsID)
+	diags.Append(flattenAccountSettings(ctx, updated, model)...)
+	return diags
+}
+
+// expandAccountSettings converts the declared settings back to the JSON type of their current
+// value, so that e.g. "true" is sent as a boolean. Unknown settings are reported as errors.
+func expandAccountSettings(ctx context.Context, model AccountSettingResourceModel, current AccountSettings) (AccountSettings, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	settings := AccountSettings{}
+
+	for name, section := range model.sections() {
+		if section.IsNull() || section.IsUnknown() {
+			continue
+		}
+
+		values := map[string]string{}
+		diags.Append(section.ElementsAs(ctx, &values, false)...)
+
+		keys := make([]string, 0, len(values))
+		for key := range values {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		settings[name] = make(map[string]interface{}, len(values))
+		for _, key := range keys {
+			currentValue, ok := current[name][key]
+			if !ok {
+				diags.AddAttributeError(
+					path.Root(name).AtMapKey(key),
+					"Unknown Account Setting",
+					fmt.Sprintf("The account has no %s setting %q. The zendesk_account_settings data source lists the available settings.", name, key),
+				)
+				continue
+			}
+
+			value, err := accountSettingValue(values[key], currentValue)
+			if err != nil {
+				diags.AddAttributeError(
+					path.Root(name).AtMapKey(key),
+					"Invalid Account Setting",
+					fmt.Sprintf("Invalid value for the %s setting %q: %v", name, key, err),
+				)
+				continue
+			}
+			settings[name][key] = value
+		}
+	}
+
+	return settings, diags
+}
+
+// accountSettingValue converts value to the JSON type of current.
+func accountSettingValue(value string, current interface{}) (interface{}, error) {
+	switch current.(type) {
+	case bool:
+		return strconv.ParseBool(value)
+	case float64:
+		return strconv.ParseFloat(value, 64)
+	case string, nil:
+		return value, nil
+	default:
+		var decoded interface{}
+		if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+			return nil, fmt.Errorf("expected a JSON value: %w", err)
+		}
+		return decoded, nil
+	}
+}
+
+// flattenAccountSettings only refreshes the declared settings; see flattenManagedFields.
+func flattenAccountSettings(ctx context.Context, settings AccountSettings, model *AccountSettingResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for name, section := range model.sections() {
+		if section.IsNull() || section.IsUnknown() {
+			continue
+		}
+
+		value, d := flattenManagedFields(ctx, *section, settings[name])
+		diags.Append(d...)
+		*section = value
+	}
+
+	return diags
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &AccountSettingsDataSource{}
+)
+
+func NewAccountSettingsDataSource() datasource.DataSource {
+	return &AccountSettingsDataSource{}
+}
+
+type AccountSettingsDataSource struct {
+	client *Client
+}
+
+type AccountSettingsDataSourceModel struct {
+	Tickets        types.Map `tfsdk:"tickets"`
+	Agents         types.Map `tfsdk:"agents"`
+	API            types.Map `tfsdk:"api"`
+	Brands         types.Map `tfsdk:"brands"`
+	Localization   types.Map `tfsdk:"localization"`
+	ActiveFeatures types.Map `tfsdk:"active_features"`
+}
+
+func (d *AccountSettingsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_settings"
+}
+
+func (d *AccountSettingsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	sectionAttribute := func(section string) schema.MapAttribute {
+		return schema.MapAttribute{
+			Description: fmt.Sprintf("The %s settings, keyed by setting name. Nested values are encoded as JSON.", section),
+			Computed:    true,
+			ElementType: types.StringType,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Reads the settings of the Zendesk account.",
+		Attributes: map[string]schema.Attribute{
+			"tickets":      sectionAttribute("ticket"),
+			"agents":       sectionAttribute("agent"),
+			"api":          sectionAttribute("API"),
+			"brands":       sectionAttribute("brand"),
+			"localization": sectionAttribute("localization"),
+			"active_features": schema.MapAttribute{
+				Description: "Whether each account feature is enabled, keyed by feature name.",
+				Computed:    true,
+				ElementType: types.BoolType,
+			},
+		},
+	}
+}
+
+func (d *AccountSettingsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AccountSettingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state AccountSettingsDataSourceModel
+
+	settings, err := d.client.ReadAccountSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Account Settings",
+			fmt.Sprintf("Could not read account settings: %v", err),
+		)
+		return
+	}
+
+	sections := map[string]*types.Map{
+		"tickets":      &state.Tickets,
+		"agents":       &state.Agents,
+		"api":          &state.API,
+		"brands":       &state.Brands,
+		"localization": &state.Localization,
+	}
+	for name, target := range sections {
+		value, diags := stringMapValue(ctx, settings[name])
+		resp.Diagnostics.Append(diags...)
+		*target = value
+	}
+
+	features := make(map[string]bool, len(settings["active_features"]))
+	for name, value := range settings["active_features"] {
+		if enabled, ok := value.(bool); ok {
+			features[name] = enabled
+		}
+	}
+
+	activeFeatures, diags := types.MapValueFrom(ctx, types.BoolType, features)
+	resp.Diagnostics.Append(diags...)
+	state.ActiveFeatures = activeFeatures
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var (
+	_ function.Function = &AdminObjectURLFunction{}
+)
+
+func NewAdminObjectURLFunction() function.Function {
+	return &AdminObjectURLFunction{}
+}
+
+type AdminObjectURLFunction struct{}
+
+func (f *AdminObjectURLFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "admin_object_url"
+}
+
+func (f *AdminObjectURLFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Builds the Admin Center URL of a Zendesk object.",
+		Description: "Returns the Admin Center deep link of a trigger, view, or other configuration object.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "subdomain",
+				Description: "The Zendesk subdomain (e.g., company in company.zendesk.com).",
+			},
+			function.StringParameter{
+				Name:        "type",
+				Description: fmt.Sprintf("The object type, one of: %s.", strings.Join(adminObjectTypes(), ", ")),
+			},
+			function.StringParameter{
+				Name:        "id",
+				Description: "The ID of the object.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *AdminObjectURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var subdomain, objectType, id string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &subdomain, &objectType, &id))
+	if resp.Error != nil {
+		return
+	}
+
+	if err := validateSubdomain(subdomain); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	if err := validateAdminObjectType(objectType); err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	result, err := adminObjectURL(subdomain, objectType, id)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(2, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &AgentStatusResource{}
+	_ resource.ResourceWithImportState = &AgentStatusResource{}
+)
+
+func NewAgentStatusResource() resource.Resource {
+	return &AgentStatusResource{}
+}
+
+type AgentStatusResource struct {
+	client *Client
+}
+
+type AgentStatusResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	StatusCategory types.String `tfsdk:"status_category"`
+	AgentLabel     types.String `tfsdk:"agent_label"`
+	EndUserLabel   types.String `tfsdk:"end_user_label"`
+	Description    types.String `tfsdk:"description"`
+	Active         types.Bool   `tfsdk:"active"`
+	Default        types.Bool   `tfsdk:"default"`
+}
+
+func (r *AgentStatusResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_agent_status"
+}
+
+func (r *AgentStatusResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Zendesk custom status, with the labels agents and end users see. Custom statuses cannot be deleted: destroying the resource deactivates the status.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the custom status.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status_category": schema.StringAttribute{
+				Description: "The category of the status: 'new', 'open', 'pending', 'hold' or 'solved'. Changing it replaces the status.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("new", "open", "pending", "hold", "solved"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"agent_label": schema.StringAttribute{
+				Description: "The label agents see.",
+				Required:    true,
+			},
+			"end_user_label": schema.StringAttribute{
+				Description: "The label end users see. Defaults to a label chosen by Zendesk for the category.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the status, shown to agents.",
+				Optional:    true,
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether the status can be used. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"default": schema.BoolAttribute{
+				Description: "Whether this is the default status of its category. Default statuses cannot be deactivated.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AgentStatusResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AgentStatusResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AgentStatusResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	status, err := r.client.CreateCustomStatus(ctx, expandAgentStatus(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Agent Status",
+			fmt.Sprintf("Could not create custom status: %v", err),
+		)
+		return
+	}
+
+	flattenAgentStatus(status, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *AgentStatusResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AgentStatusResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Agent Status ID",
+			fmt.Sprintf("Could not parse custom status ID: %v", err),
+		)
+		return
+	}
+
+	status, err := r.client.ReadCustomStatus(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Agent Status",
+			fmt.Sprintf("Could not read custom status: %v", err),
+		)
+		return
+	}
+
+	if status == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	flattenAgentStatus(status, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *AgentStatusResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan AgentStatusResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(plan.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Agent Status ID",
+			fmt.Sprintf("Could not parse custom status ID: %v", err),
+		)
+		return
+	}
+
+	status, err := r.client.UpdateCustomStatus(ctx, id, expandAgentStatus(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Agent Status",
+			fmt.Sprintf("Could not update custom status: %v", err),
+		)
+		return
+	}
+
+	flattenAgentStatus(status, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deactivates the status, as Zendesk cannot delete custom statuses. Default statuses
+// cannot be deactivated either and are only removed from the state.
+func (r *AgentStatusResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AgentStatusResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Default.ValueBool() {
+		resp.Diagnostics.AddWarning(
+			"Default Status Left Active",
+			fmt.Sprintf("%q is the default %s status, which cannot be deactivated. It was only removed from the Terraform state.", state.AgentLabel.ValueString(), state.StatusCategory.ValueString()),
+		)
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Agent Status ID",
+			fmt.Sprintf("Could not parse custom status ID: %v", err),
+		)
+		return
+	}
+
+	status := expandAgentStatus(state)
+	status.Active = false
+
+	_, err = r.client.UpdateCustomStatus(ctx, id, status)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deactivating Agent Status",
+			fmt.Sprintf("Could not deactivate custom status: %v", err),
+		)
+		return
+	}
+}
+
+func (r *AgentStatusResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func expandAgentStatus(model AgentStatusResourceModel) CustomStatus {
+	return CustomStatus{
+		StatusCategory: model.StatusCategory.ValueString(),
+		AgentLabel:     model.AgentLabel.ValueString(),
+		EndUserLabel:   model.EndUserLabel.ValueString(),
+		Description:    model.Description.ValueString(),
+		Active:         model.Active.ValueBool(),
+	}
+}
+
+func flattenAgentStatus(status *CustomStatus, model *AgentStatusResourceModel) {
+	model.ID = types.StringValue(strconv.FormatInt(status.ID, 10))
+	model.StatusCategory = types.StringValue(status.StatusCategory)
+	model.AgentLabel = types.StringValue(status.AgentLabel)
+	model.EndUserLabel = types.StringValue(status.EndUserLabel)
+	model.Description = optionalStringValue(status.Description)
+	model.Active = types.BoolValue(status.Active)
+	model.Default = types.BoolValue(status.Default)
+}
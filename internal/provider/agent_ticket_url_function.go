@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var (
+	_ function.Function = &AgentTicketURLFunction{}
+)
+
+func NewAgentTicketURLFunction() function.Function {
+	return &AgentTicketURLFunction{}
+}
+
+type AgentTicketURLFunction struct{}
+
+func (f *AgentTicketURLFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "agent_ticket_url"
+}
+
+func (f *AgentTicketURLFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Builds the Agent Workspace URL of a ticket.",
+		Description: "Returns https://<subdomain>.zendesk.com/agent/tickets/<id>. The ID may be a Liquid placeholder such as {{ticket.id}} for use in notification bodies.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "subdomain",
+				Description: "The Zendesk subdomain (e.g., company in company.zendesk.com).",
+			},
+			function.StringParameter{
+				Name:        "id",
+				Description: "The ID of the ticket, or a placeholder such as {{ticket.id}}.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *AgentTicketURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var subdomain, id string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &subdomain, &id))
+	if resp.Error != nil {
+		return
+	}
+
+	if err := validateSubdomain(subdomain); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result, err := agentTicketURL(subdomain, id)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,231 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &APITokenResource{}
+	_ resource.ResourceWithImportState = &APITokenResource{}
+)
+
+func NewAPITokenResource() resource.Resource {
+	return &APITokenResource{}
+}
+
+type APITokenResource struct {
+	client *Client
+}
+
+type APITokenResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Description      types.String `tfsdk:"description"`
+	FullToken        types.String `tfsdk:"full_token"`
+	RotationTriggers types.Map    `tfsdk:"rotation_triggers"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+}
+
+func (r *APITokenResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_token"
+}
+
+func (r *APITokenResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Zendesk API token. Destroying the resource revokes the token.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the API token.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the API token.",
+				Required:    true,
+			},
+			"full_token": schema.StringAttribute{
+				Description: "The full API token value (only available after creation, not after import).",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rotation_triggers": schema.MapAttribute{
+				Description: "Arbitrary values that replace the token when changed, e.g. a rotation date.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "When the API token was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *APITokenResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *APITokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan APITokenResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := r.client.CreateAPIToken(ctx, plan.Description.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating API Token",
+			fmt.Sprintf("Could not create API token: %v", err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(strconv.FormatInt(token.ID, 10))
+	plan.FullToken = types.StringValue(token.Token)
+	flattenAPIToken(token, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *APITokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state APITokenResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing API Token ID",
+			fmt.Sprintf("Could not parse API token ID: %v", err),
+		)
+		return
+	}
+
+	token, err := r.client.ReadAPIToken(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading API Token",
+			fmt.Sprintf("Could not read API token: %v", err),
+		)
+		return
+	}
+
+	if token == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	flattenAPIToken(token, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *APITokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan APITokenResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(plan.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing API Token ID",
+			fmt.Sprintf("Could not parse API token ID: %v", err),
+		)
+		return
+	}
+
+	token, err := r.client.UpdateAPIToken(ctx, id, plan.Description.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating API Token",
+			fmt.Sprintf("Could not update API token: %v", err),
+		)
+		return
+	}
+
+	flattenAPIToken(token, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *APITokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state APITokenResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing API Token ID",
+			fmt.Sprintf("Could not parse API token ID: %v", err),
+		)
+		return
+	}
+
+	if err := r.client.DeleteAPIToken(ctx, id); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting API Token",
+			fmt.Sprintf("Could not revoke API token: %v", err),
+		)
+		return
+	}
+}
+
+// ImportState imports the token by ID. Zendesk never returns the token value again, so
+// full_token stays null after import.
+func (r *APITokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// flattenAPIToken leaves full_token untouched, as Zendesk only returns it on creation.
+func flattenAPIToken(token *APIToken, model *APITokenResourceModel) {
+	model.Description = types.StringValue(token.Description)
+	model.CreatedAt = types.StringValue(token.CreatedAt)
+}
@@ -0,0 +1,246 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const auditLogsDefaultMaxResults = 1000
+
+var (
+	_ datasource.DataSource = &AuditLogsDataSource{}
+)
+
+func NewAuditLogsDataSource() datasource.DataSource {
+	return &AuditLogsDataSource{}
+}
+
+type AuditLogsDataSource struct {
+	client *Client
+}
+
+type AuditLogsDataSourceModel struct {
+	ActorID       types.String         `tfsdk:"actor_id"`
+	SourceType    types.String         `tfsdk:"source_type"`
+	Action        types.String         `tfsdk:"action"`
+	CreatedAfter  types.String         `tfsdk:"created_after"`
+	CreatedBefore types.String         `tfsdk:"created_before"`
+	MaxResults    types.Int64          `tfsdk:"max_results"`
+	AuditLogs     []AuditLogsItemModel `tfsdk:"audit_logs"`
+}
+
+type AuditLogsItemModel struct {
+	ID                types.String `tfsdk:"id"`
+	ActorID           types.String `tfsdk:"actor_id"`
+	ActorName         types.String `tfsdk:"actor_name"`
+	Action            types.String `tfsdk:"action"`
+	SourceType        types.String `tfsdk:"source_type"`
+	SourceID          types.String `tfsdk:"source_id"`
+	SourceLabel       types.String `tfsdk:"source_label"`
+	ChangeDescription types.String `tfsdk:"change_description"`
+	CreatedAt         types.String `tfsdk:"created_at"`
+}
+
+func (d *AuditLogsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_logs"
+}
+
+func (d *AuditLogsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists Zendesk audit log entries, newest first. Requires a Zendesk Enterprise plan.",
+		Attributes: map[string]schema.Attribute{
+			"actor_id": schema.StringAttribute{
+				Description: "Only return changes made by this user.",
+				Optional:    true,
+			},
+			"source_type": schema.StringAttribute{
+				Description: "Only return changes to this kind of object (e.g., 'rule', 'user').",
+				Optional:    true,
+			},
+			"action": schema.StringAttribute{
+				Description: "Only return entries with this action: 'create', 'update', 'destroy', 'login' or 'exported'.",
+				Optional:    true,
+			},
+			"created_after": schema.StringAttribute{
+				Description: "Only return entries created at or after this RFC 3339 timestamp.",
+				Optional:    true,
+			},
+			"created_before": schema.StringAttribute{
+				Description: "Only return entries created at or before this RFC 3339 timestamp.",
+				Optional:    true,
+			},
+			"max_results": schema.Int64Attribute{
+				Description: fmt.Sprintf("The maximum number of entries to return. Defaults to %d.", auditLogsDefaultMaxResults),
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"audit_logs": schema.ListNestedAttribute{
+				Description: "The audit log entries.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the entry.",
+							Computed:    true,
+						},
+						"actor_id": schema.StringAttribute{
+							Description: "The ID of the user who made the change.",
+							Computed:    true,
+						},
+						"actor_name": schema.StringAttribute{
+							Description: "The name of the user who made the change.",
+							Computed:    true,
+						},
+						"action": schema.StringAttribute{
+							Description: "The action performed.",
+							Computed:    true,
+						},
+						"source_type": schema.StringAttribute{
+							Description: "The kind of object changed.",
+							Computed:    true,
+						},
+						"source_id": schema.StringAttribute{
+							Description: "The ID of the object changed.",
+							Computed:    true,
+						},
+						"source_label": schema.StringAttribute{
+							Description: "The name of the object changed.",
+							Computed:    true,
+						},
+						"change_description": schema.StringAttribute{
+							Description: "A description of the change.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "When the change was made.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AuditLogsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AuditLogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config AuditLogsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := url.Values{}
+	if !config.ActorID.IsNull() {
+		params.Set("filter[actor_id]", config.ActorID.ValueString())
+	}
+	if !config.SourceType.IsNull() {
+		params.Set("filter[source_type]", config.SourceType.ValueString())
+	}
+	if !config.Action.IsNull() {
+		params.Set("filter[action]", config.Action.ValueString())
+	}
+
+	// The API filters on a created_at range given as two values, so an open end of the window
+	// is filled in with the epoch or the current time.
+	if !config.CreatedAfter.IsNull() || !config.CreatedBefore.IsNull() {
+		after := time.Unix(0, 0).UTC()
+		before := time.Now().UTC()
+
+		if !config.CreatedAfter.IsNull() {
+			parsed, err := time.Parse(time.RFC3339, config.CreatedAfter.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("created_after"),
+					"Invalid Timestamp",
+					fmt.Sprintf("created_after must be an RFC 3339 timestamp: %v", err),
+				)
+				return
+			}
+			after = parsed
+		}
+		if !config.CreatedBefore.IsNull() {
+			parsed, err := time.Parse(time.RFC3339, config.CreatedBefore.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("created_before"),
+					"Invalid Timestamp",
+					fmt.Sprintf("created_before must be an RFC 3339 timestamp: %v", err),
+				)
+				return
+			}
+			before = parsed
+		}
+
+		params.Add("filter[created_at][]", after.Format(time.RFC3339))
+		params.Add("filter[created_at][]", before.Format(time.RFC3339))
+	}
+
+	maxResults := auditLogsDefaultMaxResults
+	if !config.MaxResults.IsNull() {
+		maxResults = int(config.MaxResults.ValueInt64())
+	}
+
+	logs, truncated, err := d.client.ListAuditLogs(ctx, params, maxResults)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Audit Logs",
+			fmt.Sprintf("Could not list audit logs: %v", err),
+		)
+		return
+	}
+
+	if truncated {
+		resp.Diagnostics.AddWarning(
+			"Audit Logs Result Truncated",
+			fmt.Sprintf("Only the %d most recent entries were fetched. Narrow the filters or raise max_results to get a complete result.", maxResults),
+		)
+	}
+
+	config.AuditLogs = make([]AuditLogsItemModel, 0, len(logs))
+	for _, log := range logs {
+		config.AuditLogs = append(config.AuditLogs, AuditLogsItemModel{
+			ID:                types.StringValue(strconv.FormatInt(log.ID, 10)),
+			ActorID:           types.StringValue(strconv.FormatInt(log.ActorID, 10)),
+			ActorName:         types.StringValue(log.ActorName),
+			Action:            types.StringValue(log.Action),
+			SourceType:        types.StringValue(log.SourceType),
+			SourceID:          types.StringValue(strconv.FormatInt(log.SourceID, 10)),
+			SourceLabel:       types.StringValue(log.SourceLabel),
+			ChangeDescription: types.StringValue(log.ChangeDescription),
+			CreatedAt:         types.StringValue(log.CreatedAt),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
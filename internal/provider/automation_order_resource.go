@@ -0,0 +1,254 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &AutomationOrderResource{}
+	_ resource.ResourceWithImportState = &AutomationOrderResource{}
+	_ resource.ResourceWithModifyPlan  = &AutomationOrderResource{}
+)
+
+// automationOrderID is the fixed ID of the automation order, which is a singleton.
+const automationOrderID = "automation_order"
+
+func NewAutomationOrderResource() resource.Resource {
+	return &AutomationOrderResource{}
+}
+
+type AutomationOrderResource struct {
+	client *Client
+}
+
+type AutomationOrderResourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	AutomationIDs []types.String `tfsdk:"automation_ids"`
+}
+
+func (r *AutomationOrderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_automation_order"
+}
+
+func (r *AutomationOrderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the order in which automations run, applied in a single request. Automations ordered here should not set position.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: fmt.Sprintf("Always %q, as the account has a single automation order.", automationOrderID),
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"automation_ids": schema.ListAttribute{
+				Description: "The IDs of the automations, in the order they run.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *AutomationOrderResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan rejects automations listed twice, and warns about automations that also set their
+// own position.
+func (r *AutomationOrderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan AutomationOrderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attr := path.Root("automation_ids")
+	resp.Diagnostics.Append(duplicateIDDiagnostics(attr, plan.AutomationIDs, map[string]path.Path{})...)
+
+	if r.client == nil {
+		return
+	}
+	for i, id := range plan.AutomationIDs {
+		if !id.IsUnknown() && r.client.positionClaims.claimOrder("automation", id.ValueString()) {
+			resp.Diagnostics.Append(positionConflictWarning(attr.AtListIndex(i), "automation", id.ValueString(), "zendesk_automation_order"))
+		}
+	}
+}
+
+func (r *AutomationOrderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AutomationOrderResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(automationOrderID)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *AutomationOrderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AutomationOrderResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	automations, err := r.client.ListAutomations(ctx, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Automation Order",
+			fmt.Sprintf("Could not list automations: %v", err),
+		)
+		return
+	}
+
+	flattenAutomationOrder(automations, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *AutomationOrderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan AutomationOrderResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(automationOrderID)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete leaves the automations in their current order.
+func (r *AutomationOrderResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// ImportState reads the order of every automation.
+func (r *AutomationOrderResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), automationOrderID)...)
+}
+
+// apply checks that every automation exists, so that a missing one is reported at its index
+// instead of failing the whole request, and then reorders them.
+func (r *AutomationOrderResource) apply(ctx context.Context, model AutomationOrderResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ids, d := expandIDList(model.AutomationIDs, path.Root("automation_ids"))
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	automations, err := r.client.ListAutomations(ctx, nil)
+	if err != nil {
+		diags.AddError(
+			"Error Ordering Automations",
+			fmt.Sprintf("Could not list automations: %v", err),
+		)
+		return diags
+	}
+
+	existing := make(map[int64]bool, len(automations))
+	for _, automation := range automations {
+		existing[automation.ID] = true
+	}
+	for i, id := range ids {
+		if !existing[id] {
+			diags.AddAttributeError(
+				path.Root("automation_ids").AtListIndex(i),
+				"Automation Not Found",
+				fmt.Sprintf("No automation found with ID %d, at index %d.", id, i),
+			)
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := r.client.ReorderAutomations(ctx, ids); err != nil {
+		diags.AddError(
+			"Error Ordering Automations",
+			fmt.Sprintf("Could not reorder automations: %v", err),
+		)
+	}
+	return diags
+}
+
+// flattenAutomationOrder rebuilds the order of the automations in the state from their
+// positions. Automations the state does not list are left out, so that only the order of the
+// managed ones is compared. An empty state, as after import, lists them all.
+func flattenAutomationOrder(automations []Automation, model *AutomationOrderResourceModel) {
+	managed := map[string]bool{}
+	for _, id := range model.AutomationIDs {
+		managed[id.ValueString()] = true
+	}
+
+	sort.SliceStable(automations, func(i, j int) bool {
+		if positionValue(automations[i].Position) != positionValue(automations[j].Position) {
+			return positionValue(automations[i].Position) < positionValue(automations[j].Position)
+		}
+		return automations[i].ID < automations[j].ID
+	})
+
+	ids := make([]types.String, 0, len(automations))
+	for _, automation := range automations {
+		id := strconv.FormatInt(automation.ID, 10)
+		if len(managed) == 0 || managed[id] {
+			ids = append(ids, types.StringValue(id))
+		}
+	}
+
+	model.ID = types.StringValue(automationOrderID)
+	model.AutomationIDs = ids
+}
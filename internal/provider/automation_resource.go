@@ -0,0 +1,310 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &AutomationResource{}
+	_ resource.ResourceWithImportState = &AutomationResource{}
+	_ resource.ResourceWithModifyPlan  = &AutomationResource{}
+)
+
+func NewAutomationResource() resource.Resource {
+	return &AutomationResource{}
+}
+
+type AutomationResource struct {
+	client *Client
+}
+
+type AutomationResourceModel struct {
+	ID                  types.String         `tfsdk:"id"`
+	Title               types.String         `tfsdk:"title"`
+	Active              types.Bool           `tfsdk:"active"`
+	Position            types.Int64          `tfsdk:"position"`
+	Conditions          *RuleConditionsModel `tfsdk:"conditions"`
+	Actions             []RuleActionModel    `tfsdk:"actions"`
+	DeactivateOnDelete  types.Bool           `tfsdk:"deactivate_on_delete"`
+	IgnoreServerChanges types.Set            `tfsdk:"ignore_server_changes"`
+}
+
+func (r *AutomationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_automation"
+}
+
+func (r *AutomationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Zendesk automation, a business rule that runs on tickets every hour.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the automation.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "The title of the automation.",
+				Required:    true,
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether the automation is active. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"position": schema.Int64Attribute{
+				Description: "The position of the automation, which determines the order in which automations run.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"conditions":            ruleConditionsAttribute("The conditions the ticket must meet for the automation to run. At least one must be time based, e.g. hours_since_created."),
+			"actions":               ruleActionsAttribute("The actions performed on the ticket when the automation runs. At least one must nullify a condition, so that the automation runs only once per ticket."),
+			"deactivate_on_delete":  deactivateOnDeleteAttribute(),
+			"ignore_server_changes": ignoreServerChangesAttribute("position"),
+		},
+	}
+}
+
+func (r *AutomationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AutomationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan AutomationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var position types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("position"), &position)...)
+	if !position.IsNull() && !plan.ID.IsUnknown() && r.client.positionClaims.claimPosition("automation", plan.ID.ValueString()) {
+		resp.Diagnostics.Append(positionConflictWarning(path.Root("position"), "automation", plan.ID.ValueString(), "zendesk_automation_order"))
+	}
+
+	if r.client.validatePlaceholders {
+		resp.Diagnostics.Append(ruleActionPlaceholderDiagnostics(path.Root("actions"), plan.Actions)...)
+	}
+}
+
+func (r *AutomationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AutomationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	position := rulePosition(plan.Position, types.Int64Null(), plan.IgnoreServerChanges)
+	automation, err := r.client.CreateAutomation(ctx, expandAutomation(plan, position))
+	if err != nil {
+		if diags := ruleValidationDiagnostics("Error Creating Automation", err); diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Creating Automation",
+			fmt.Sprintf("Could not create automation: %v", err),
+		)
+		return
+	}
+
+	planned := plan.Position
+	flattenAutomation(automation, &plan)
+	if position == nil && !planned.IsUnknown() {
+		plan.Position = planned
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *AutomationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AutomationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Automation ID",
+			fmt.Sprintf("Could not parse automation ID: %v", err),
+		)
+		return
+	}
+
+	automation, err := r.client.ReadAutomation(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Automation",
+			fmt.Sprintf("Could not read automation: %v", err),
+		)
+		return
+	}
+
+	if automation == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	position := state.Position
+	flattenAutomation(automation, &state)
+	if ignoresServerChanges(state.IgnoreServerChanges, "position") && !position.IsNull() {
+		state.Position = position
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *AutomationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan AutomationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The planned position of an automation that does not configure one is the prior state,
+	// which zendesk_automation_order may have changed since, so only the configured one is sent.
+	var configured, prior types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("position"), &configured)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("position"), &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(plan.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Automation ID",
+			fmt.Sprintf("Could not parse automation ID: %v", err),
+		)
+		return
+	}
+
+	position := rulePosition(configured, prior, plan.IgnoreServerChanges)
+	automation, err := r.client.UpdateAutomation(ctx, id, expandAutomation(plan, position))
+	if err != nil {
+		if diags := ruleValidationDiagnostics("Error Updating Automation", err); diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Updating Automation",
+			fmt.Sprintf("Could not update automation: %v", err),
+		)
+		return
+	}
+
+	planned := plan.Position
+	flattenAutomation(automation, &plan)
+	if position == nil && !planned.IsUnknown() {
+		plan.Position = planned
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *AutomationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AutomationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Automation ID",
+			fmt.Sprintf("Could not parse automation ID: %v", err),
+		)
+		return
+	}
+
+	if deactivateOnDelete(r.client, state.DeactivateOnDelete) {
+		automation := expandAutomation(state, nil)
+		automation.Active = false
+
+		_, err = r.client.UpdateAutomation(ctx, id, automation)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Deactivating Automation",
+				fmt.Sprintf("Could not deactivate automation: %v", err),
+			)
+		}
+		return
+	}
+
+	err = r.client.DeleteAutomation(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Automation",
+			fmt.Sprintf("Could not delete automation: %v", err),
+		)
+		return
+	}
+}
+
+func (r *AutomationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func expandAutomation(model AutomationResourceModel, position *int64) Automation {
+	return Automation{
+		Title:      model.Title.ValueString(),
+		Active:     model.Active.ValueBool(),
+		Position:   position,
+		Conditions: expandRuleConditions(model.Conditions),
+		Actions:    expandRuleActions(model.Actions),
+	}
+}
+
+func flattenAutomation(automation *Automation, model *AutomationResourceModel) {
+	model.ID = types.StringValue(strconv.FormatInt(automation.ID, 10))
+	model.Title = types.StringValue(automation.Title)
+	model.Active = types.BoolValue(automation.Active)
+	model.Position = types.Int64Value(positionValue(automation.Position))
+	model.Conditions = flattenRuleConditions(automation.Conditions, model.Conditions)
+	model.Actions = flattenRuleActions(automation.Actions, model.Actions)
+}
@@ -0,0 +1,25 @@
+package provider
+
+import "testing"
+
+func TestAutomationPositionRenumbered(t *testing.T) {
+	p := newProtocolTest(t, "automation_position_renumbered.json")
+
+	state := p.read("zendesk_automation", `{
+		"id": "1000001", "title": "Close solved tickets", "active": true, "position": 3,
+		"conditions": {"all": [
+			{"field": "status", "operator": "is", "value": "solved"},
+			{"field": "SOLVED", "operator": "greater_than", "value": "96"}
+		]},
+		"actions": [{"field": "status", "value": "closed"}]
+	}`)
+
+	p.planUnchanged("zendesk_automation", `{
+		"title": "Close solved tickets",
+		"conditions": {"all": [
+			{"field": "status", "operator": "is", "value": "solved"},
+			{"field": "SOLVED", "operator": "greater_than", "value": "96"}
+		]},
+		"actions": [{"field": "status", "value": "closed"}]
+	}`, state)
+}
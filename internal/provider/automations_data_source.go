@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &AutomationsDataSource{}
+)
+
+func NewAutomationsDataSource() datasource.DataSource {
+	return &AutomationsDataSource{}
+}
+
+type AutomationsDataSource struct {
+	client *Client
+}
+
+type AutomationsDataSourceModel struct {
+	Active      types.Bool             `tfsdk:"active"`
+	Title       types.String           `tfsdk:"title"`
+	Automations []AutomationsItemModel `tfsdk:"automations"`
+}
+
+type AutomationsItemModel struct {
+	ID             types.String `tfsdk:"id"`
+	Title          types.String `tfsdk:"title"`
+	Active         types.Bool   `tfsdk:"active"`
+	Position       types.Int64  `tfsdk:"position"`
+	ConditionsJSON types.String `tfsdk:"conditions_json"`
+	ActionsJSON    types.String `tfsdk:"actions_json"`
+}
+
+func (d *AutomationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_automations"
+}
+
+func (d *AutomationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists Zendesk automations, sorted by position.",
+		Attributes: map[string]schema.Attribute{
+			"active": schema.BoolAttribute{
+				Description: "Only return active (true) or inactive (false) automations.",
+				Optional:    true,
+			},
+			"title": schema.StringAttribute{
+				Description: "Only return the automations with this exact title. An error is returned if none match.",
+				Optional:    true,
+			},
+			"automations": schema.ListNestedAttribute{
+				Description: "The automations.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the automation.",
+							Computed:    true,
+						},
+						"title": schema.StringAttribute{
+							Description: "The title of the automation.",
+							Computed:    true,
+						},
+						"active": schema.BoolAttribute{
+							Description: "Whether the automation is active.",
+							Computed:    true,
+						},
+						"position": schema.Int64Attribute{
+							Description: "The position of the automation.",
+							Computed:    true,
+						},
+						"conditions_json": schema.StringAttribute{
+							Description: "The conditions of the automation, as returned by the API, encoded as JSON.",
+							Computed:    true,
+						},
+						"actions_json": schema.StringAttribute{
+							Description: "The actions of the automation, as returned by the API, encoded as JSON.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AutomationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AutomationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config AutomationsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := url.Values{}
+	if !config.Active.IsNull() {
+		params.Set("active", strconv.FormatBool(config.Active.ValueBool()))
+	}
+
+	automations, err := d.client.ListAutomations(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Automations",
+			fmt.Sprintf("Could not list automations: %v", err),
+		)
+		return
+	}
+
+	sort.SliceStable(automations, func(i, j int) bool {
+		if positionValue(automations[i].Position) != positionValue(automations[j].Position) {
+			return positionValue(automations[i].Position) < positionValue(automations[j].Position)
+		}
+		return automations[i].ID < automations[j].ID
+	})
+
+	config.Automations = make([]AutomationsItemModel, 0, len(automations))
+	for _, automation := range automations {
+		if !config.Title.IsNull() && automation.Title != config.Title.ValueString() {
+			continue
+		}
+
+		config.Automations = append(config.Automations, AutomationsItemModel{
+			ID:             types.StringValue(strconv.FormatInt(automation.ID, 10)),
+			Title:          types.StringValue(automation.Title),
+			Active:         types.BoolValue(automation.Active),
+			Position:       types.Int64Value(positionValue(automation.Position)),
+			ConditionsJSON: jsonStringValue(automation.Conditions),
+			ActionsJSON:    jsonStringValue(automation.Actions),
+		})
+	}
+
+	if !config.Title.IsNull() && len(config.Automations) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("title"),
+			"Automation Not Found",
+			fmt.Sprintf("No automation found with title %q.", config.Title.ValueString()),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,266 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &BrandAgentResource{}
+	_ resource.ResourceWithImportState = &BrandAgentResource{}
+)
+
+func NewBrandAgentResource() resource.Resource {
+	return &BrandAgentResource{}
+}
+
+type BrandAgentResource struct {
+	client *Client
+}
+
+type BrandAgentResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	BrandID types.String `tfsdk:"brand_id"`
+	UserID  types.String `tfsdk:"user_id"`
+}
+
+func (r *BrandAgentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_brand_agent"
+}
+
+func (r *BrandAgentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the membership of an agent in a brand, which lets the agent work the tickets of the brand.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the brand membership.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"brand_id": schema.StringAttribute{
+				Description: "The ID of the brand.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Description: "The ID of the agent.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *BrandAgentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *BrandAgentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan BrandAgentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	brandID, userID, err := parseBrandAgentIDs(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Brand Agent IDs",
+			fmt.Sprintf("Could not parse brand or user ID: %v", err),
+		)
+		return
+	}
+
+	agent, err := r.client.CreateBrandAgent(ctx, brandID, userID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Brand Agent",
+			fmt.Sprintf("Could not add user %d to brand %d: %v", userID, brandID, err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(strconv.FormatInt(agent.ID, 10))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *BrandAgentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state BrandAgentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	brandID, err := strconv.ParseInt(state.BrandID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Brand ID",
+			fmt.Sprintf("Could not parse brand ID: %v", err),
+		)
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Brand Agent ID",
+			fmt.Sprintf("Could not parse brand agent ID: %v", err),
+		)
+		return
+	}
+
+	// The memberships of a deleted brand may still be readable, so the brand is checked first.
+	brand, err := r.client.ReadBrand(ctx, brandID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Brand",
+			fmt.Sprintf("Could not read brand: %v", err),
+		)
+		return
+	}
+
+	if brand == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	agent, err := r.client.ReadBrandAgent(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Brand Agent",
+			fmt.Sprintf("Could not read brand agent: %v", err),
+		)
+		return
+	}
+
+	if agent == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.BrandID = types.StringValue(strconv.FormatInt(agent.BrandID, 10))
+	state.UserID = types.StringValue(strconv.FormatInt(agent.UserID, 10))
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *BrandAgentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Update Not Supported",
+		"Brand memberships cannot be updated. Changing the brand or the user replaces the membership.",
+	)
+}
+
+func (r *BrandAgentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state BrandAgentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Brand Agent ID",
+			fmt.Sprintf("Could not parse brand agent ID: %v", err),
+		)
+		return
+	}
+
+	err = r.client.DeleteBrandAgent(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Brand Agent",
+			fmt.Sprintf("Could not remove user %s from brand %s: %v", state.UserID.ValueString(), state.BrandID.ValueString(), err),
+		)
+		return
+	}
+}
+
+// ImportState accepts brand_id/user_id and looks up the membership among the agents of the brand.
+func (r *BrandAgentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := splitImportID(req.ID, "brand_id", "user_id")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	brandID, userID, err := parseBrandAgentIDs(BrandAgentResourceModel{
+		BrandID: types.StringValue(parts[0]),
+		UserID:  types.StringValue(parts[1]),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Could not parse brand or user ID: %v", err))
+		return
+	}
+
+	agents, err := r.client.ListBrandAgents(ctx, brandID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Brand Agent",
+			fmt.Sprintf("Could not list the agents of brand %d: %v", brandID, err),
+		)
+		return
+	}
+
+	for _, agent := range agents {
+		if agent.UserID == userID {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strconv.FormatInt(agent.ID, 10))...)
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("brand_id"), parts[0])...)
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), parts[1])...)
+			return
+		}
+	}
+
+	resp.Diagnostics.AddError(
+		"Brand Agent Not Found",
+		fmt.Sprintf("User %d is not a member of brand %d.", userID, brandID),
+	)
+}
+
+func parseBrandAgentIDs(model BrandAgentResourceModel) (int64, int64, error) {
+	brandID, err := strconv.ParseInt(model.BrandID.ValueString(), 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	userID, err := strconv.ParseInt(model.UserID.ValueString(), 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return brandID, userID, nil
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const testBrandAgentState = `{"id": "1000020", "brand_id": "1000001", "user_id": "1000010"}`
+
+// Creating a membership adds the user to the brand, and state keeps the membership ID with the
+// brand and user it joins. The fixture fails the test if the request has other IDs.
+func TestBrandAgentCreate(t *testing.T) {
+	p := newProtocolTest(t, "brand_agent_create.json")
+
+	state := p.apply("zendesk_brand_agent", `{"brand_id": "1000001", "user_id": "1000010"}`, nil)
+	for name, want := range map[string]string{"id": "1000020", "brand_id": "1000001", "user_id": "1000010"} {
+		if got := p.attribute("zendesk_brand_agent", state, name); !got.Equal(tftypes.NewValue(tftypes.String, want)) {
+			t.Errorf("expected %s %s, got %s", name, want, got)
+		}
+	}
+}
+
+func TestBrandAgentImport(t *testing.T) {
+	p := newProtocolTest(t, "brand_agent_import.json")
+
+	importState := func(id string) *tfprotov6.ImportResourceStateResponse {
+		t.Helper()
+		resp, err := p.server.ImportResourceState(context.Background(), &tfprotov6.ImportResourceStateRequest{
+			TypeName: "zendesk_brand_agent",
+			ID:       id,
+		})
+		if err != nil {
+			t.Fatalf("ImportResourceState: %v", err)
+		}
+		return resp
+	}
+
+	resp := importState("1000001/1000010")
+	checkProtocolDiagnostics(t, resp.Diagnostics)
+	if len(resp.ImportedResources) != 1 {
+		t.Fatalf("expected 1 imported resource, got %d", len(resp.ImportedResources))
+	}
+	state := resp.ImportedResources[0].State
+	for name, want := range map[string]string{"id": "1000020", "brand_id": "1000001", "user_id": "1000010"} {
+		if got := p.attribute("zendesk_brand_agent", state, name); !got.Equal(tftypes.NewValue(tftypes.String, want)) {
+			t.Errorf("expected %s %s, got %s", name, want, got)
+		}
+	}
+
+	if resp := importState("1000001/1000012"); !hasProtocolError(resp.Diagnostics, "Brand Agent Not Found") {
+		t.Errorf("expected an error for a user who is not a member, got %v", resp.Diagnostics)
+	}
+
+	for _, id := range []string{"1000001", "1000001/agent"} {
+		if resp := importState(id); !hasProtocolError(resp.Diagnostics, "Invalid Import ID") {
+			t.Errorf("expected an invalid import ID error for %q, got %v", id, resp.Diagnostics)
+		}
+	}
+}
+
+// Deleting a brand removes its memberships, which are then gone from state, and destroying
+// them succeeds.
+func TestBrandAgentBrandDeleted(t *testing.T) {
+	p := newProtocolTest(t, "brand_agent_brand_deleted.json")
+
+	state := p.read("zendesk_brand_agent", testBrandAgentState)
+	if !p.value("zendesk_brand_agent", state).IsNull() {
+		t.Errorf("expected the membership to be removed, got %s", p.value("zendesk_brand_agent", state))
+	}
+
+	state = p.apply("zendesk_brand_agent", "", p.config("zendesk_brand_agent", testBrandAgentState))
+	if !p.value("zendesk_brand_agent", state).IsNull() {
+		t.Errorf("expected the membership to be destroyed, got %s", p.value("zendesk_brand_agent", state))
+	}
+}
+
+func TestBrandAgentReadDeleted(t *testing.T) {
+	p := newProtocolTest(t, "brand_agent_deleted.json")
+
+	state := p.read("zendesk_brand_agent", testBrandAgentState)
+	if !p.value("zendesk_brand_agent", state).IsNull() {
+		t.Errorf("expected the membership to be removed, got %s", p.value("zendesk_brand_agent", state))
+	}
+}
@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                     = &BrandDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &BrandDataSource{}
+)
+
+func NewBrandDataSource() datasource.DataSource {
+	return &BrandDataSource{}
+}
+
+type BrandDataSource struct {
+	client *Client
+}
+
+type BrandDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Subdomain     types.String `tfsdk:"subdomain"`
+	BrandURL      types.String `tfsdk:"brand_url"`
+	HostMapping   types.String `tfsdk:"host_mapping"`
+	Active        types.Bool   `tfsdk:"active"`
+	Default       types.Bool   `tfsdk:"default"`
+	HasHelpCenter types.Bool   `tfsdk:"has_help_center"`
+}
+
+func (d *BrandDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_brand"
+}
+
+func (d *BrandDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a Zendesk brand by ID, subdomain, or name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the brand. Exactly one of id, subdomain or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"subdomain": schema.StringAttribute{
+				Description: "The subdomain of the brand. Exactly one of id, subdomain or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The exact name of the brand. Exactly one of id, subdomain or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"brand_url": schema.StringAttribute{
+				Description: "The URL of the brand.",
+				Computed:    true,
+			},
+			"host_mapping": schema.StringAttribute{
+				Description: "The host mapping (custom domain) of the brand, if any.",
+				Computed:    true,
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether the brand is active.",
+				Computed:    true,
+			},
+			"default": schema.BoolAttribute{
+				Description: "Whether the brand is the default brand of the account.",
+				Computed:    true,
+			},
+			"has_help_center": schema.BoolAttribute{
+				Description: "Whether the brand has a Help Center.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *BrandDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("subdomain"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *BrandDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *BrandDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config BrandDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var brand *Brand
+	if !config.ID.IsNull() {
+		id, err := strconv.ParseInt(config.ID.ValueString(), 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Error Parsing Brand ID",
+				fmt.Sprintf("Could not parse brand ID: %v", err),
+			)
+			return
+		}
+
+		brand, err = d.client.ReadBrand(ctx, id)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Brand",
+				fmt.Sprintf("Could not read brand: %v", err),
+			)
+			return
+		}
+
+		if brand == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Brand Not Found",
+				fmt.Sprintf("No brand found with id %q.", config.ID.ValueString()),
+			)
+			return
+		}
+	} else {
+		brands, err := d.client.ListBrands(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Listing Brands",
+				fmt.Sprintf("Could not list brands: %v", err),
+			)
+			return
+		}
+
+		attr, key, value := path.Root("name"), "name", config.Name.ValueString()
+		if !config.Subdomain.IsNull() {
+			attr, key, value = path.Root("subdomain"), "subdomain", config.Subdomain.ValueString()
+		}
+
+		var found []Brand
+		for _, b := range brands {
+			if (key == "subdomain" && b.Subdomain == value) || (key == "name" && b.Name == value) {
+				found = append(found, b)
+			}
+		}
+
+		switch len(found) {
+		case 0:
+			resp.Diagnostics.AddAttributeError(
+				attr,
+				"Brand Not Found",
+				fmt.Sprintf("No brand found with %s %q.", key, value),
+			)
+			return
+		case 1:
+			brand = &found[0]
+		default:
+			ids := make([]string, 0, len(found))
+			for _, b := range found {
+				ids = append(ids, strconv.FormatInt(b.ID, 10))
+			}
+			resp.Diagnostics.AddAttributeError(
+				attr,
+				"Multiple Brands Found",
+				fmt.Sprintf("Found %d brands with %s %q (IDs: %s). Look the brand up by id or subdomain instead.", len(found), key, value, strings.Join(ids, ", ")),
+			)
+			return
+		}
+	}
+
+	config.ID = types.StringValue(strconv.FormatInt(brand.ID, 10))
+	config.Name = types.StringValue(brand.Name)
+	config.Subdomain = types.StringValue(brand.Subdomain)
+	config.BrandURL = types.StringValue(brand.BrandURL)
+	config.HostMapping = types.StringValue(brand.HostMapping)
+	config.Active = types.BoolValue(brand.Active)
+	config.Default = types.BoolValue(brand.Default)
+	config.HasHelpCenter = types.BoolValue(brand.HasHelpCenter)
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,316 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// brandReadableTimeout bounds the wait for a new brand to be returned by the API, which can
+	// answer 404 for a few seconds after the brand is created.
+	brandReadableTimeout      = time.Minute
+	brandReadablePollInterval = 2 * time.Second
+)
+
+var (
+	_ resource.Resource                = &BrandResource{}
+	_ resource.ResourceWithImportState = &BrandResource{}
+)
+
+func NewBrandResource() resource.Resource {
+	return &BrandResource{}
+}
+
+type BrandResource struct {
+	client *Client
+}
+
+type BrandResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Subdomain         types.String `tfsdk:"subdomain"`
+	HostMapping       types.String `tfsdk:"host_mapping"`
+	BrandURL          types.String `tfsdk:"brand_url"`
+	Active            types.Bool   `tfsdk:"active"`
+	Default           types.Bool   `tfsdk:"default"`
+	SignatureTemplate types.String `tfsdk:"signature_template"`
+	HasHelpCenter     types.Bool   `tfsdk:"has_help_center"`
+}
+
+func (r *BrandResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_brand"
+}
+
+func (r *BrandResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Zendesk brand.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the brand.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the brand.",
+				Required:    true,
+			},
+			"subdomain": schema.StringAttribute{
+				Description: "The subdomain of the brand, as in subdomain.zendesk.com.",
+				Required:    true,
+			},
+			"host_mapping": schema.StringAttribute{
+				Description: "The custom domain the brand's Help Center is served on.",
+				Optional:    true,
+			},
+			"brand_url": schema.StringAttribute{
+				Description: "The URL of the brand.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether the brand is active. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"default": schema.BoolAttribute{
+				Description: "Whether the brand is the default brand of the account. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"signature_template": schema.StringAttribute{
+				Description: "The template of the signature added to agent comments, e.g. '{{agent.signature}}'.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"has_help_center": schema.BoolAttribute{
+				Description: "Whether the brand has a Help Center.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *BrandResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *BrandResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan BrandResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	brand, err := r.client.CreateBrand(ctx, expandBrand(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Brand",
+			fmt.Sprintf("Could not create brand: %v", err),
+		)
+		return
+	}
+
+	// Save the ID first, so that the brand is tracked even if it never becomes readable.
+	plan.ID = types.StringValue(strconv.FormatInt(brand.ID, 10))
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+
+	err = waitFor(ctx, waitOptions{
+		Description: fmt.Sprintf("brand %d to be readable", brand.ID),
+		Interval:    brandReadablePollInterval,
+		Timeout:     brandReadableTimeout,
+	}, func() (bool, error) {
+		found, err := r.client.ReadBrand(ctx, brand.ID)
+		if err != nil || found == nil {
+			return false, err
+		}
+		brand = found
+		return true, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Brand",
+			fmt.Sprintf("Brand %d was created but could not be read: %v", brand.ID, err),
+		)
+		return
+	}
+
+	flattenBrand(brand, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *BrandResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state BrandResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Brand ID",
+			fmt.Sprintf("Could not parse brand ID: %v", err),
+		)
+		return
+	}
+
+	brand, err := r.client.ReadBrand(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Brand",
+			fmt.Sprintf("Could not read brand: %v", err),
+		)
+		return
+	}
+
+	if brand == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	flattenBrand(brand, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *BrandResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan BrandResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(plan.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Brand ID",
+			fmt.Sprintf("Could not parse brand ID: %v", err),
+		)
+		return
+	}
+
+	brand, err := r.client.UpdateBrand(ctx, id, expandBrand(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Brand",
+			fmt.Sprintf("Could not update brand: %v", err),
+		)
+		return
+	}
+
+	flattenBrand(brand, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *BrandResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state BrandResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Brand ID",
+			fmt.Sprintf("Could not parse brand ID: %v", err),
+		)
+		return
+	}
+
+	err = r.client.DeleteBrand(ctx, id)
+	if err != nil {
+		// Zendesk refuses to delete the default brand, with an error that does not say why.
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && state.Default.ValueBool() {
+			resp.Diagnostics.AddError(
+				"Error Deleting Brand",
+				fmt.Sprintf("Brand %q is the default brand, which Zendesk does not allow to delete. Make another brand the default first.", state.Name.ValueString()),
+			)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Deleting Brand",
+			fmt.Sprintf("Could not delete brand: %v", err),
+		)
+		return
+	}
+}
+
+func (r *BrandResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func expandBrand(model BrandResourceModel) Brand {
+	return Brand{
+		Name:              model.Name.ValueString(),
+		Subdomain:         model.Subdomain.ValueString(),
+		HostMapping:       model.HostMapping.ValueString(),
+		Active:            model.Active.ValueBool(),
+		Default:           model.Default.ValueBool(),
+		SignatureTemplate: model.SignatureTemplate.ValueString(),
+		HasHelpCenter:     model.HasHelpCenter.ValueBool(),
+	}
+}
+
+func flattenBrand(brand *Brand, model *BrandResourceModel) {
+	model.ID = types.StringValue(strconv.FormatInt(brand.ID, 10))
+	model.Name = types.StringValue(brand.Name)
+	model.Subdomain = types.StringValue(brand.Subdomain)
+	if brand.HostMapping != "" || !model.HostMapping.IsNull() {
+		model.HostMapping = types.StringValue(brand.HostMapping)
+	}
+	model.BrandURL = types.StringValue(brand.BrandURL)
+	model.Active = types.BoolValue(brand.Active)
+	model.Default = types.BoolValue(brand.Default)
+	model.SignatureTemplate = types.StringValue(brand.SignatureTemplate)
+	model.HasHelpCenter = types.BoolValue(brand.HasHelpCenter)
+}
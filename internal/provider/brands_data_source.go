@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &BrandsDataSource{}
+)
+
+func NewBrandsDataSource() datasource.DataSource {
+	return &BrandsDataSource{}
+}
+
+type BrandsDataSource struct {
+	client *Client
+}
+
+type BrandsDataSourceModel struct {
+	ActiveOnly types.Bool        `tfsdk:"active_only"`
+	Brands     []BrandsItemModel `tfsdk:"brands"`
+}
+
+type BrandsItemModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Subdomain     types.String `tfsdk:"subdomain"`
+	BrandURL      types.String `tfsdk:"brand_url"`
+	HostMapping   types.String `tfsdk:"host_mapping"`
+	Active        types.Bool   `tfsdk:"active"`
+	IsDefault     types.Bool   `tfsdk:"is_default"`
+	HasHelpCenter types.Bool   `tfsdk:"has_help_center"`
+}
+
+func (d *BrandsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_brands"
+}
+
+func (d *BrandsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all Zendesk brands, sorted by name.",
+		Attributes: map[string]schema.Attribute{
+			"active_only": schema.BoolAttribute{
+				Description: "Only return active brands.",
+				Optional:    true,
+			},
+			"brands": schema.ListNestedAttribute{
+				Description: "The brands.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the brand.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the brand.",
+							Computed:    true,
+						},
+						"subdomain": schema.StringAttribute{
+							Description: "The subdomain of the brand.",
+							Computed:    true,
+						},
+						"brand_url": schema.StringAttribute{
+							Description: "The URL of the brand.",
+							Computed:    true,
+						},
+						"host_mapping": schema.StringAttribute{
+							Description: "The host mapping (custom domain) of the brand, if any.",
+							Computed:    true,
+						},
+						"active": schema.BoolAttribute{
+							Description: "Whether the brand is active.",
+							Computed:    true,
+						},
+						"is_default": schema.BoolAttribute{
+							Description: "Whether the brand is the default brand of the account.",
+							Computed:    true,
+						},
+						"has_help_center": schema.BoolAttribute{
+							Description: "Whether the brand has a Help Center.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *BrandsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *BrandsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config BrandsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	brands, err := d.client.ListBrands(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Brands",
+			fmt.Sprintf("Could not list brands: %v", err),
+		)
+		return
+	}
+
+	sort.SliceStable(brands, func(i, j int) bool {
+		if brands[i].Name != brands[j].Name {
+			return brands[i].Name < brands[j].Name
+		}
+		return brands[i].ID < brands[j].ID
+	})
+
+	config.Brands = make([]BrandsItemModel, 0, len(brands))
+	for _, brand := range brands {
+		if config.ActiveOnly.ValueBool() && !brand.Active {
+			continue
+		}
+
+		config.Brands = append(config.Brands, BrandsItemModel{
+			ID:            types.StringValue(strconv.FormatInt(brand.ID, 10)),
+			Name:          types.StringValue(brand.Name),
+			Subdomain:     types.StringValue(brand.Subdomain),
+			BrandURL:      types.StringValue(brand.BrandURL),
+			HostMapping:   types.StringValue(brand.HostMapping),
+			Active:        types.BoolValue(brand.Active),
+			IsDefault:     types.BoolValue(brand.Default),
+			HasHelpCenter: types.BoolValue(brand.HasHelpCenter),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
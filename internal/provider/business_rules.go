@@ -0,0 +1,443 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// RuleCondition is a single condition of a Zendesk business rule (trigger, automation, ...).
+type RuleCondition struct {
+	Field    string          `json:"field"`
+	Operator string          `json:"operator"`
+	Value    json.RawMessage `json:"value,omitempty"`
+}
+
+// RuleConditions groups the conditions that must all match and the ones of which any must match.
+type RuleConditions struct {
+	All []RuleCondition `json:"all"`
+	Any []RuleCondition `json:"any"`
+}
+
+// RuleAction is a single action of a Zendesk business rule.
+type RuleAction struct {
+	Field string          `json:"field"`
+	Value json.RawMessage `json:"value"`
+}
+
+type RuleConditionModel struct {
+	Field    types.String `tfsdk:"field"`
+	Operator types.String `tfsdk:"operator"`
+	Value    types.String `tfsdk:"value"`
+}
+
+type RuleConditionsModel struct {
+	All []RuleConditionModel `tfsdk:"all"`
+	Any []RuleConditionModel `tfsdk:"any"`
+}
+
+type RuleActionModel struct {
+	Field types.String `tfsdk:"field"`
+	Value types.String `tfsdk:"value"`
+}
+
+func ruleConditionAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"field": schema.StringAttribute{
+			Description: "The field the condition is evaluated against.",
+			Required:    true,
+		},
+		"operator": schema.StringAttribute{
+			Description: "The comparison operator (e.g., 'is', 'is_not', 'includes').",
+			Required:    true,
+		},
+		"value": schema.StringAttribute{
+			Description: "The value to compare against. Use jsonencode() for conditions that take a list of values.",
+			Optional:    true,
+		},
+	}
+}
+
+func ruleConditionsAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: description,
+		Required:    true,
+		Attributes: map[string]schema.Attribute{
+			"all": schema.ListNestedAttribute{
+				Description: "Conditions that must all be met.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: ruleConditionAttributes(),
+				},
+			},
+			"any": schema.ListNestedAttribute{
+				Description: "Conditions of which at least one must be met.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: ruleConditionAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func ruleActionsAttribute(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: description,
+		Required:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"field": schema.StringAttribute{
+					Description: "The field the action modifies (e.g., 'status', 'group_id', 'notification_user').",
+					Required:    true,
+				},
+				"value": schema.StringAttribute{
+					Description: "The value to set. Use jsonencode() for actions that take a list of values.",
+					Required:    true,
+				},
+			},
+		},
+	}
+}
+
+func expandRuleConditions(model *RuleConditionsModel) RuleConditions {
+	if model == nil {
+		return RuleConditions{All: []RuleCondition{}, Any: []RuleCondition{}}
+	}
+
+	return RuleConditions{
+		All: expandRuleConditionList(model.All),
+		Any: expandRuleConditionList(model.Any),
+	}
+}
+
+func expandRuleConditionList(models []RuleConditionModel) []RuleCondition {
+	conditions := make([]RuleCondition, 0, len(models))
+	for _, m := range models {
+		condition := RuleCondition{
+			Field:    m.Field.ValueString(),
+			Operator: m.Operator.ValueString(),
+		}
+		if !m.Value.IsNull() {
+			condition.Value = expandRuleValue(m.Value.ValueString())
+		}
+		conditions = append(conditions, condition)
+	}
+	return conditions
+}
+
+func expandRuleActions(models []RuleActionModel) []RuleAction {
+	actions := make([]RuleAction, 0, len(models))
+	for _, m := range models {
+		actions = append(actions, RuleAction{
+			Field: m.Field.ValueString(),
+			Value: expandRuleValue(m.Value.ValueString()),
+		})
+	}
+	return actions
+}
+
+// flattenRuleConditions maps the conditions returned by Zendesk back to the model, keeping the
+// prior values where they are semantically equal to avoid spurious diffs.
+func flattenRuleConditions(conditions RuleConditions, prior *RuleConditionsModel) *RuleConditionsModel {
+	if prior == nil {
+		prior = &RuleConditionsModel{}
+	}
+
+	return &RuleConditionsModel{
+		All: flattenRuleConditionList(conditions.All, prior.All),
+		Any: flattenRuleConditionList(conditions.Any, prior.Any),
+	}
+}
+
+func flattenRuleConditionList(conditions []RuleCondition, prior []RuleConditionModel) []RuleConditionModel {
+	if len(conditions) == 0 {
+		if prior != nil {
+			return []RuleConditionModel{}
+		}
+		return nil
+	}
+
+	models := make([]RuleConditionModel, 0, len(conditions))
+	for i, c := range conditions {
+		value := types.StringNull()
+		if i < len(prior) {
+			value = prior[i].Value
+		}
+
+		models = append(models, RuleConditionModel{
+			Field:    types.StringValue(c.Field),
+			Operator: types.StringValue(c.Operator),
+			Value:    flattenRuleValue(c.Value, value),
+		})
+	}
+	return models
+}
+
+func flattenRuleActions(actions []RuleAction, prior []RuleActionModel) []RuleActionModel {
+	models := make([]RuleActionModel, 0, len(actions))
+	for i, a := range actions {
+		value := types.StringNull()
+		if i < len(prior) {
+			value = prior[i].Value
+		}
+
+		flattened := flattenRuleValue(a.Value, value)
+		if flattened.IsNull() {
+			flattened = types.StringValue("")
+		}
+
+		models = append(models, RuleActionModel{
+			Field: types.StringValue(a.Field),
+			Value: flattened,
+		})
+	}
+	return models
+}
+
+// expandRuleValue sends JSON arrays as arrays and everything else as a plain string.
+func expandRuleValue(value string) json.RawMessage {
+	trimmed := strings.TrimSpace(value)
+	if strings.HasPrefix(trimmed, "[") {
+		var list []interface{}
+		if err := json.Unmarshal([]byte(trimmed), &list); err == nil {
+			return json.RawMessage(trimmed)
+		}
+	}
+
+	raw, _ := json.Marshal(value)
+	return raw
+}
+
+// flattenRuleValue converts a value returned by Zendesk into its string form. The prior value
+// is kept when both represent the same thing, e.g. "1" and [1], or "open" and ["open"].
+func flattenRuleValue(raw json.RawMessage, prior types.String) types.String {
+	normalized := normalizeRuleValue(raw)
+
+	if !prior.IsNull() && !prior.IsUnknown() {
+		if equalStringSlices(normalized, normalizeRuleValue(expandRuleValue(prior.ValueString()))) {
+			return prior
+		}
+	}
+
+	trimmed := bytes.TrimSpace(raw)
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		return types.StringNull()
+	}
+
+	var s string
+	if err := json.Unmarshal(trimmed, &s); err == nil {
+		return types.StringValue(s)
+	}
+
+	var compacted bytes.Buffer
+	if err := json.Compact(&compacted, trimmed); err != nil {
+		return types.StringValue(string(trimmed))
+	}
+	return types.StringValue(compacted.String())
+}
+
+// normalizeRuleValue flattens a JSON value into a list of strings so that scalars, single
+// element arrays, and numbers encoded as strings compare equal.
+func normalizeRuleValue(raw json.RawMessage) []string {
+	trimmed := bytes.TrimSpace(raw)
+	if len(trimmed) == 0 {
+		return nil
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(trimmed, &value); err != nil {
+		return []string{string(trimmed)}
+	}
+
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			values = append(values, ruleScalarString(item))
+		}
+		return values
+	default:
+		return []string{ruleScalarString(v)}
+	}
+}
+
+func ruleScalarString(value interface{}) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	raw, _ := json.Marshal(value)
+	return string(raw)
+}
+
+func equalStringSlices(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Restriction limits who can use a macro or view. Type is "User" or "Group"; group
+// restrictions list their groups in IDs.
+type Restriction struct {
+	Type string  `json:"type"`
+	ID   int64   `json:"id,omitempty"`
+	IDs  []int64 `json:"ids,omitempty"`
+}
+
+type RestrictionModel struct {
+	Type types.String   `tfsdk:"type"`
+	IDs  []types.String `tfsdk:"ids"`
+}
+
+func restrictionDataSourceAttribute(description string) dsschema.SingleNestedAttribute {
+	return dsschema.SingleNestedAttribute{
+		Description: description,
+		Computed:    true,
+		Attributes: map[string]dsschema.Attribute{
+			"type": dsschema.StringAttribute{
+				Description: "The restriction type, 'User' or 'Group'.",
+				Computed:    true,
+			},
+			"ids": dsschema.ListAttribute{
+				Description: "The IDs of the user or groups the restriction applies to.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func flattenRestriction(restriction *Restriction) *RestrictionModel {
+	if restriction == nil {
+		return nil
+	}
+
+	ids := restriction.IDs
+	if len(ids) == 0 && restriction.ID != 0 {
+		ids = []int64{restriction.ID}
+	}
+
+	return &RestrictionModel{
+		Type: types.StringValue(restriction.Type),
+		IDs:  idListValue(ids),
+	}
+}
+
+func deactivateOnDeleteAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: "Whether destroying the resource deactivates it instead of deleting it, keeping its audit history. Defaults to the deactivate_on_delete setting of the provider.",
+		Optional:    true,
+	}
+}
+
+// deactivateOnDelete resolves the deactivate_on_delete argument of a resource against the
+// provider-wide default.
+func deactivateOnDelete(client *Client, override types.Bool) bool {
+	if override.IsNull() || override.IsUnknown() {
+		return client.deactivateOnDelete
+	}
+	return override.ValueBool()
+}
+
+// rulePosition returns the position to send for a business rule, or nil to leave the position
+// Zendesk assigned unchanged: when it is null or unknown, or when server changes to it are
+// ignored and the configuration did not change it from prior. The state then keeps the planned
+// position until the next refresh reads the one Zendesk holds.
+func rulePosition(position, prior types.Int64, ignored types.Set) *int64 {
+	if position.IsNull() || position.IsUnknown() {
+		return nil
+	}
+	if ignoresServerChanges(ignored, "position") && position.Equal(prior) {
+		return nil
+	}
+	return position.ValueInt64Pointer()
+}
+
+// positionValue returns the position of a business rule, SLA policy or ticket form read from
+// Zendesk, treating a missing position as 0.
+func positionValue(position *int64) int64 {
+	if position == nil {
+		return 0
+	}
+	return *position
+}
+
+func ignoreServerChangesAttribute(attributes ...string) schema.SetAttribute {
+	return schema.SetAttribute{
+		Description: fmt.Sprintf("Server-managed attributes whose changes made by Zendesk are ignored on refresh, so that e.g. a renumbering of positions does not produce a plan. Changes made in the configuration are still applied. Supported values: %s.", strings.Join(attributes, ", ")),
+		Optional:    true,
+		ElementType: types.StringType,
+		Validators: []validator.Set{
+			setvalidator.ValueStringsAre(stringvalidator.OneOf(attributes...)),
+		},
+	}
+}
+
+// ignoresServerChanges reports whether attribute is listed in the ignore_server_changes
+// argument of a resource.
+func ignoresServerChanges(ignored types.Set, attribute string) bool {
+	for _, element := range ignored.Elements() {
+		if value, ok := element.(types.String); ok && value.ValueString() == attribute {
+			return true
+		}
+	}
+	return false
+}
+
+// ruleActionPlaceholderDiagnostics checks the Liquid placeholders of the actions that send text,
+// such as notifications and comments. Notification values are lists whose strings (recipient,
+// subject, body) are each checked.
+func ruleActionPlaceholderDiagnostics(actionsPath path.Path, actions []RuleActionModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for i, action := range actions {
+		field := action.Field.ValueString()
+		if action.Value.IsNull() || action.Value.IsUnknown() || !(strings.HasPrefix(field, "notification_") || strings.HasPrefix(field, "comment_value")) {
+			continue
+		}
+
+		valuePath := actionsPath.AtListIndex(i).AtName("value")
+		for _, text := range normalizeRuleValue(expandRuleValue(action.Value.ValueString())) {
+			diags.Append(liquidPlaceholderDiagnostics(valuePath, text)...)
+		}
+	}
+
+	return diags
+}
+
+// ruleValidationDiagnostics turns the messages of a 422 returned for a business rule into
+// errors on the conditions or actions attribute they are about, e.g. "Automations must have a
+// time based condition". Other errors yield no diagnostics.
+func ruleValidationDiagnostics(summary string, err error) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, message := range validationMessages(err) {
+		lower := strings.ToLower(message)
+		switch {
+		case strings.Contains(lower, "condition"):
+			diags.AddAttributeError(path.Root("conditions"), summary, message)
+		case strings.Contains(lower, "action"):
+			diags.AddAttributeError(path.Root("actions"), summary, message)
+		default:
+			diags.AddError(summary, message)
+		}
+	}
+	return diags
+}
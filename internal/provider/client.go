@@ -1,251 +1,487 @@
-package provider
-
-import (
-	"bytes"
-	"encoding/json"
-	"fmt"
-	"io"
-	"net/http"
-)
-
-type Client struct {
-	subdomain string
-	email     string
-	apiToken  string
-	http      *http.Client
-}
-
-type OAuthClient struct {
-	ID          int64  `json:"id"`
-	Name        string `json:"name"`
-	Identifier  string `json:"identifier"`
-	Kind        string `json:"kind"`
-	Description string `json:"description,omitempty"`
-}
-
-type OAuthToken struct {
-	ID        int64    `json:"id"`
-	ClientID  int64    `json:"client_id"`
-	UserID    int64    `json:"user_id"`
-	Scopes    []string `json:"scopes"`
-	FullToken string   `json:"full_token,omitempty"`
-	ExpiresAt string   `json:"expires_at,omitempty"`
-}
-
-type oauthClientWrapper struct {
-	Client OAuthClient `json:"client"`
-}
-
-type oauthTokenWrapper struct {
-	Token OAuthToken `json:"token"`
-}
-
-func NewClient(subdomain, email, apiToken string) *Client {
-	return &Client{
-		subdomain: subdomain,
-		email:     email,
-		apiToken:  apiToken,
-		http:      &http.Client{},
-	}
-}
-
-func (c *Client) CreateOAuthClient(name, identifier, kind, description string) (*OAuthClient, error) {
-	url := fmt.Sprintf("https://%s.zendesk.com/api/v2/oauth/clients.json", c.subdomain)
-	
-	payload := oauthClientWrapper{
-		Client: OAuthClient{
-			Name:        name,
-			Identifier:  identifier,
-			Kind:       kind,
-			Description: description,
-		},
-	}
-	
-	body, err := json.Marshal(payload)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(body))
-	if err != nil {
-		return nil, err
-	}
-
-	req.SetBasicAuth(fmt.Sprintf("%s/token", c.email), c.apiToken)
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.http.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusCreated {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to create OAuth client: %s", string(body))
-	}
-
-	var result oauthClientWrapper
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, err
-	}
-
-	return &result.Client, nil
-}
-
-func (c *Client) ReadOAuthClient(id int64) (*OAuthClient, error) {
-	url := fmt.Sprintf("https://%s.zendesk.com/api/v2/oauth/clients/%d.json", c.subdomain, id)
-
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	req.SetBasicAuth(fmt.Sprintf("%s/token", c.email), c.apiToken)
-
-	resp, err := c.http.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, nil
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to read OAuth client: %s", string(body))
-	}
-
-	var result oauthClientWrapper
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, err
-	}
-
-	return &result.Client, nil
-}
-
-func (c *Client) DeleteOAuthClient(id int64) error {
-	url := fmt.Sprintf("https://%s.zendesk.com/api/v2/oauth/clients/%d.json", c.subdomain, id)
-
-	req, err := http.NewRequest("DELETE", url, nil)
-	if err != nil {
-		return err
-	}
-
-	req.SetBasicAuth(fmt.Sprintf("%s/token", c.email), c.apiToken)
-
-	resp, err := c.http.Do(req)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusNoContent {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to delete OAuth client: %s", string(body))
-	}
-
-	return nil
-}
-
-func (c *Client) CreateOAuthToken(clientID int64, scopes []string, expiresAt string) (*OAuthToken, error) {
-	url := fmt.Sprintf("https://%s.zendesk.com/api/v2/oauth/tokens.json", c.subdomain)
-	
-	payload := oauthTokenWrapper{
-		Token: OAuthToken{
-			ClientID:  clientID,
-			Scopes:    scopes,
-			ExpiresAt: expiresAt,
-		},
-	}
-	
-	body, err := json.Marshal(payload)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(body))
-	if err != nil {
-		return nil, err
-	}
-
-	req.SetBasicAuth(fmt.Sprintf("%s/token", c.email), c.apiToken)
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.http.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusCreated {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to create OAuth token: %s", string(body))
-	}
-
-	var result oauthTokenWrapper
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, err
-	}
-
-	return &result.Token, nil
-}
-
-func (c *Client) ReadOAuthToken(id int64) (*OAuthToken, error) {
-	url := fmt.Sprintf("https://%s.zendesk.com/api/v2/oauth/tokens/%d.json", c.subdomain, id)
-
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	req.SetBasicAuth(fmt.Sprintf("%s/token", c.email), c.apiToken)
-
-	resp, err := c.http.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, nil
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to read OAuth token: %s", string(body))
-	}
-
-	var result oauthTokenWrapper
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, err
-	}
-
-	return &result.Token, nil
-}
-
-func (c *Client) DeleteOAuthToken(id int64) error {
-	url := fmt.Sprintf("https://%s.zendesk.com/api/v2/oauth/tokens/%d.json", c.subdomain, id)
-
-	req, err := http.NewRequest("DELETE", url, nil)
-	if err != nil {
-		return err
-	}
-
-	req.SetBasicAuth(fmt.Sprintf("%s/token", c.email), c.apiToken)
-
-	resp, err := c.http.Do(req)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusNoContent {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to delete OAuth token: %s", string(body))
-	}
-
-	return nil
-} 
\ No newline at end of file
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type Client struct {
+	subdomain string
+	email     string
+	apiToken  string
+	http      *http.Client
+
+	// deactivateOnDelete is the provider-wide default of the deactivate_on_delete argument of
+	// business rule resources.
+	deactivateOnDelete bool
+
+	// validatePlaceholders enables the plan-time check of Liquid placeholders in notification
+	// bodies and macro comments.
+	validatePlaceholders bool
+
+	// validateReferences enables the plan-time check that fields referenced by business rules
+	// exist.
+	validateReferences bool
+
+	// maxRetries is the number of times a request rejected by the rate limit is retried.
+	maxRetries int
+
+	// positionClaims detects rules ordered both by an order resource and by their position.
+	positionClaims positionClaims
+}
+
+type OAuthClient struct {
+	ID           int64    `json:"id"`
+	Name         string   `json:"name"`
+	Identifier   string   `json:"identifier"`
+	Kind         string   `json:"kind"`
+	Description  string   `json:"description,omitempty"`
+	Secret       string   `json:"secret,omitempty"`
+	Company      string   `json:"company,omitempty"`
+	RedirectURIs []string `json:"redirect_uri,omitempty"`
+	Global       bool     `json:"global,omitempty"`
+	CreatedAt    string   `json:"created_at,omitempty"`
+}
+
+type OAuthToken struct {
+	ID        int64    `json:"id"`
+	ClientID  int64    `json:"client_id"`
+	UserID    int64    `json:"user_id"`
+	Scopes    []string `json:"scopes"`
+	FullToken string   `json:"full_token,omitempty"`
+	ExpiresAt string   `json:"expires_at,omitempty"`
+	CreatedAt string   `json:"created_at,omitempty"`
+	UsedAt    string   `json:"used_at,omitempty"`
+}
+
+type oauthClientWrapper struct {
+	Client OAuthClient `json:"client"`
+}
+
+type oauthTokenWrapper struct {
+	Token OAuthToken `json:"token"`
+}
+
+func NewClient(subdomain, email, apiToken string) *Client {
+	return &Client{
+		subdomain:  subdomain,
+		email:      email,
+		apiToken:   apiToken,
+		maxRetries: defaultMaxRetries,
+		http: &http.Client{
+			Transport: &rateLimitTransport{next: newFixtureTransportFromEnv(subdomain, http.DefaultTransport)},
+		},
+	}
+}
+
+// ClientOptions are the provider arguments that configure the client besides its credentials.
+// Both the framework and the SDK provider build their client from them.
+type ClientOptions struct {
+	DeactivateOnDelete   bool
+	ValidatePlaceholders bool
+	ValidateReferences   bool
+	// MaxRetries overrides the number of retries of rate limited requests when not nil.
+	MaxRetries *int64
+}
+
+// NewClientWithOptions returns a client configured with the provider arguments in opts.
+func NewClientWithOptions(subdomain, email, apiToken string, opts ClientOptions) *Client {
+	client := NewClient(subdomain, email, apiToken)
+	client.deactivateOnDelete = opts.DeactivateOnDelete
+	client.validatePlaceholders = opts.ValidatePlaceholders
+	client.validateReferences = opts.ValidateReferences
+	if opts.MaxRetries != nil {
+		client.maxRetries = int(*opts.MaxRetries)
+	}
+	return client
+}
+
+// APIError is returned when the Zendesk API responds with an unexpected status code.
+type APIError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return e.Body
+}
+
+// isNotFound reports whether err, which may be wrapped, is a 404 Not Found error.
+func isNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// isForbidden reports whether err, which may be wrapped, is a 403 Forbidden error.
+func isForbidden(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusForbidden
+}
+
+// isConflict reports whether err, which may be wrapped, is a 409 Conflict error, returned e.g. for
+// duplicate names.
+func isConflict(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusConflict
+}
+
+// validationError is the body of a 422 response, e.g.
+// {"error": "RecordInvalid", "description": "...", "details": {"base": [{"description": "..."}]}}.
+type validationError struct {
+	Description string `json:"description"`
+	Details     map[string][]struct {
+		Description string `json:"description"`
+	} `json:"details"`
+}
+
+// validationMessages returns the messages of a 422 Unprocessable Entity error, which may be
+// wrapped, or nil when err is not one. The messages of details are preferred over the overall
+// description, and the raw body is returned when neither can be decoded.
+func validationMessages(err error) []string {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnprocessableEntity {
+		return nil
+	}
+
+	var body validationError
+	if json.Unmarshal([]byte(apiErr.Body), &body) != nil {
+		return []string{apiErr.Body}
+	}
+
+	var messages []string
+	for _, details := range body.Details {
+		for _, detail := range details {
+			if detail.Description != "" {
+				messages = append(messages, detail.Description)
+			}
+		}
+	}
+	sort.Strings(messages)
+
+	if len(messages) == 0 && body.Description != "" {
+		messages = append(messages, body.Description)
+	}
+	if len(messages) == 0 {
+		messages = append(messages, apiErr.Body)
+	}
+	return messages
+}
+
+func (c *Client) baseURL() string {
+	return fmt.Sprintf("https://%s.zendesk.com", c.subdomain)
+}
+
+// doRequest sends a JSON request to the given API path and decodes the response into out.
+// Any status code outside of the 2xx range is returned as an *APIError. Requests rejected with
+// 429 Too Many Requests are retried after the delay given by Retry-After, up to maxRetries
+// times.
+func (c *Client) doRequest(ctx context.Context, method, path string, in, out interface{}) error {
+	var body []byte
+	var contentType string
+	if in != nil {
+		var err error
+		body, err = json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		contentType = "application/json"
+	}
+
+	return c.send(ctx, method, path, contentType, body, out)
+}
+
+// doMultipartRequest uploads content as the file part named fileField of a multipart form,
+// along with the given form fields, and decodes the JSON response into out.
+func (c *Client) doMultipartRequest(ctx context.Context, method, path string, fields map[string]string, fileField, filename string, content []byte, out interface{}) error {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err := writer.WriteField(key, fields[key]); err != nil {
+			return err
+		}
+	}
+
+	part, err := writer.CreateFormFile(fileField, filename)
+	if err != nil {
+		return err
+	}
+	if _, err := part.Write(content); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	return c.send(ctx, method, path, writer.FormDataContentType(), body.Bytes(), out)
+}
+
+// send performs a request, retrying it while it is rejected by the rate limit.
+func (c *Client) send(ctx context.Context, method, path, contentType string, body []byte, out interface{}) error {
+	requestURL := path
+	if !strings.HasPrefix(path, "https://") {
+		requestURL = c.baseURL() + path
+	}
+
+	for attempt := 0; ; attempt++ {
+		var reqBody io.Reader
+		if body != nil {
+			reqBody = bytes.NewReader(body)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, method, requestURL, reqBody)
+		if err != nil {
+			return err
+		}
+
+		req.SetBasicAuth(fmt.Sprintf("%s/token", c.email), c.apiToken)
+		if contentType != "" {
+			req.Header.Set("Content-Type", contentType)
+		}
+
+		resp, err := c.http.Do(req)
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests && attempt < c.maxRetries {
+			delay := retryAfter(resp.Header, attempt)
+			resp.Body.Close()
+
+			usage.recordRetry()
+			tflog.Debug(ctx, "Zendesk API rate limit reached, retrying", map[string]interface{}{
+				"method":  method,
+				"path":    path,
+				"attempt": attempt + 1,
+				"delay":   delay.String(),
+			})
+
+			if err := sleepContext(ctx, delay); err != nil {
+				return err
+			}
+			continue
+		}
+
+		return decodeResponse(resp, out)
+	}
+}
+
+// decodeResponse decodes a response into out and closes its body, returning status codes
+// outside of the 2xx range as an *APIError.
+func decodeResponse(resp *http.Response, out interface{}) error {
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(resp.Body)
+		return &APIError{StatusCode: resp.StatusCode, Body: string(body)}
+	}
+
+	if out == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+type paginationLinks struct {
+	NextPage string `json:"next_page"`
+	Meta     struct {
+		HasMore bool `json:"has_more"`
+	} `json:"meta"`
+	Links struct {
+		Next string `json:"next"`
+	} `json:"links"`
+}
+
+// paginate requests path and follows the next page links of both cursor and offset based
+// pagination, calling fn with every page until fn returns false or there are no more pages.
+func (c *Client) paginate(ctx context.Context, path string, fn func(page map[string]json.RawMessage) (bool, error)) error {
+	next := path
+	for next != "" {
+		var raw json.RawMessage
+		if err := c.doRequest(ctx, "GET", next, nil, &raw); err != nil {
+			return err
+		}
+
+		var page map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &page); err != nil {
+			return err
+		}
+
+		more, err := fn(page)
+		if err != nil || !more {
+			return err
+		}
+
+		var links paginationLinks
+		if err := json.Unmarshal(raw, &links); err != nil {
+			return err
+		}
+
+		switch {
+		case links.Meta.HasMore && links.Links.Next != "":
+			next = links.Links.Next
+		case links.NextPage != "":
+			next = links.NextPage
+		default:
+			next = ""
+		}
+	}
+
+	return nil
+}
+
+// listAll collects the items stored under key in every page of a paginated list endpoint.
+func listAll[T any](ctx context.Context, c *Client, path, key string) ([]T, error) {
+	var items []T
+	err := c.paginate(ctx, path, func(page map[string]json.RawMessage) (bool, error) {
+		var batch []T
+		if raw, ok := page[key]; ok {
+			if err := json.Unmarshal(raw, &batch); err != nil {
+				return false, err
+			}
+		}
+		items = append(items, batch...)
+		return true, nil
+	})
+	return items, err
+}
+
+// listLimited is like listAll but stops after limit items, reporting whether the result was
+// truncated.
+func listLimited[T any](ctx context.Context, c *Client, path, key string, limit int) ([]T, bool, error) {
+	var items []T
+	truncated := false
+	err := c.paginate(ctx, path, func(page map[string]json.RawMessage) (bool, error) {
+		var batch []T
+		if raw, ok := page[key]; ok {
+			if err := json.Unmarshal(raw, &batch); err != nil {
+				return false, err
+			}
+		}
+		for _, item := range batch {
+			if len(items) >= limit {
+				truncated = true
+				return false, nil
+			}
+			items = append(items, item)
+		}
+		return true, nil
+	})
+	return items, truncated, err
+}
+
+func (c *Client) CreateOAuthClient(ctx context.Context, name, identifier, kind, description string) (*OAuthClient, error) {
+	payload := oauthClientWrapper{
+		Client: OAuthClient{
+			Name:        name,
+			Identifier:  identifier,
+			Kind:        kind,
+			Description: description,
+		},
+	}
+
+	var result oauthClientWrapper
+	if err := c.doRequest(ctx, "POST", "/api/v2/oauth/clients.json", payload, &result); err != nil {
+		return nil, fmt.Errorf("failed to create OAuth client: %w", err)
+	}
+
+	return &result.Client, nil
+}
+
+func (c *Client) ReadOAuthClient(ctx context.Context, id int64) (*OAuthClient, error) {
+	var result oauthClientWrapper
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/v2/oauth/clients/%d.json", id), nil, &result); err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read OAuth client: %w", err)
+	}
+
+	return &result.Client, nil
+}
+
+func (c *Client) ListOAuthClients(ctx context.Context) ([]OAuthClient, error) {
+	clients, err := listAll[OAuthClient](ctx, c, "/api/v2/oauth/clients.json?page[size]=100", "clients")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list OAuth clients: %w", err)
+	}
+
+	return clients, nil
+}
+
+func (c *Client) DeleteOAuthClient(ctx context.Context, id int64) error {
+	if err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/v2/oauth/clients/%d.json", id), nil, nil); err != nil {
+		return fmt.Errorf("failed to delete OAuth client: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) CreateOAuthToken(ctx context.Context, clientID int64, scopes []string, expiresAt string) (*OAuthToken, error) {
+	payload := oauthTokenWrapper{
+		Token: OAuthToken{
+			ClientID:  clientID,
+			Scopes:    scopes,
+			ExpiresAt: expiresAt,
+		},
+	}
+
+	var result oauthTokenWrapper
+	if err := c.doRequest(ctx, "POST", "/api/v2/oauth/tokens.json", payload, &result); err != nil {
+		return nil, fmt.Errorf("failed to create OAuth token: %w", err)
+	}
+
+	return &result.Token, nil
+}
+
+func (c *Client) ReadOAuthToken(ctx context.Context, id int64) (*OAuthToken, error) {
+	var result oauthTokenWrapper
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/v2/oauth/tokens/%d.json", id), nil, &result); err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read OAuth token: %w", err)
+	}
+
+	return &result.Token, nil
+}
+
+// ListOAuthTokens lists the OAuth tokens of the account. When clientID is not zero only the
+// tokens issued to that client are returned.
+func (c *Client) ListOAuthTokens(ctx context.Context, clientID int64) ([]OAuthToken, error) {
+	path := "/api/v2/oauth/tokens.json?page[size]=100"
+	if clientID != 0 {
+		path += fmt.Sprintf("&client_id=%d", clientID)
+	}
+
+	tokens, err := listAll[OAuthToken](ctx, c, path, "tokens")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list OAuth tokens: %w", err)
+	}
+
+	return tokens, nil
+}
+
+func (c *Client) DeleteOAuthToken(ctx context.Context, id int64) error {
+	if err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/v2/oauth/tokens/%d.json", id), nil, nil); err != nil {
+		return fmt.Errorf("failed to delete OAuth token: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+type Account struct {
+	Name         string `json:"name"`
+	Subdomain    string `json:"subdomain"`
+	URL          string `json:"url"`
+	OwnerID      int64  `json:"owner_id"`
+	Sandbox      bool   `json:"sandbox"`
+	Multiproduct bool   `json:"multiproduct"`
+}
+
+// AccountSubscription describes the plan of the account. It is only readable by the account
+// owner and admins with billing access.
+type AccountSubscription struct {
+	PlanName  string `json:"plan_name"`
+	PlanType  string `json:"plan_type"`
+	MaxAgents int64  `json:"max_agents"`
+}
+
+type accountWrapper struct {
+	Account Account `json:"account"`
+}
+
+type accountSubscriptionWrapper struct {
+	Subscription AccountSubscription `json:"subscription"`
+}
+
+func (c *Client) ReadAccount(ctx context.Context) (*Account, error) {
+	var result accountWrapper
+	if err := c.doRequest(ctx, "GET", "/api/v2/account.json", nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to read account: %w", err)
+	}
+
+	return &result.Account, nil
+}
+
+// ReadAccountSubscription returns nil when the subscription is not visible to the
+// authenticated user.
+func (c *Client) ReadAccountSubscription(ctx context.Context) (*AccountSubscription, error) {
+	var result accountSubscriptionWrapper
+	if err := c.doRequest(ctx, "GET", "/api/v2/account/subscription.json", nil, &result); err != nil {
+		if isForbidden(err) || isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read account subscription: %w", err)
+	}
+
+	return &result.Subscription, nil
+}
+
+// CountAgents returns the number of agents and admins of the account.
+func (c *Client) CountAgents(ctx context.Context) (int64, error) {
+	query := url.Values{}
+	query.Add("role[]", "agent")
+	query.Add("role[]", "admin")
+
+	var result countWrapper
+	if err := c.doRequest(ctx, "GET", "/api/v2/users/count.json?"+query.Encode(), nil, &result); err != nil {
+		return 0, fmt.Errorf("failed to count agents: %w", err)
+	}
+
+	return result.Count.Value, nil
+}
+
+// HasCustomRoles reports whether the plan of the account includes custom roles, which the
+// custom roles endpoint answers with a 403 when it does not.
+func (c *Client) HasCustomRoles(ctx context.Context) (bool, error) {
+	if err := c.doRequest(ctx, "GET", "/api/v2/custom_roles.json", nil, nil); err != nil {
+		if isForbidden(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to list custom roles: %w", err)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+)
+
+// AccountSettings holds the account settings, keyed by section and then by setting name.
+type AccountSettings map[string]map[string]interface{}
+
+type accountSettingsWrapper struct {
+	Settings AccountSettings `json:"settings"`
+}
+
+func (c *Client) ReadAccountSettings(ctx context.Context) (AccountSettings, error) {
+	var result accountSettingsWrapper
+	if err := c.doRequest(ctx, "GET", "/api/v2/account/settings.json", nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to read account settings: %w", err)
+	}
+
+	return result.Settings, nil
+}
+
+// UpdateAccountSettings updates the given settings, leaving the others unchanged.
+func (c *Client) UpdateAccountSettings(ctx context.Context, settings AccountSettings) (AccountSettings, error) {
+	var result accountSettingsWrapper
+	if err := c.doRequest(ctx, "PUT", "/api/v2/account/settings.json", accountSettingsWrapper{Settings: settings}, &result); err != nil {
+		return nil, fmt.Errorf("failed to update account settings: %w", err)
+	}
+
+	return result.Settings, nil
+}
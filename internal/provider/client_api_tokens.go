@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+)
+
+// APIToken is an account-level API token. The token value is only returned when it is created.
+type APIToken struct {
+	ID          int64  `json:"id,omitempty"`
+	Description string `json:"description"`
+	Token       string `json:"token,omitempty"`
+	Active      bool   `json:"active,omitempty"`
+	CreatedAt   string `json:"created_at,omitempty"`
+}
+
+type apiTokenWrapper struct {
+	APIToken APIToken `json:"api_token"`
+}
+
+func (c *Client) ReadAPIToken(ctx context.Context, id int64) (*APIToken, error) {
+	var result apiTokenWrapper
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/v2/api_tokens/%d.json", id), nil, &result); err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read API token: %w", err)
+	}
+
+	return &result.APIToken, nil
+}
+
+func (c *Client) CreateAPIToken(ctx context.Context, description string) (*APIToken, error) {
+	var result apiTokenWrapper
+	payload := apiTokenWrapper{APIToken: APIToken{Description: description}}
+	if err := c.doRequest(ctx, "POST", "/api/v2/api_tokens.json", payload, &result); err != nil {
+		return nil, fmt.Errorf("failed to create API token: %w", err)
+	}
+
+	return &result.APIToken, nil
+}
+
+func (c *Client) UpdateAPIToken(ctx context.Context, id int64, description string) (*APIToken, error) {
+	var result apiTokenWrapper
+	payload := apiTokenWrapper{APIToken: APIToken{Description: description}}
+	if err := c.doRequest(ctx, "PUT", fmt.Sprintf("/api/v2/api_tokens/%d.json", id), payload, &result); err != nil {
+		return nil, fmt.Errorf("failed to update API token: %w", err)
+	}
+
+	return &result.APIToken, nil
+}
+
+// DeleteAPIToken revokes the API token.
+func (c *Client) DeleteAPIToken(ctx context.Context, id int64) error {
+	if err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/v2/api_tokens/%d.json", id), nil, nil); err != nil {
+		if isNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete API token: %w", err)
+	}
+
+	return nil
+}
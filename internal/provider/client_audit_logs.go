@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+type AuditLog struct {
+	ID                int64  `json:"id"`
+	ActorID           int64  `json:"actor_id"`
+	ActorName         string `json:"actor_name"`
+	Action            string `json:"action"`
+	SourceID          int64  `json:"source_id"`
+	SourceType        string `json:"source_type"`
+	SourceLabel       string `json:"source_label"`
+	ChangeDescription string `json:"change_description"`
+	IPAddress         string `json:"ip_address"`
+	CreatedAt         string `json:"created_at"`
+}
+
+// ListAuditLogs returns the audit log entries matching the given filters, newest first. It stops
+// after limit entries and reports whether the result was truncated.
+func (c *Client) ListAuditLogs(ctx context.Context, params url.Values, limit int) ([]AuditLog, bool, error) {
+	query := url.Values{}
+	for key, values := range params {
+		query[key] = values
+	}
+	query.Set("sort", "-created_at")
+	query.Set("page[size]", "100")
+
+	logs, truncated, err := listLimited[AuditLog](ctx, c, "/api/v2/audit_logs.json?"+query.Encode(), "audit_logs", limit)
+	if err != nil {
+		if isForbidden(err) {
+			return nil, false, fmt.Errorf("audit logs require a Zendesk Enterprise plan: %w", err)
+		}
+		return nil, false, fmt.Errorf("failed to list audit logs: %w", err)
+	}
+
+	return logs, truncated, nil
+}
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+type Automation struct {
+	ID         int64          `json:"id,omitempty"`
+	Title      string         `json:"title"`
+	Active     bool           `json:"active"`
+	Position   *int64         `json:"position,omitempty"`
+	Conditions RuleConditions `json:"conditions"`
+	Actions    []RuleAction   `json:"actions"`
+	UpdatedAt  string         `json:"updated_at,omitempty"`
+}
+
+type automationWrapper struct {
+	Automation Automation `json:"automation"`
+}
+
+func (c *Client) CreateAutomation(ctx context.Context, automation Automation) (*Automation, error) {
+	var result automationWrapper
+	if err := c.doRequest(ctx, "POST", "/api/v2/automations.json", automationWrapper{Automation: automation}, &result); err != nil {
+		return nil, fmt.Errorf("failed to create automation: %w", err)
+	}
+
+	return &result.Automation, nil
+}
+
+func (c *Client) ReadAutomation(ctx context.Context, id int64) (*Automation, error) {
+	var result automationWrapper
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/v2/automations/%d.json", id), nil, &result); err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read automation: %w", err)
+	}
+
+	return &result.Automation, nil
+}
+
+func (c *Client) UpdateAutomation(ctx context.Context, id int64, automation Automation) (*Automation, error) {
+	var result automationWrapper
+	if err := c.doRequest(ctx, "PUT", fmt.Sprintf("/api/v2/automations/%d.json", id), automationWrapper{Automation: automation}, &result); err != nil {
+		return nil, fmt.Errorf("failed to update automation: %w", err)
+	}
+
+	return &result.Automation, nil
+}
+
+func (c *Client) DeleteAutomation(ctx context.Context, id int64) error {
+	if err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/v2/automations/%d.json", id), nil, nil); err != nil {
+		return fmt.Errorf("failed to delete automation: %w", err)
+	}
+
+	return nil
+}
+
+// ListAutomations returns the automations matching the given filters (active).
+func (c *Client) ListAutomations(ctx context.Context, params url.Values) ([]Automation, error) {
+	query := url.Values{}
+	for key, values := range params {
+		query[key] = values
+	}
+	query.Set("page[size]", "100")
+
+	automations, err := listAll[Automation](ctx, c, "/api/v2/automations.json?"+query.Encode(), "automations")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list automations: %w", err)
+	}
+
+	return automations, nil
+}
+
+// ReorderAutomations positions the automations in the given order, in a single request.
+// Positions start at 1.
+func (c *Client) ReorderAutomations(ctx context.Context, ids []int64) error {
+	type automationPosition struct {
+		ID       int64 `json:"id"`
+		Position int64 `json:"position"`
+	}
+
+	payload := struct {
+		Automations []automationPosition `json:"automations"`
+	}{Automations: make([]automationPosition, 0, len(ids))}
+	for i, id := range ids {
+		payload.Automations = append(payload.Automations, automationPosition{ID: id, Position: int64(i + 1)})
+	}
+
+	if err := c.doRequest(ctx, "PUT", "/api/v2/automations/update_many.json", payload, nil); err != nil {
+		return fmt.Errorf("failed to reorder automations: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+)
+
+// BrandAgent is the membership of an agent in a brand.
+type BrandAgent struct {
+	ID      int64 `json:"id,omitempty"`
+	BrandID int64 `json:"brand_id"`
+	UserID  int64 `json:"user_id"`
+}
+
+type brandAgentWrapper struct {
+	BrandAgent BrandAgent `json:"brand_agent"`
+}
+
+func (c *Client) ListBrandAgents(ctx context.Context, brandID int64) ([]BrandAgent, error) {
+	agents, err := listAll[BrandAgent](ctx, c, fmt.Sprintf("/api/v2/brands/%d/agents.json", brandID), "brand_agents")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list brand agents: %w", err)
+	}
+
+	return agents, nil
+}
+
+func (c *Client) ReadBrandAgent(ctx context.Context, id int64) (*BrandAgent, error) {
+	var result brandAgentWrapper
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/v2/brand_agents/%d.json", id), nil, &result); err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read brand agent: %w", err)
+	}
+
+	return &result.BrandAgent, nil
+}
+
+func (c *Client) CreateBrandAgent(ctx context.Context, brandID, userID int64) (*BrandAgent, error) {
+	var result brandAgentWrapper
+	payload := brandAgentWrapper{BrandAgent: BrandAgent{BrandID: brandID, UserID: userID}}
+	if err := c.doRequest(ctx, "POST", "/api/v2/brand_agents.json", payload, &result); err != nil {
+		return nil, fmt.Errorf("failed to create brand agent: %w", err)
+	}
+
+	return &result.BrandAgent, nil
+}
+
+// DeleteBrandAgent removes the agent from the brand. A membership that no longer exists is not
+// an error.
+func (c *Client) DeleteBrandAgent(ctx context.Context, id int64) error {
+	if err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/v2/brand_agents/%d.json", id), nil, nil); err != nil {
+		if isNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete brand agent: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+)
+
+type Brand struct {
+	ID                int64  `json:"id,omitempty"`
+	Name              string `json:"name"`
+	Subdomain         string `json:"subdomain"`
+	BrandURL          string `json:"brand_url,omitempty"`
+	HostMapping       string `json:"host_mapping,omitempty"`
+	Active            bool   `json:"active"`
+	Default           bool   `json:"default"`
+	HasHelpCenter     bool   `json:"has_help_center"`
+	SignatureTemplate string `json:"signature_template,omitempty"`
+}
+
+type brandWrapper struct {
+	Brand Brand `json:"brand"`
+}
+
+func (c *Client) ReadBrand(ctx context.Context, id int64) (*Brand, error) {
+	var result brandWrapper
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/v2/brands/%d.json", id), nil, &result); err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read brand: %w", err)
+	}
+
+	return &result.Brand, nil
+}
+
+func (c *Client) CreateBrand(ctx context.Context, brand Brand) (*Brand, error) {
+	var result brandWrapper
+	if err := c.doRequest(ctx, "POST", "/api/v2/brands.json", brandWrapper{Brand: brand}, &result); err != nil {
+		return nil, fmt.Errorf("failed to create brand: %w", err)
+	}
+
+	return &result.Brand, nil
+}
+
+func (c *Client) UpdateBrand(ctx context.Context, id int64, brand Brand) (*Brand, error) {
+	var result brandWrapper
+	if err := c.doRequest(ctx, "PUT", fmt.Sprintf("/api/v2/brands/%d.json", id), brandWrapper{Brand: brand}, &result); err != nil {
+		return nil, fmt.Errorf("failed to update brand: %w", err)
+	}
+
+	return &result.Brand, nil
+}
+
+func (c *Client) DeleteBrand(ctx context.Context, id int64) error {
+	if err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/v2/brands/%d.json", id), nil, nil); err != nil {
+		return fmt.Errorf("failed to delete brand: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) ListBrands(ctx context.Context) ([]Brand, error) {
+	brands, err := listAll[Brand](ctx, c, "/api/v2/brands.json?page[size]=100", "brands")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list brands: %w", err)
+	}
+
+	return brands, nil
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// ContentTag IDs are opaque strings.
+type ContentTag struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name"`
+}
+
+type contentTagWrapper struct {
+	ContentTag ContentTag `json:"content_tag"`
+}
+
+func (c *Client) ListContentTags(ctx context.Context) ([]ContentTag, error) {
+	tags, err := listAll[ContentTag](ctx, c, "/api/v2/guide/content_tags.json?page[size]=30", "records")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list content tags: %w", err)
+	}
+
+	return tags, nil
+}
+
+func (c *Client) ReadContentTag(ctx context.Context, id string) (*ContentTag, error) {
+	var result contentTagWrapper
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/v2/guide/content_tags/%s.json", url.PathEscape(id)), nil, &result); err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read content tag: %w", err)
+	}
+
+	return &result.ContentTag, nil
+}
+
+func (c *Client) CreateContentTag(ctx context.Context, tag ContentTag) (*ContentTag, error) {
+	var result contentTagWrapper
+	if err := c.doRequest(ctx, "POST", "/api/v2/guide/content_tags.json", contentTagWrapper{ContentTag: tag}, &result); err != nil {
+		return nil, fmt.Errorf("failed to create content tag: %w", err)
+	}
+
+	return &result.ContentTag, nil
+}
+
+func (c *Client) UpdateContentTag(ctx context.Context, id string, tag ContentTag) (*ContentTag, error) {
+	var result contentTagWrapper
+	if err := c.doRequest(ctx, "PUT", fmt.Sprintf("/api/v2/guide/content_tags/%s.json", url.PathEscape(id)), contentTagWrapper{ContentTag: tag}, &result); err != nil {
+		return nil, fmt.Errorf("failed to update content tag: %w", err)
+	}
+
+	return &result.ContentTag, nil
+}
+
+func (c *Client) DeleteContentTag(ctx context.Context, id string) error {
+	if err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/v2/guide/content_tags/%s.json", url.PathEscape(id)), nil, nil); err != nil {
+		return fmt.Errorf("failed to delete content tag: %w", err)
+	}
+
+	return nil
+}
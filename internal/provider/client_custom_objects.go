@@ -0,0 +1,236 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const customObjectJobMaxItems = 100
+
+type CustomObject struct {
+	Key             string `json:"key"`
+	Title           string `json:"title"`
+	TitlePluralized string `json:"title_pluralized"`
+	Description     string `json:"description,omitempty"`
+}
+
+type CustomObjectRecord struct {
+	ID                 string                 `json:"id,omitempty"`
+	Name               string                 `json:"name,omitempty"`
+	ExternalID         string                 `json:"external_id,omitempty"`
+	CustomObjectFields map[string]interface{} `json:"custom_object_fields,omitempty"`
+}
+
+type CustomObjectRecordJob struct {
+	Action string               `json:"action"`
+	Items  []CustomObjectRecord `json:"items"`
+}
+
+// JobStatusResult is the outcome of one item of a job. Index is the position of the item in
+// the job, and is nil when Zendesk does not report it.
+type JobStatusResult struct {
+	ID         string `json:"id,omitempty"`
+	Index      *int   `json:"index,omitempty"`
+	ExternalID string `json:"external_id,omitempty"`
+	Status     string `json:"status,omitempty"`
+	Success    bool   `json:"success"`
+	Error      string `json:"error,omitempty"`
+	Details    string `json:"details,omitempty"`
+}
+
+type JobStatus struct {
+	ID       string            `json:"id"`
+	Status   string            `json:"status"`
+	Message  string            `json:"message,omitempty"`
+	Total    int               `json:"total"`
+	Progress int               `json:"progress"`
+	Results  []JobStatusResult `json:"results"`
+}
+
+type customObjectRecordJobWrapper struct {
+	Job CustomObjectRecordJob `json:"job"`
+}
+
+type jobStatusWrapper struct {
+	JobStatus JobStatus `json:"job_status"`
+}
+
+// countWrapper is the response of the count endpoints, e.g. /api/v2/users/count.
+type countWrapper struct {
+	Count struct {
+		Value int64 `json:"value"`
+	} `json:"count"`
+}
+
+type customObjectRecordsPage struct {
+	CustomObjectRecords []CustomObjectRecord `json:"custom_object_records"`
+	Meta                struct {
+		HasMore     bool   `json:"has_more"`
+		AfterCursor string `json:"after_cursor"`
+	} `json:"meta"`
+}
+
+type customObjectRecordsSearch struct {
+	Filter json.RawMessage `json:"filter,omitempty"`
+}
+
+// Finished reports whether the job reached a terminal state.
+func (j *JobStatus) Finished() bool {
+	switch j.Status {
+	case "completed", "failed", "killed":
+		return true
+	}
+	return false
+}
+
+// customObjectsError wraps err, explaining 403 responses returned when the custom objects
+// feature is not enabled on the account.
+func customObjectsError(action string, err error) error {
+	if isForbidden(err) {
+		return fmt.Errorf("failed to %s, custom objects may not be enabled on this account: %w", action, err)
+	}
+	return fmt.Errorf("failed to %s: %w", action, err)
+}
+
+func (c *Client) ListCustomObjects(ctx context.Context) ([]CustomObject, error) {
+	objects, err := listAll[CustomObject](ctx, c, "/api/v2/custom_objects", "custom_objects")
+	if err != nil {
+		return nil, customObjectsError("list custom objects", err)
+	}
+
+	return objects, nil
+}
+
+func (c *Client) CountCustomObjectRecords(ctx context.Context, objectKey string) (int64, error) {
+	var result countWrapper
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/v2/custom_objects/%s/records/count", url.PathEscape(objectKey)), nil, &result); err != nil {
+		return 0, customObjectsError("count custom object records", err)
+	}
+
+	return result.Count.Value, nil
+}
+
+func (c *Client) ListCustomObjectFields(ctx context.Context, objectKey string) ([]Field, error) {
+	path := fmt.Sprintf("/api/v2/custom_objects/%s/fields?page[size]=100", url.PathEscape(objectKey))
+	fields, err := listAll[Field](ctx, c, path, "custom_object_fields")
+	if err != nil {
+		return nil, customObjectsError("list custom object fields", err)
+	}
+
+	return fields, nil
+}
+
+func (c *Client) CreateCustomObjectRecordJob(ctx context.Context, objectKey, action string, records []CustomObjectRecord) (*JobStatus, error) {
+	payload := customObjectRecordJobWrapper{
+		Job: CustomObjectRecordJob{
+			Action: action,
+			Items:  records,
+		},
+	}
+
+	var result jobStatusWrapper
+	path := fmt.Sprintf("/api/v2/custom_objects/%s/jobs", url.PathEscape(objectKey))
+	if err := c.doRequest(ctx, "POST", path, payload, &result); err != nil {
+		return nil, fmt.Errorf("failed to create custom object record job: %w", err)
+	}
+
+	return &result.JobStatus, nil
+}
+
+func (c *Client) ReadJobStatus(ctx context.Context, id string) (*JobStatus, error) {
+	var result jobStatusWrapper
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/v2/job_statuses/%s", url.PathEscape(id)), nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to read job status: %w", err)
+	}
+
+	return &result.JobStatus, nil
+}
+
+// ListCustomObjectRecordsByExternalIDs returns the records of an object matching the given
+// external IDs. Records that do not exist are simply absent from the result.
+func (c *Client) ListCustomObjectRecordsByExternalIDs(ctx context.Context, objectKey string, externalIDs []string) ([]CustomObjectRecord, error) {
+	// The filter separates external IDs with commas and has no way to escape them.
+	for _, externalID := range externalIDs {
+		if strings.Contains(externalID, ",") {
+			return nil, fmt.Errorf("failed to list custom object records: external ID %q contains a comma", externalID)
+		}
+	}
+
+	records := make([]CustomObjectRecord, 0, len(externalIDs))
+
+	for start := 0; start < len(externalIDs); start += customObjectJobMaxItems {
+		end := start + customObjectJobMaxItems
+		if end > len(externalIDs) {
+			end = len(externalIDs)
+		}
+
+		query := url.Values{}
+		query.Set("filter[external_ids]", strings.Join(externalIDs[start:end], ","))
+		query.Set("page[size]", fmt.Sprintf("%d", customObjectJobMaxItems))
+
+		var page customObjectRecordsPage
+		path := fmt.Sprintf("/api/v2/custom_objects/%s/records?%s", url.PathEscape(objectKey), query.Encode())
+		if err := c.doRequest(ctx, "GET", path, nil, &page); err != nil {
+			return nil, fmt.Errorf("failed to list custom object records: %w", err)
+		}
+
+		records = append(records, page.CustomObjectRecords...)
+	}
+
+	return records, nil
+}
+
+// SearchCustomObjectRecords returns the records of an object matching query and filter, a
+// JSON filter object as accepted by the records search endpoint. Without either, all records
+// are listed. It stops after limit records and reports whether the result was truncated.
+func (c *Client) SearchCustomObjectRecords(ctx context.Context, objectKey, query string, filter json.RawMessage, limit int) ([]CustomObjectRecord, bool, error) {
+	basePath := fmt.Sprintf("/api/v2/custom_objects/%s/records", url.PathEscape(objectKey))
+
+	if query == "" && len(filter) == 0 {
+		records, truncated, err := listLimited[CustomObjectRecord](ctx, c, basePath+"?page[size]=100", "custom_object_records", limit)
+		if err != nil {
+			return nil, false, customObjectsError("list custom object records", err)
+		}
+
+		return records, truncated, nil
+	}
+
+	var records []CustomObjectRecord
+	truncated := false
+
+	collect := func(batch []CustomObjectRecord) bool {
+		for _, record := range batch {
+			if len(records) >= limit {
+				truncated = true
+				return false
+			}
+			records = append(records, record)
+		}
+		return true
+	}
+
+	// The search endpoint is a POST, so its cursor is followed by hand rather than through the
+	// next links used by paginate.
+	params := url.Values{}
+	if query != "" {
+		params.Set("query", query)
+	}
+	params.Set("page[size]", "100")
+
+	for {
+		var page customObjectRecordsPage
+		if err := c.doRequest(ctx, "POST", basePath+"/search?"+params.Encode(), customObjectRecordsSearch{Filter: filter}, &page); err != nil {
+			return nil, false, customObjectsError("search custom object records", err)
+		}
+
+		if !collect(page.CustomObjectRecords) || !page.Meta.HasMore || page.Meta.AfterCursor == "" {
+			break
+		}
+		params.Set("page[after]", page.Meta.AfterCursor)
+	}
+
+	return records, truncated, nil
+}
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// CustomStatus is a custom ticket status. Default statuses, one per category, cannot be
+// deactivated, and no status can be deleted.
+type CustomStatus struct {
+	ID             int64  `json:"id,omitempty"`
+	StatusCategory string `json:"status_category"`
+	AgentLabel     string `json:"agent_label"`
+	EndUserLabel   string `json:"end_user_label,omitempty"`
+	Description    string `json:"description"`
+	Active         bool   `json:"active"`
+	Default        bool   `json:"default,omitempty"`
+}
+
+type customStatusWrapper struct {
+	CustomStatus CustomStatus `json:"custom_status"`
+}
+
+// ListCustomStatuses returns the custom statuses matching the given filters
+// (status_categories, active). Accounts without custom statuses enabled get an empty list.
+func (c *Client) ListCustomStatuses(ctx context.Context, params url.Values) ([]CustomStatus, error) {
+	path := "/api/v2/custom_statuses.json"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	statuses, err := listAll[CustomStatus](ctx, c, path, "custom_statuses")
+	if err != nil {
+		if isNotFound(err) || isForbidden(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to list custom statuses: %w", err)
+	}
+
+	return statuses, nil
+}
+
+func (c *Client) ReadCustomStatus(ctx context.Context, id int64) (*CustomStatus, error) {
+	var result customStatusWrapper
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/v2/custom_statuses/%d.json", id), nil, &result); err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read custom status: %w", err)
+	}
+
+	return &result.CustomStatus, nil
+}
+
+func (c *Client) CreateCustomStatus(ctx context.Context, status CustomStatus) (*CustomStatus, error) {
+	var result customStatusWrapper
+	if err := c.doRequest(ctx, "POST", "/api/v2/custom_statuses.json", customStatusWrapper{CustomStatus: status}, &result); err != nil {
+		return nil, fmt.Errorf("failed to create custom status: %w", err)
+	}
+
+	return &result.CustomStatus, nil
+}
+
+func (c *Client) UpdateCustomStatus(ctx context.Context, id int64, status CustomStatus) (*CustomStatus, error) {
+	var result customStatusWrapper
+	if err := c.doRequest(ctx, "PUT", fmt.Sprintf("/api/v2/custom_statuses/%d.json", id), customStatusWrapper{CustomStatus: status}, &result); err != nil {
+		return nil, fmt.Errorf("failed to update custom status: %w", err)
+	}
+
+	return &result.CustomStatus, nil
+}
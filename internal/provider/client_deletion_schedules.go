@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+)
+
+// DeletionSchedule permanently deletes the tickets matching its conditions. The API calls
+// the name of the schedule its title.
+type DeletionSchedule struct {
+	ID          int64          `json:"id,omitempty"`
+	Name        string         `json:"title"`
+	Description string         `json:"description"`
+	Active      bool           `json:"active"`
+	Object      string         `json:"object,omitempty"`
+	Conditions  RuleConditions `json:"conditions"`
+}
+
+type deletionScheduleWrapper struct {
+	DeletionSchedule DeletionSchedule `json:"deletion_schedule"`
+}
+
+func (c *Client) ReadDeletionSchedule(ctx context.Context, id int64) (*DeletionSchedule, error) {
+	var result deletionScheduleWrapper
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/v2/deletion_schedules/%d.json", id), nil, &result); err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read deletion schedule: %w", err)
+	}
+
+	return &result.DeletionSchedule, nil
+}
+
+func (c *Client) CreateDeletionSchedule(ctx context.Context, schedule DeletionSchedule) (*DeletionSchedule, error) {
+	var result deletionScheduleWrapper
+	if err := c.doRequest(ctx, "POST", "/api/v2/deletion_schedules.json", deletionScheduleWrapper{DeletionSchedule: schedule}, &result); err != nil {
+		return nil, fmt.Errorf("failed to create deletion schedule: %w", err)
+	}
+
+	return &result.DeletionSchedule, nil
+}
+
+func (c *Client) UpdateDeletionSchedule(ctx context.Context, id int64, schedule DeletionSchedule) (*DeletionSchedule, error) {
+	var result deletionScheduleWrapper
+	if err := c.doRequest(ctx, "PUT", fmt.Sprintf("/api/v2/deletion_schedules/%d.json", id), deletionScheduleWrapper{DeletionSchedule: schedule}, &result); err != nil {
+		return nil, fmt.Errorf("failed to update deletion schedule: %w", err)
+	}
+
+	return &result.DeletionSchedule, nil
+}
+
+func (c *Client) DeleteDeletionSchedule(ctx context.Context, id int64) error {
+	if err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/v2/deletion_schedules/%d.json", id), nil, nil); err != nil {
+		return fmt.Errorf("failed to delete deletion schedule: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+)
+
+type DynamicContentItem struct {
+	ID              int64                   `json:"id,omitempty"`
+	Name            string                  `json:"name"`
+	Placeholder     string                  `json:"placeholder,omitempty"`
+	DefaultLocaleID int64                   `json:"default_locale_id"`
+	Variants        []DynamicContentVariant `json:"variants"`
+}
+
+type DynamicContentVariant struct {
+	ID       int64  `json:"id,omitempty"`
+	Content  string `json:"content"`
+	LocaleID int64  `json:"locale_id"`
+	Active   bool   `json:"active"`
+	Default  bool   `json:"default"`
+}
+
+type dynamicContentItemWrapper struct {
+	Item DynamicContentItem `json:"item"`
+}
+
+type dynamicContentVariantWrapper struct {
+	Variant DynamicContentVariant `json:"variant"`
+}
+
+func (c *Client) CreateDynamicContentItem(ctx context.Context, item DynamicContentItem) (*DynamicContentItem, error) {
+	var result dynamicContentItemWrapper
+	if err := c.doRequest(ctx, "POST", "/api/v2/dynamic_content/items.json", dynamicContentItemWrapper{Item: item}, &result); err != nil {
+		return nil, fmt.Errorf("failed to create dynamic content item: %w", err)
+	}
+
+	return &result.Item, nil
+}
+
+func (c *Client) ReadDynamicContentItem(ctx context.Context, id int64) (*DynamicContentItem, error) {
+	var result dynamicContentItemWrapper
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/v2/dynamic_content/items/%d.json", id), nil, &result); err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read dynamic content item: %w", err)
+	}
+
+	return &result.Item, nil
+}
+
+// UpdateDynamicContentItem updates the name and default locale of an item. Variants are updated
+// separately with UpdateDynamicContentVariant.
+func (c *Client) UpdateDynamicContentItem(ctx context.Context, id int64, item DynamicContentItem) (*DynamicContentItem, error) {
+	payload := struct {
+		Item struct {
+			Name            string `json:"name"`
+			DefaultLocaleID int64  `json:"default_locale_id"`
+		} `json:"item"`
+	}{}
+	payload.Item.Name = item.Name
+	payload.Item.DefaultLocaleID = item.DefaultLocaleID
+
+	var result dynamicContentItemWrapper
+	if err := c.doRequest(ctx, "PUT", fmt.Sprintf("/api/v2/dynamic_content/items/%d.json", id), payload, &result); err != nil {
+		return nil, fmt.Errorf("failed to update dynamic content item: %w", err)
+	}
+
+	return &result.Item, nil
+}
+
+func (c *Client) UpdateDynamicContentVariant(ctx context.Context, itemID int64, variant DynamicContentVariant) (*DynamicContentVariant, error) {
+	var result dynamicContentVariantWrapper
+	path := fmt.Sprintf("/api/v2/dynamic_content/items/%d/variants/%d.json", itemID, variant.ID)
+	if err := c.doRequest(ctx, "PUT", path, dynamicContentVariantWrapper{Variant: variant}, &result); err != nil {
+		return nil, fmt.Errorf("failed to update dynamic content variant: %w", err)
+	}
+
+	return &result.Variant, nil
+}
+
+func (c *Client) DeleteDynamicContentItem(ctx context.Context, id int64) error {
+	if err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/v2/dynamic_content/items/%d.json", id), nil, nil); err != nil {
+		return fmt.Errorf("failed to delete dynamic content item: %w", err)
+	}
+
+	return nil
+}
+
+// DefaultVariant returns the default variant of the item, or nil if it has none.
+func (i *DynamicContentItem) DefaultVariant() *DynamicContentVariant {
+	for j := range i.Variants {
+		if i.Variants[j].Default {
+			return &i.Variants[j]
+		}
+	}
+	return nil
+}
+
+func (c *Client) ListDynamicContentItems(ctx context.Context) ([]DynamicContentItem, error) {
+	items, err := listAll[DynamicContentItem](ctx, c, "/api/v2/dynamic_content/items.json?page[size]=100", "items")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list dynamic content items: %w", err)
+	}
+
+	return items, nil
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+)
+
+// Field describes a ticket, user, organization, or custom object field. The field APIs share
+// the same shape, apart from all but ticket fields being identified by a key.
+type Field struct {
+	ID                 int64               `json:"id,omitempty"`
+	Type               string              `json:"type"`
+	Key                string              `json:"key,omitempty"`
+	Title              string              `json:"title"`
+	Description        string              `json:"description,omitempty"`
+	Active             bool                `json:"active"`
+	Tag                string              `json:"tag,omitempty"`
+	Removable          bool                `json:"removable"`
+	System             bool                `json:"system,omitempty"`
+	Position           int64               `json:"position,omitempty"`
+	CreatedAt          string              `json:"created_at,omitempty"`
+	CustomFieldOptions []CustomFieldOption `json:"custom_field_options,omitempty"`
+}
+
+type CustomFieldOption struct {
+	ID      int64  `json:"id,omitempty"`
+	Name    string `json:"name"`
+	Value   string `json:"value"`
+	Default bool   `json:"default,omitempty"`
+}
+
+type ticketFieldWrapper struct {
+	TicketField Field `json:"ticket_field"`
+}
+
+func (c *Client) ReadTicketField(ctx context.Context, id int64) (*Field, error) {
+	var result ticketFieldWrapper
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/v2/ticket_fields/%d.json", id), nil, &result); err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read ticket field: %w", err)
+	}
+
+	return &result.TicketField, nil
+}
+
+type customFieldOptionWrapper struct {
+	CustomFieldOption CustomFieldOption `json:"custom_field_option"`
+}
+
+// SaveTicketFieldOption creates an option of a dropdown or multiselect ticket field, or updates
+// it when option has an ID, as the options endpoint does both.
+func (c *Client) SaveTicketFieldOption(ctx context.Context, fieldID int64, option CustomFieldOption) (*CustomFieldOption, error) {
+	var result customFieldOptionWrapper
+	if err := c.doRequest(ctx, "POST", fmt.Sprintf("/api/v2/ticket_fields/%d/options.json", fieldID), customFieldOptionWrapper{CustomFieldOption: option}, &result); err != nil {
+		return nil, fmt.Errorf("failed to save ticket field option: %w", err)
+	}
+
+	return &result.CustomFieldOption, nil
+}
+
+func (c *Client) ReadTicketFieldOption(ctx context.Context, fieldID, id int64) (*CustomFieldOption, error) {
+	var result customFieldOptionWrapper
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/v2/ticket_fields/%d/options/%d.json", fieldID, id), nil, &result); err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read ticket field option: %w", err)
+	}
+
+	return &result.CustomFieldOption, nil
+}
+
+func (c *Client) DeleteTicketFieldOption(ctx context.Context, fieldID, id int64) error {
+	if err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/v2/ticket_fields/%d/options/%d.json", fieldID, id), nil, nil); err != nil {
+		return fmt.Errorf("failed to delete ticket field option: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) ListTicketFields(ctx context.Context) ([]Field, error) {
+	fields, err := listAll[Field](ctx, c, "/api/v2/ticket_fields.json?page[size]=100", "ticket_fields")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ticket fields: %w", err)
+	}
+
+	return fields, nil
+}
+
+func (c *Client) ListUserFields(ctx context.Context) ([]Field, error) {
+	fields, err := listAll[Field](ctx, c, "/api/v2/user_fields.json?page[size]=100", "user_fields")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list user fields: %w", err)
+	}
+
+	return fields, nil
+}
+
+func (c *Client) ListOrganizationFields(ctx context.Context) ([]Field, error) {
+	fields, err := listAll[Field](ctx, c, "/api/v2/organization_fields.json?page[size]=100", "organization_fields")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organization fields: %w", err)
+	}
+
+	return fields, nil
+}
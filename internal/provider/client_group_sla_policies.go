@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+)
+
+// GroupSLAPolicy is a group SLA policy (OLA). Unlike SLA policies, its targets are in seconds.
+type GroupSLAPolicy struct {
+	ID            int64                  `json:"id,omitempty"`
+	Title         string                 `json:"title"`
+	Description   string                 `json:"description,omitempty"`
+	Position      int64                  `json:"position,omitempty"`
+	Filter        RuleConditions         `json:"filter"`
+	PolicyMetrics []GroupSLAPolicyMetric `json:"policy_metrics"`
+}
+
+type GroupSLAPolicyMetric struct {
+	Priority        string `json:"priority"`
+	Metric          string `json:"metric"`
+	TargetInSeconds int64  `json:"target_in_seconds"`
+	BusinessHours   bool   `json:"business_hours"`
+}
+
+type groupSLAPolicyWrapper struct {
+	GroupSLAPolicy GroupSLAPolicy `json:"group_sla_policy"`
+}
+
+func (c *Client) CreateGroupSLAPolicy(ctx context.Context, policy GroupSLAPolicy) (*GroupSLAPolicy, error) {
+	var result groupSLAPolicyWrapper
+	if err := c.doRequest(ctx, "POST", "/api/v2/group_slas/policies.json", groupSLAPolicyWrapper{GroupSLAPolicy: policy}, &result); err != nil {
+		return nil, fmt.Errorf("failed to create group SLA policy: %w", err)
+	}
+
+	return &result.GroupSLAPolicy, nil
+}
+
+func (c *Client) ReadGroupSLAPolicy(ctx context.Context, id int64) (*GroupSLAPolicy, error) {
+	var result groupSLAPolicyWrapper
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/v2/group_slas/policies/%d.json", id), nil, &result); err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read group SLA policy: %w", err)
+	}
+
+	return &result.GroupSLAPolicy, nil
+}
+
+func (c *Client) UpdateGroupSLAPolicy(ctx context.Context, id int64, policy GroupSLAPolicy) (*GroupSLAPolicy, error) {
+	var result groupSLAPolicyWrapper
+	if err := c.doRequest(ctx, "PUT", fmt.Sprintf("/api/v2/group_slas/policies/%d.json", id), groupSLAPolicyWrapper{GroupSLAPolicy: policy}, &result); err != nil {
+		return nil, fmt.Errorf("failed to update group SLA policy: %w", err)
+	}
+
+	return &result.GroupSLAPolicy, nil
+}
+
+func (c *Client) DeleteGroupSLAPolicy(ctx context.Context, id int64) error {
+	if err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/v2/group_slas/policies/%d.json", id), nil, nil); err != nil {
+		return fmt.Errorf("failed to delete group SLA policy: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+)
+
+type Group struct {
+	ID          int64  `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	IsPublic    bool   `json:"is_public"`
+	Default     bool   `json:"default,omitempty"`
+	Deleted     bool   `json:"deleted,omitempty"`
+}
+
+type groupWrapper struct {
+	Group Group `json:"group"`
+}
+
+func (c *Client) ReadGroup(ctx context.Context, id int64) (*Group, error) {
+	var result groupWrapper
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/v2/groups/%d.json", id), nil, &result); err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read group: %w", err)
+	}
+
+	return &result.Group, nil
+}
+
+func (c *Client) ListGroups(ctx context.Context) ([]Group, error) {
+	groups, err := listAll[Group](ctx, c, "/api/v2/groups.json?page[size]=100", "groups")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list groups: %w", err)
+	}
+
+	return groups, nil
+}
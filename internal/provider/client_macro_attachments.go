@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+)
+
+// MacroAttachment is a file attached to a macro, which is added to the comment of the tickets the
+// macro is applied to.
+type MacroAttachment struct {
+	ID          int64  `json:"id"`
+	Filename    string `json:"filename"`
+	ContentType string `json:"content_type"`
+	ContentURL  string `json:"content_url"`
+	Size        int64  `json:"size"`
+	CreatedAt   string `json:"created_at"`
+}
+
+type macroAttachmentWrapper struct {
+	MacroAttachment MacroAttachment `json:"macro_attachment"`
+}
+
+type macroAttachmentsWrapper struct {
+	MacroAttachments []MacroAttachment `json:"macro_attachments"`
+}
+
+// ListMacroAttachments returns the attachments of the macro, or nil if the macro does not exist.
+func (c *Client) ListMacroAttachments(ctx context.Context, macroID int64) ([]MacroAttachment, error) {
+	var result macroAttachmentsWrapper
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/v2/macros/%d/attachments.json", macroID), nil, &result); err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to list macro attachments: %w", err)
+	}
+
+	return result.MacroAttachments, nil
+}
+
+// CreateMacroAttachment uploads content as a new attachment of the macro.
+func (c *Client) CreateMacroAttachment(ctx context.Context, macroID int64, filename string, content []byte) (*MacroAttachment, error) {
+	var result macroAttachmentWrapper
+	fields := map[string]string{"filename": filename}
+	if err := c.doMultipartRequest(ctx, "POST", fmt.Sprintf("/api/v2/macros/%d/attachments.json", macroID), fields, "attachment", filename, content, &result); err != nil {
+		return nil, fmt.Errorf("failed to create macro attachment: %w", err)
+	}
+
+	return &result.MacroAttachment, nil
+}
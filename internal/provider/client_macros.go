@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+type Macro struct {
+	ID          int64        `json:"id,omitempty"`
+	Title       string       `json:"title"`
+	Active      bool         `json:"active"`
+	Position    *int64       `json:"position,omitempty"`
+	Description string       `json:"description,omitempty"`
+	Actions     []RuleAction `json:"actions"`
+	Restriction *Restriction `json:"restriction,omitempty"`
+}
+
+type macroWrapper struct {
+	Macro Macro `json:"macro"`
+}
+
+func (c *Client) CreateMacro(ctx context.Context, macro Macro) (*Macro, error) {
+	var result macroWrapper
+	if err := c.doRequest(ctx, "POST", "/api/v2/macros.json", macroWrapper{Macro: macro}, &result); err != nil {
+		return nil, fmt.Errorf("failed to create macro: %w", err)
+	}
+
+	return &result.Macro, nil
+}
+
+func (c *Client) ReadMacro(ctx context.Context, id int64) (*Macro, error) {
+	var result macroWrapper
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/v2/macros/%d.json", id), nil, &result); err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read macro: %w", err)
+	}
+
+	return &result.Macro, nil
+}
+
+func (c *Client) UpdateMacro(ctx context.Context, id int64, macro Macro) (*Macro, error) {
+	var result macroWrapper
+	if err := c.doRequest(ctx, "PUT", fmt.Sprintf("/api/v2/macros/%d.json", id), macroWrapper{Macro: macro}, &result); err != nil {
+		return nil, fmt.Errorf("failed to update macro: %w", err)
+	}
+
+	return &result.Macro, nil
+}
+
+func (c *Client) DeleteMacro(ctx context.Context, id int64) error {
+	if err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/v2/macros/%d.json", id), nil, nil); err != nil {
+		return fmt.Errorf("failed to delete macro: %w", err)
+	}
+
+	return nil
+}
+
+// ListMacros returns the macros matching the given filters (active, group_id, category, access).
+func (c *Client) ListMacros(ctx context.Context, params url.Values) ([]Macro, error) {
+	query := url.Values{}
+	for key, values := range params {
+		query[key] = values
+	}
+	query.Set("per_page", "100")
+
+	macros, err := listAll[Macro](ctx, c, "/api/v2/macros.json?"+query.Encode(), "macros")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list macros: %w", err)
+	}
+
+	return macros, nil
+}
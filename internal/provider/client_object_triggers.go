@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+type ObjectTrigger struct {
+	ID          int64          `json:"id,omitempty"`
+	Title       string         `json:"title"`
+	Active      bool           `json:"active"`
+	Description string         `json:"description"`
+	Position    *int64         `json:"position,omitempty"`
+	Conditions  RuleConditions `json:"conditions"`
+	Actions     []RuleAction   `json:"actions"`
+}
+
+type objectTriggerWrapper struct {
+	Trigger ObjectTrigger `json:"trigger"`
+}
+
+func objectTriggersPath(objectKey string) string {
+	return fmt.Sprintf("/api/v2/custom_objects/%s/triggers", url.PathEscape(objectKey))
+}
+
+func (c *Client) CreateObjectTrigger(ctx context.Context, objectKey string, trigger ObjectTrigger) (*ObjectTrigger, error) {
+	var result objectTriggerWrapper
+	if err := c.doRequest(ctx, "POST", objectTriggersPath(objectKey)+".json", objectTriggerWrapper{Trigger: trigger}, &result); err != nil {
+		return nil, fmt.Errorf("failed to create object trigger: %w", err)
+	}
+
+	return &result.Trigger, nil
+}
+
+func (c *Client) ReadObjectTrigger(ctx context.Context, objectKey string, id int64) (*ObjectTrigger, error) {
+	var result objectTriggerWrapper
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("%s/%d.json", objectTriggersPath(objectKey), id), nil, &result); err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read object trigger: %w", err)
+	}
+
+	return &result.Trigger, nil
+}
+
+func (c *Client) UpdateObjectTrigger(ctx context.Context, objectKey string, id int64, trigger ObjectTrigger) (*ObjectTrigger, error) {
+	var result objectTriggerWrapper
+	if err := c.doRequest(ctx, "PUT", fmt.Sprintf("%s/%d.json", objectTriggersPath(objectKey), id), objectTriggerWrapper{Trigger: trigger}, &result); err != nil {
+		return nil, fmt.Errorf("failed to update object trigger: %w", err)
+	}
+
+	return &result.Trigger, nil
+}
+
+func (c *Client) DeleteObjectTrigger(ctx context.Context, objectKey string, id int64) error {
+	if err := c.doRequest(ctx, "DELETE", fmt.Sprintf("%s/%d.json", objectTriggersPath(objectKey), id), nil, nil); err != nil {
+		return fmt.Errorf("failed to delete object trigger: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+type Organization struct {
+	ID                 int64                  `json:"id,omitempty"`
+	Name               string                 `json:"name"`
+	ExternalID         string                 `json:"external_id,omitempty"`
+	DomainNames        []string               `json:"domain_names,omitempty"`
+	Tags               []string               `json:"tags,omitempty"`
+	GroupID            *int64                 `json:"group_id,omitempty"`
+	SharedTickets      bool                   `json:"shared_tickets"`
+	OrganizationFields map[string]interface{} `json:"organization_fields,omitempty"`
+}
+
+type organizationWrapper struct {
+	Organization Organization `json:"organization"`
+}
+
+func (c *Client) ReadOrganization(ctx context.Context, id int64) (*Organization, error) {
+	var result organizationWrapper
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/v2/organizations/%d.json", id), nil, &result); err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read organization: %w", err)
+	}
+
+	return &result.Organization, nil
+}
+
+// SearchOrganizationsByExternalID returns the organizations with the given external ID.
+func (c *Client) SearchOrganizationsByExternalID(ctx context.Context, externalID string) ([]Organization, error) {
+	path := "/api/v2/organizations/search.json?" + url.Values{"external_id": []string{externalID}}.Encode()
+	organizations, err := listAll[Organization](ctx, c, path, "organizations")
+	if err != nil {
+		return nil, fmt.Errorf("failed to search organizations: %w", err)
+	}
+
+	return organizations, nil
+}
+
+// AutocompleteOrganizations returns the organizations whose name starts with the given name.
+func (c *Client) AutocompleteOrganizations(ctx context.Context, name string) ([]Organization, error) {
+	path := "/api/v2/organizations/autocomplete.json?" + url.Values{"name": []string{name}}.Encode()
+	organizations, err := listAll[Organization](ctx, c, path, "organizations")
+	if err != nil {
+		return nil, fmt.Errorf("failed to autocomplete organizations: %w", err)
+	}
+
+	return organizations, nil
+}
+
+// ListOrganizations lists the organizations returned by path, which is either the organizations
+// list endpoint or a search returning organizations. It stops after limit organizations and
+// reports whether the result was truncated.
+func (c *Client) ListOrganizations(ctx context.Context, path, key string, limit int) ([]Organization, bool, error) {
+	organizations, truncated, err := listLimited[Organization](ctx, c, path, key, limit)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to list organizations: %w", err)
+	}
+
+	return organizations, truncated, nil
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// Queue IDs are strings in the API. The groups are returned as primary_groups and
+// secondary_groups, but sent as primary_groups_id and secondary_groups_id.
+type Queue struct {
+	ID                string          `json:"id,omitempty"`
+	Name              string          `json:"name"`
+	Description       string          `json:"description"`
+	Priority          int64           `json:"priority"`
+	Order             int64           `json:"order,omitempty"`
+	Definition        QueueDefinition `json:"definition"`
+	PrimaryGroups     *queueGroups    `json:"primary_groups,omitempty"`
+	SecondaryGroups   *queueGroups    `json:"secondary_groups,omitempty"`
+	PrimaryGroupIDs   []int64         `json:"primary_groups_id"`
+	SecondaryGroupIDs []int64         `json:"secondary_groups_id"`
+}
+
+// QueueDefinition holds the conditions a ticket must meet to enter a queue. Unlike business
+// rule conditions, queue conditions name their field subject.
+type QueueDefinition struct {
+	All []QueueCondition `json:"all"`
+	Any []QueueCondition `json:"any"`
+}
+
+type QueueCondition struct {
+	Subject  string          `json:"subject"`
+	Operator string          `json:"operator"`
+	Value    json.RawMessage `json:"value,omitempty"`
+}
+
+type queueWrapper struct {
+	Queue Queue `json:"queue"`
+}
+
+type queueGroups struct {
+	Groups []struct {
+		ID int64 `json:"id"`
+	} `json:"groups"`
+}
+
+// IDs returns the IDs of the groups.
+func (g *queueGroups) IDs() []int64 {
+	if g == nil {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(g.Groups))
+	for _, group := range g.Groups {
+		ids = append(ids, group.ID)
+	}
+	return ids
+}
+
+func (c *Client) ListQueues(ctx context.Context) ([]Queue, error) {
+	queues, err := listAll[Queue](ctx, c, "/api/v2/queues", "queues")
+	if err != nil {
+		if isForbidden(err) || isNotFound(err) {
+			return nil, fmt.Errorf("failed to list queues, omnichannel routing may not be enabled on this account: %w", err)
+		}
+		return nil, fmt.Errorf("failed to list queues: %w", err)
+	}
+
+	return queues, nil
+}
+
+func (c *Client) ReadQueue(ctx context.Context, id string) (*Queue, error) {
+	var result queueWrapper
+	if err := c.doRequest(ctx, "GET", "/api/v2/queues/"+url.PathEscape(id), nil, &result); err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read queue: %w", err)
+	}
+
+	return &result.Queue, nil
+}
+
+func (c *Client) CreateQueue(ctx context.Context, queue Queue) (*Queue, error) {
+	var result queueWrapper
+	if err := c.doRequest(ctx, "POST", "/api/v2/queues", queueWrapper{Queue: queue}, &result); err != nil {
+		return nil, fmt.Errorf("failed to create queue: %w", err)
+	}
+
+	return &result.Queue, nil
+}
+
+func (c *Client) UpdateQueue(ctx context.Context, id string, queue Queue) (*Queue, error) {
+	var result queueWrapper
+	if err := c.doRequest(ctx, "PUT", "/api/v2/queues/"+url.PathEscape(id), queueWrapper{Queue: queue}, &result); err != nil {
+		return nil, fmt.Errorf("failed to update queue: %w", err)
+	}
+
+	return &result.Queue, nil
+}
+
+func (c *Client) DeleteQueue(ctx context.Context, id string) error {
+	if err := c.doRequest(ctx, "DELETE", "/api/v2/queues/"+url.PathEscape(id), nil, nil); err != nil {
+		return fmt.Errorf("failed to delete queue: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// RoutingAttribute IDs are UUIDs.
+type RoutingAttribute struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name"`
+}
+
+type routingAttributeWrapper struct {
+	Attribute RoutingAttribute `json:"attribute"`
+}
+
+type RoutingAttributeValue struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name"`
+}
+
+type routingAttributeValueWrapper struct {
+	AttributeValue RoutingAttributeValue `json:"attribute_value"`
+}
+
+func (c *Client) ListRoutingAttributes(ctx context.Context) ([]RoutingAttribute, error) {
+	attributes, err := listAll[RoutingAttribute](ctx, c, "/api/v2/routing/attributes.json", "attributes")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list routing attributes: %w", err)
+	}
+
+	return attributes, nil
+}
+
+func (c *Client) ReadRoutingAttribute(ctx context.Context, id string) (*RoutingAttribute, error) {
+	var result routingAttributeWrapper
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/v2/routing/attributes/%s.json", url.PathEscape(id)), nil, &result); err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read routing attribute: %w", err)
+	}
+
+	return &result.Attribute, nil
+}
+
+func (c *Client) CreateRoutingAttribute(ctx context.Context, attribute RoutingAttribute) (*RoutingAttribute, error) {
+	var result routingAttributeWrapper
+	if err := c.doRequest(ctx, "POST", "/api/v2/routing/attributes.json", routingAttributeWrapper{Attribute: attribute}, &result); err != nil {
+		return nil, fmt.Errorf("failed to create routing attribute: %w", err)
+	}
+
+	return &result.Attribute, nil
+}
+
+func (c *Client) UpdateRoutingAttribute(ctx context.Context, id string, attribute RoutingAttribute) (*RoutingAttribute, error) {
+	var result routingAttributeWrapper
+	if err := c.doRequest(ctx, "PUT", fmt.Sprintf("/api/v2/routing/attributes/%s.json", url.PathEscape(id)), routingAttributeWrapper{Attribute: attribute}, &result); err != nil {
+		return nil, fmt.Errorf("failed to update routing attribute: %w", err)
+	}
+
+	return &result.Attribute, nil
+}
+
+func (c *Client) DeleteRoutingAttribute(ctx context.Context, id string) error {
+	if err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/v2/routing/attributes/%s.json", url.PathEscape(id)), nil, nil); err != nil {
+		return fmt.Errorf("failed to delete routing attribute: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) ListRoutingAttributeValues(ctx context.Context, attributeID string) ([]RoutingAttributeValue, error) {
+	path := fmt.Sprintf("/api/v2/routing/attributes/%s/values.json", url.PathEscape(attributeID))
+	values, err := listAll[RoutingAttributeValue](ctx, c, path, "attribute_values")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list routing attribute values: %w", err)
+	}
+
+	return values, nil
+}
+
+// ReadRoutingAttributeValue returns nil if the value or its attribute is not found, as deleting
+// an attribute deletes its values.
+func (c *Client) ReadRoutingAttributeValue(ctx context.Context, attributeID, id string) (*RoutingAttributeValue, error) {
+	var result routingAttributeValueWrapper
+	path := fmt.Sprintf("/api/v2/routing/attributes/%s/values/%s.json", url.PathEscape(attributeID), url.PathEscape(id))
+	if err := c.doRequest(ctx, "GET", path, nil, &result); err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read routing attribute value: %w", err)
+	}
+
+	return &result.AttributeValue, nil
+}
+
+func (c *Client) CreateRoutingAttributeValue(ctx context.Context, attributeID string, value RoutingAttributeValue) (*RoutingAttributeValue, error) {
+	var result routingAttributeValueWrapper
+	path := fmt.Sprintf("/api/v2/routing/attributes/%s/values.json", url.PathEscape(attributeID))
+	if err := c.doRequest(ctx, "POST", path, routingAttributeValueWrapper{AttributeValue: value}, &result); err != nil {
+		return nil, fmt.Errorf("failed to create routing attribute value: %w", err)
+	}
+
+	return &result.AttributeValue, nil
+}
+
+func (c *Client) UpdateRoutingAttributeValue(ctx context.Context, attributeID, id string, value RoutingAttributeValue) (*RoutingAttributeValue, error) {
+	var result routingAttributeValueWrapper
+	path := fmt.Sprintf("/api/v2/routing/attributes/%s/values/%s.json", url.PathEscape(attributeID), url.PathEscape(id))
+	if err := c.doRequest(ctx, "PUT", path, routingAttributeValueWrapper{AttributeValue: value}, &result); err != nil {
+		return nil, fmt.Errorf("failed to update routing attribute value: %w", err)
+	}
+
+	return &result.AttributeValue, nil
+}
+
+func (c *Client) DeleteRoutingAttributeValue(ctx context.Context, attributeID, id string) error {
+	path := fmt.Sprintf("/api/v2/routing/attributes/%s/values/%s.json", url.PathEscape(attributeID), url.PathEscape(id))
+	if err := c.doRequest(ctx, "DELETE", path, nil, nil); err != nil {
+		// Deleting an attribute deletes its values, so the value may already be gone.
+		if isNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete routing attribute value: %w", err)
+	}
+
+	return nil
+}
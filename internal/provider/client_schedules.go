@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+)
+
+type Schedule struct {
+	ID        int64              `json:"id,omitempty"`
+	Name      string             `json:"name"`
+	TimeZone  string             `json:"time_zone"`
+	Intervals []ScheduleInterval `json:"intervals,omitempty"`
+}
+
+// ScheduleInterval is a span of business hours, in minutes since Sunday 00:00.
+type ScheduleInterval struct {
+	StartTime int64 `json:"start_time"`
+	EndTime   int64 `json:"end_time"`
+}
+
+type scheduleWrapper struct {
+	Schedule Schedule `json:"schedule"`
+}
+
+type scheduleWorkweek struct {
+	Workweek struct {
+		Intervals []ScheduleInterval `json:"intervals"`
+	} `json:"workweek"`
+}
+
+type ScheduleHoliday struct {
+	ID        int64  `json:"id,omitempty"`
+	Name      string `json:"name"`
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+}
+
+type scheduleHolidayWrapper struct {
+	Holiday ScheduleHoliday `json:"holiday"`
+}
+
+func (c *Client) ListSchedules(ctx context.Context) ([]Schedule, error) {
+	schedules, err := listAll[Schedule](ctx, c, "/api/v2/business_hours/schedules.json", "schedules")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list schedules: %w", err)
+	}
+
+	return schedules, nil
+}
+
+func (c *Client) ListScheduleHolidays(ctx context.Context, scheduleID int64) ([]ScheduleHoliday, error) {
+	holidays, err := listAll[ScheduleHoliday](ctx, c, fmt.Sprintf("/api/v2/business_hours/schedules/%d/holidays.json", scheduleID), "holidays")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list schedule holidays: %w", err)
+	}
+
+	return holidays, nil
+}
+
+func (c *Client) ReadSchedule(ctx context.Context, id int64) (*Schedule, error) {
+	var result scheduleWrapper
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/v2/business_hours/schedules/%d.json", id), nil, &result); err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read schedule: %w", err)
+	}
+
+	return &result.Schedule, nil
+}
+
+// CreateSchedule creates a schedule with the name and time zone of schedule. Zendesk gives new
+// schedules default business hours, which are replaced with UpdateScheduleWorkweek.
+func (c *Client) CreateSchedule(ctx context.Context, schedule Schedule) (*Schedule, error) {
+	schedule.Intervals = nil
+
+	var result scheduleWrapper
+	if err := c.doRequest(ctx, "POST", "/api/v2/business_hours/schedules.json", scheduleWrapper{Schedule: schedule}, &result); err != nil {
+		return nil, fmt.Errorf("failed to create schedule: %w", err)
+	}
+
+	return &result.Schedule, nil
+}
+
+// UpdateSchedule updates the name and time zone of a schedule. Its intervals can only be
+// changed with UpdateScheduleWorkweek.
+func (c *Client) UpdateSchedule(ctx context.Context, id int64, schedule Schedule) (*Schedule, error) {
+	schedule.Intervals = nil
+
+	var result scheduleWrapper
+	if err := c.doRequest(ctx, "PUT", fmt.Sprintf("/api/v2/business_hours/schedules/%d.json", id), scheduleWrapper{Schedule: schedule}, &result); err != nil {
+		return nil, fmt.Errorf("failed to update schedule: %w", err)
+	}
+
+	return &result.Schedule, nil
+}
+
+// UpdateScheduleWorkweek replaces the intervals of a schedule.
+func (c *Client) UpdateScheduleWorkweek(ctx context.Context, id int64, intervals []ScheduleInterval) ([]ScheduleInterval, error) {
+	var payload scheduleWorkweek
+	payload.Workweek.Intervals = intervals
+
+	var result scheduleWorkweek
+	if err := c.doRequest(ctx, "PUT", fmt.Sprintf("/api/v2/business_hours/schedules/%d/workweek.json", id), payload, &result); err != nil {
+		return nil, fmt.Errorf("failed to update schedule workweek: %w", err)
+	}
+
+	return result.Workweek.Intervals, nil
+}
+
+func (c *Client) DeleteSchedule(ctx context.Context, id int64) error {
+	if err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/v2/business_hours/schedules/%d.json", id), nil, nil); err != nil {
+		return fmt.Errorf("failed to delete schedule: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) ReadScheduleHoliday(ctx context.Context, scheduleID, id int64) (*ScheduleHoliday, error) {
+	var result scheduleHolidayWrapper
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/v2/business_hours/schedules/%d/holidays/%d.json", scheduleID, id), nil, &result); err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read schedule holiday: %w", err)
+	}
+
+	return &result.Holiday, nil
+}
+
+func (c *Client) CreateScheduleHoliday(ctx context.Context, scheduleID int64, holiday ScheduleHoliday) (*ScheduleHoliday, error) {
+	var result scheduleHolidayWrapper
+	if err := c.doRequest(ctx, "POST", fmt.Sprintf("/api/v2/business_hours/schedules/%d/holidays.json", scheduleID), scheduleHolidayWrapper{Holiday: holiday}, &result); err != nil {
+		return nil, fmt.Errorf("failed to create schedule holiday: %w", err)
+	}
+
+	return &result.Holiday, nil
+}
+
+func (c *Client) UpdateScheduleHoliday(ctx context.Context, scheduleID, id int64, holiday ScheduleHoliday) (*ScheduleHoliday, error) {
+	var result scheduleHolidayWrapper
+	if err := c.doRequest(ctx, "PUT", fmt.Sprintf("/api/v2/business_hours/schedules/%d/holidays/%d.json", scheduleID, id), scheduleHolidayWrapper{Holiday: holiday}, &result); err != nil {
+		return nil, fmt.Errorf("failed to update schedule holiday: %w", err)
+	}
+
+	return &result.Holiday, nil
+}
+
+// DeleteScheduleHoliday deletes a holiday. Deleting a schedule deletes its holidays, so a holiday
+// that is not found, e.g. because its schedule was destroyed first, is not an error.
+func (c *Client) DeleteScheduleHoliday(ctx context.Context, scheduleID, id int64) error {
+	if err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/v2/business_hours/schedules/%d/holidays/%d.json", scheduleID, id), nil, nil); err != nil {
+		if isNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete schedule holiday: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// searchResultsCeiling is the maximum number of results the search API returns for a query.
+const searchResultsCeiling = 1000
+
+// SearchResult holds the fields common to every kind of search result, plus the raw hit.
+type SearchResult struct {
+	ID         int64           `json:"id"`
+	ResultType string          `json:"result_type"`
+	Name       string          `json:"name,omitempty"`
+	Subject    string          `json:"subject,omitempty"`
+	Title      string          `json:"title,omitempty"`
+	URL        string          `json:"url"`
+	Raw        json.RawMessage `json:"-"`
+}
+
+// Search runs a search query, returning at most limit results along with the total number of
+// matches reported by the API.
+func (c *Client) Search(ctx context.Context, query string, limit int) ([]SearchResult, int64, error) {
+	var results []SearchResult
+	var count int64
+
+	params := url.Values{}
+	params.Set("query", query)
+	params.Set("per_page", "100")
+
+	err := c.paginate(ctx, "/api/v2/search.json?"+params.Encode(), func(page map[string]json.RawMessage) (bool, error) {
+		if raw, ok := page["count"]; ok {
+			if err := json.Unmarshal(raw, &count); err != nil {
+				return false, err
+			}
+		}
+
+		var batch []json.RawMessage
+		if raw, ok := page["results"]; ok {
+			if err := json.Unmarshal(raw, &batch); err != nil {
+				return false, err
+			}
+		}
+
+		for _, raw := range batch {
+			if len(results) >= limit {
+				return false, nil
+			}
+
+			var result SearchResult
+			if err := json.Unmarshal(raw, &result); err != nil {
+				return false, err
+			}
+			result.Raw = raw
+			results = append(results, result)
+		}
+		return len(results) < limit, nil
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to search: %w", err)
+	}
+
+	return results, count, nil
+}
@@ -0,0 +1,24 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+)
+
+type SharingAgreement struct {
+	ID              int64  `json:"id,omitempty"`
+	Name            string `json:"name"`
+	Type            string `json:"type"`
+	Status          string `json:"status"`
+	PartnerName     string `json:"partner_name,omitempty"`
+	RemoteSubdomain string `json:"remote_subdomain"`
+}
+
+func (c *Client) ListSharingAgreements(ctx context.Context) ([]SharingAgreement, error) {
+	agreements, err := listAll[SharingAgreement](ctx, c, "/api/v2/sharing_agreements.json", "sharing_agreements")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sharing agreements: %w", err)
+	}
+
+	return agreements, nil
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+)
+
+type SLAPolicy struct {
+	ID            int64             `json:"id,omitempty"`
+	Title         string            `json:"title"`
+	Description   string            `json:"description,omitempty"`
+	Position      *int64            `json:"position,omitempty"`
+	Filter        RuleConditions    `json:"filter"`
+	PolicyMetrics []SLAPolicyMetric `json:"policy_metrics"`
+}
+
+type SLAPolicyMetric struct {
+	Priority      string `json:"priority"`
+	Metric        string `json:"metric"`
+	Target        int64  `json:"target"`
+	BusinessHours bool   `json:"business_hours"`
+}
+
+type slaPolicyWrapper struct {
+	SLAPolicy SLAPolicy `json:"sla_policy"`
+}
+
+func (c *Client) CreateSLAPolicy(ctx context.Context, policy SLAPolicy) (*SLAPolicy, error) {
+	var result slaPolicyWrapper
+	if err := c.doRequest(ctx, "POST", "/api/v2/slas/policies.json", slaPolicyWrapper{SLAPolicy: policy}, &result); err != nil {
+		return nil, fmt.Errorf("failed to create SLA policy: %w", err)
+	}
+
+	return &result.SLAPolicy, nil
+}
+
+func (c *Client) ReadSLAPolicy(ctx context.Context, id int64) (*SLAPolicy, error) {
+	var result slaPolicyWrapper
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/v2/slas/policies/%d.json", id), nil, &result); err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read SLA policy: %w", err)
+	}
+
+	return &result.SLAPolicy, nil
+}
+
+func (c *Client) UpdateSLAPolicy(ctx context.Context, id int64, policy SLAPolicy) (*SLAPolicy, error) {
+	var result slaPolicyWrapper
+	if err := c.doRequest(ctx, "PUT", fmt.Sprintf("/api/v2/slas/policies/%d.json", id), slaPolicyWrapper{SLAPolicy: policy}, &result); err != nil {
+		return nil, fmt.Errorf("failed to update SLA policy: %w", err)
+	}
+
+	return &result.SLAPolicy, nil
+}
+
+func (c *Client) DeleteSLAPolicy(ctx context.Context, id int64) error {
+	if err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/v2/slas/policies/%d.json", id), nil, nil); err != nil {
+		return fmt.Errorf("failed to delete SLA policy: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) ListSLAPolicies(ctx context.Context) ([]SLAPolicy, error) {
+	policies, err := listAll[SLAPolicy](ctx, c, "/api/v2/slas/policies.json", "sla_policies")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list SLA policies: %w", err)
+	}
+
+	return policies, nil
+}
+
+// ReorderSLAPolicies orders the SLA policies in a single request. The API requires the IDs of
+// all the policies of the account.
+func (c *Client) ReorderSLAPolicies(ctx context.Context, ids []int64) error {
+	payload := struct {
+		SLAPolicyIDs []int64 `json:"sla_policy_ids"`
+	}{SLAPolicyIDs: ids}
+
+	if err := c.doRequest(ctx, "PUT", "/api/v2/slas/policies/reorder.json", payload, nil); err != nil {
+		return fmt.Errorf("failed to reorder SLA policies: %w", err)
+	}
+
+	return nil
+}
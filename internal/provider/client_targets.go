@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+)
+
+// Target is a legacy notification target. HTTP targets use target_url, URL targets (v2) use url.
+type Target struct {
+	ID          int64  `json:"id,omitempty"`
+	Type        string `json:"type"`
+	Title       string `json:"title"`
+	Active      bool   `json:"active"`
+	TargetURL   string `json:"target_url,omitempty"`
+	URL         string `json:"url,omitempty"`
+	Method      string `json:"method,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+	Attribute   string `json:"attribute,omitempty"`
+	Username    string `json:"username,omitempty"`
+	Password    string `json:"password,omitempty"`
+	Email       string `json:"email,omitempty"`
+	Subject     string `json:"subject,omitempty"`
+}
+
+type targetWrapper struct {
+	Target Target `json:"target"`
+}
+
+func (c *Client) ReadTarget(ctx context.Context, id int64) (*Target, error) {
+	var result targetWrapper
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/v2/targets/%d.json", id), nil, &result); err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read target: %w", err)
+	}
+
+	return &result.Target, nil
+}
+
+func (c *Client) CreateTarget(ctx context.Context, target Target) (*Target, error) {
+	var result targetWrapper
+	if err := c.doRequest(ctx, "POST", "/api/v2/targets.json", targetWrapper{Target: target}, &result); err != nil {
+		return nil, fmt.Errorf("failed to create target: %w", err)
+	}
+
+	return &result.Target, nil
+}
+
+func (c *Client) UpdateTarget(ctx context.Context, id int64, target Target) (*Target, error) {
+	var result targetWrapper
+	if err := c.doRequest(ctx, "PUT", fmt.Sprintf("/api/v2/targets/%d.json", id), targetWrapper{Target: target}, &result); err != nil {
+		return nil, fmt.Errorf("failed to update target: %w", err)
+	}
+
+	return &result.Target, nil
+}
+
+func (c *Client) DeleteTarget(ctx context.Context, id int64) error {
+	if err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/v2/targets/%d.json", id), nil, nil); err != nil {
+		return fmt.Errorf("failed to delete target: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// redirectTransport sends requests to a test server instead of Zendesk.
+type redirectTransport struct {
+	target *url.URL
+}
+
+func (t redirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Scheme = t.target.Scheme
+	req.URL.Host = t.target.Host
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+// newTestClient returns a client whose requests are served by handler.
+func newTestClient(t *testing.T, handler http.Handler) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	target, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := NewClient("example", "agent@example.com", "token")
+	client.http = &http.Client{Transport: &rateLimitTransport{next: redirectTransport{target: target}}}
+	return client
+}
+
+func TestAPIErrorStatusWrapped(t *testing.T) {
+	tests := []struct {
+		status    int
+		notFound  bool
+		forbidden bool
+	}{
+		{status: http.StatusNotFound, notFound: true},
+		{status: http.StatusForbidden, forbidden: true},
+		{status: http.StatusInternalServerError},
+	}
+
+	for _, test := range tests {
+		err := fmt.Errorf("failed to read thing: %w", &APIError{StatusCode: test.status})
+		if got := isNotFound(err); got != test.notFound {
+			t.Errorf("isNotFound(%d) = %t, want %t", test.status, got, test.notFound)
+		}
+		if got := isForbidden(err); got != test.forbidden {
+			t.Errorf("isForbidden(%d) = %t, want %t", test.status, got, test.forbidden)
+		}
+	}
+}
+
+func TestListCustomObjectRecordsByExternalIDs(t *testing.T) {
+	var filter string
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		filter = r.URL.Query().Get("filter[external_ids]")
+		fmt.Fprint(w, `{"custom_object_records": [{"id": "1", "external_id": "a"}, {"id": "2", "external_id": "b"}]}`)
+	}))
+
+	records, err := client.ListCustomObjectRecordsByExternalIDs(context.Background(), "car", []string{"a", "b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if filter != "a,b" {
+		t.Errorf("filter = %q, want %q", filter, "a,b")
+	}
+	if len(records) != 2 {
+		t.Errorf("got %d records, want 2", len(records))
+	}
+}
+
+func TestListCustomObjectRecordsByExternalIDsNotFound(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"error": "RecordNotFound"}`, http.StatusNotFound)
+	}))
+
+	_, err := client.ListCustomObjectRecordsByExternalIDs(context.Background(), "car", []string{"a"})
+	if !isNotFound(err) {
+		t.Errorf("isNotFound(%v) = false, want true", err)
+	}
+}
+
+func TestListCustomObjectRecordsByExternalIDsRejectsCommas(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL)
+	}))
+
+	if _, err := client.ListCustomObjectRecordsByExternalIDs(context.Background(), "car", []string{"a,b"}); err == nil {
+		t.Error("expected an error for an external ID containing a comma")
+	}
+}
+
+func TestRateLimitedRequestRetried(t *testing.T) {
+	attempts := 0
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{"trigger": {"id": 1, "title": "Tag new tickets", "active": true}}`)
+	}))
+
+	trigger, err := client.ReadTrigger(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if trigger.Title != "Tag new tickets" {
+		t.Errorf("unexpected trigger %+v", trigger)
+	}
+	if attempts != 2 {
+		t.Errorf("expected 2 attempts, got %d", attempts)
+	}
+}
+
+func TestRateLimitedRequestGivesUp(t *testing.T) {
+	attempts := 0
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	client.maxRetries = 2
+
+	_, err := client.ReadTrigger(context.Background(), 1)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("expected a 429 error, got %v", err)
+	}
+	if attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestNewClientWithOptions(t *testing.T) {
+	maxRetries := int64(0)
+	client := NewClientWithOptions("example", "agent@example.com", "token", ClientOptions{
+		DeactivateOnDelete:   true,
+		ValidatePlaceholders: true,
+		ValidateReferences:   true,
+		MaxRetries:           &maxRetries,
+	})
+	if !client.deactivateOnDelete || !client.validatePlaceholders || !client.validateReferences || client.maxRetries != 0 {
+		t.Errorf("options not applied: %+v", client)
+	}
+
+	if client := NewClientWithOptions("example", "agent@example.com", "token", ClientOptions{}); client.maxRetries != defaultMaxRetries {
+		t.Errorf("expected %d retries by default, got %d", defaultMaxRetries, client.maxRetries)
+	}
+}
+
+func TestCancelledRequestAborted(t *testing.T) {
+	started := make(chan struct{})
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-r.Context().Done()
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-started
+		cancel()
+	}()
+
+	_, err := client.ReadTrigger(ctx, 1)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the request to be cancelled, got %v", err)
+	}
+}
+
+func TestCancelledRetryAborted(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	attempts := 0
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+		// The client is now about to wait a minute before retrying.
+		time.AfterFunc(10*time.Millisecond, cancel)
+	}))
+
+	start := time.Now()
+	_, err := client.ReadTrigger(ctx, 1)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the retry to be cancelled, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("expected the wait to end on cancellation, took %s", elapsed)
+	}
+	if attempts != 1 {
+		t.Errorf("expected 1 attempt, got %d", attempts)
+	}
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+)
+
+type TicketForm struct {
+	ID                 int64   `json:"id,omitempty"`
+	Name               string  `json:"name"`
+	DisplayName        string  `json:"display_name,omitempty"`
+	Position           *int64  `json:"position,omitempty"`
+	Active             bool    `json:"active"`
+	Default            bool    `json:"default"`
+	EndUserVisible     bool    `json:"end_user_visible"`
+	InAllBrands        bool    `json:"in_all_brands"`
+	RestrictedBrandIDs []int64 `json:"restricted_brand_ids"`
+	TicketFieldIDs     []int64 `json:"ticket_field_ids"`
+}
+
+type ticketFormWrapper struct {
+	TicketForm TicketForm `json:"ticket_form"`
+}
+
+func (c *Client) ReadTicketForm(ctx context.Context, id int64) (*TicketForm, error) {
+	var result ticketFormWrapper
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/v2/ticket_forms/%d.json", id), nil, &result); err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read ticket form: %w", err)
+	}
+
+	return &result.TicketForm, nil
+}
+
+func (c *Client) CreateTicketForm(ctx context.Context, form TicketForm) (*TicketForm, error) {
+	var result ticketFormWrapper
+	if err := c.doRequest(ctx, "POST", "/api/v2/ticket_forms.json", ticketFormWrapper{TicketForm: form}, &result); err != nil {
+		return nil, fmt.Errorf("failed to create ticket form: %w", err)
+	}
+
+	return &result.TicketForm, nil
+}
+
+func (c *Client) UpdateTicketForm(ctx context.Context, id int64, form TicketForm) (*TicketForm, error) {
+	var result ticketFormWrapper
+	if err := c.doRequest(ctx, "PUT", fmt.Sprintf("/api/v2/ticket_forms/%d.json", id), ticketFormWrapper{TicketForm: form}, &result); err != nil {
+		return nil, fmt.Errorf("failed to update ticket form: %w", err)
+	}
+
+	return &result.TicketForm, nil
+}
+
+func (c *Client) DeleteTicketForm(ctx context.Context, id int64) error {
+	if err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/v2/ticket_forms/%d.json", id), nil, nil); err != nil {
+		return fmt.Errorf("failed to delete ticket form: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) ListTicketForms(ctx context.Context) ([]TicketForm, error) {
+	forms, err := listAll[TicketForm](ctx, c, "/api/v2/ticket_forms.json", "ticket_forms")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ticket forms: %w", err)
+	}
+
+	return forms, nil
+}
+
+// ReorderTicketForms orders the ticket forms in a single request.
+func (c *Client) ReorderTicketForms(ctx context.Context, ids []int64) error {
+	payload := struct {
+		TicketFormIDs []int64 `json:"ticket_form_ids"`
+	}{TicketFormIDs: ids}
+
+	if err := c.doRequest(ctx, "PUT", "/api/v2/ticket_forms/reorder.json", payload, nil); err != nil {
+		return fmt.Errorf("failed to reorder ticket forms: %w", err)
+	}
+
+	return nil
+}
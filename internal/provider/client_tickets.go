@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+)
+
+type Ticket struct {
+	ID           int64               `json:"id,omitempty"`
+	Subject      string              `json:"subject,omitempty"`
+	Comment      *TicketComment      `json:"comment,omitempty"`
+	RequesterID  *int64              `json:"requester_id,omitempty"`
+	Requester    *TicketRequester    `json:"requester,omitempty"`
+	AssigneeID   *int64              `json:"assignee_id,omitempty"`
+	GroupID      *int64              `json:"group_id,omitempty"`
+	TicketFormID *int64              `json:"ticket_form_id,omitempty"`
+	Priority     string              `json:"priority,omitempty"`
+	Type         string              `json:"type,omitempty"`
+	Status       string              `json:"status,omitempty"`
+	Tags         []string            `json:"tags,omitempty"`
+	CustomFields []TicketCustomField `json:"custom_fields,omitempty"`
+}
+
+// TicketComment is the first comment of a new ticket. It is only sent on creation.
+type TicketComment struct {
+	Body     string `json:"body,omitempty"`
+	HTMLBody string `json:"html_body,omitempty"`
+	Public   bool   `json:"public"`
+}
+
+// TicketRequester creates the requester of a new ticket, or matches an existing user by email.
+type TicketRequester struct {
+	Name  string `json:"name,omitempty"`
+	Email string `json:"email"`
+}
+
+type TicketCustomField struct {
+	ID    int64       `json:"id"`
+	Value interface{} `json:"value"`
+}
+
+type ticketWrapper struct {
+	Ticket Ticket `json:"ticket"`
+}
+
+func (c *Client) ReadTicket(ctx context.Context, id int64) (*Ticket, error) {
+	var result ticketWrapper
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/v2/tickets/%d.json", id), nil, &result); err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read ticket: %w", err)
+	}
+
+	return &result.Ticket, nil
+}
+
+func (c *Client) CreateTicket(ctx context.Context, ticket Ticket) (*Ticket, error) {
+	var result ticketWrapper
+	if err := c.doRequest(ctx, "POST", "/api/v2/tickets.json", ticketWrapper{Ticket: ticket}, &result); err != nil {
+		return nil, fmt.Errorf("failed to create ticket: %w", err)
+	}
+
+	return &result.Ticket, nil
+}
+
+func (c *Client) UpdateTicket(ctx context.Context, id int64, ticket Ticket) (*Ticket, error) {
+	var result ticketWrapper
+	if err := c.doRequest(ctx, "PUT", fmt.Sprintf("/api/v2/tickets/%d.json", id), ticketWrapper{Ticket: ticket}, &result); err != nil {
+		return nil, fmt.Errorf("failed to update ticket: %w", err)
+	}
+
+	return &result.Ticket, nil
+}
+
+// DeleteTicket soft-deletes a ticket, which moves it to the deleted tickets view until it is
+// permanently deleted. A ticket that no longer exists is not an error.
+func (c *Client) DeleteTicket(ctx context.Context, id int64) error {
+	if err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/v2/tickets/%d.json", id), nil, nil); err != nil {
+		if isNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete ticket: %w", err)
+	}
+
+	return nil
+}
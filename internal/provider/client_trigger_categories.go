@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+)
+
+// TriggerCategory IDs are strings in the API.
+type TriggerCategory struct {
+	ID       string `json:"id,omitempty"`
+	Name     string `json:"name"`
+	Position int64  `json:"position,omitempty"`
+}
+
+func (c *Client) ListTriggerCategories(ctx context.Context) ([]TriggerCategory, error) {
+	categories, err := listAll[TriggerCategory](ctx, c, "/api/v2/trigger_categories.json?page[size]=100", "trigger_categories")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list trigger categories: %w", err)
+	}
+
+	return categories, nil
+}
+
+// TriggerCategoryOrder is a category and its triggers, in order.
+type TriggerCategoryOrder struct {
+	CategoryID string
+	TriggerIDs []string
+}
+
+type triggerCategoryJob struct {
+	Job struct {
+		Action string                  `json:"action"`
+		Items  triggerCategoryJobItems `json:"items"`
+	} `json:"job"`
+}
+
+type triggerCategoryJobItems struct {
+	TriggerCategories []triggerCategoryPosition `json:"trigger_categories"`
+	Triggers          []triggerPosition         `json:"triggers"`
+}
+
+type triggerCategoryPosition struct {
+	ID       string `json:"id"`
+	Position int64  `json:"position"`
+}
+
+type triggerPosition struct {
+	ID         string `json:"id"`
+	Position   int64  `json:"position"`
+	CategoryID string `json:"category_id"`
+}
+
+// ReorderTriggers positions the categories and their triggers in the given order, in a single
+// batch job. Positions start at 1 and triggers are numbered within their category.
+func (c *Client) ReorderTriggers(ctx context.Context, order []TriggerCategoryOrder) error {
+	var job triggerCategoryJob
+	job.Job.Action = "patch"
+	job.Job.Items.TriggerCategories = make([]triggerCategoryPosition, 0, len(order))
+	job.Job.Items.Triggers = []triggerPosition{}
+
+	for i, category := range order {
+		job.Job.Items.TriggerCategories = append(job.Job.Items.TriggerCategories, triggerCategoryPosition{
+			ID:       category.CategoryID,
+			Position: int64(i + 1),
+		})
+		for j, id := range category.TriggerIDs {
+			job.Job.Items.Triggers = append(job.Job.Items.Triggers, triggerPosition{
+				ID:         id,
+				Position:   int64(j + 1),
+				CategoryID: category.CategoryID,
+			})
+		}
+	}
+
+	if err := c.doRequest(ctx, "POST", "/api/v2/trigger_categories/jobs.json", job, nil); err != nil {
+		return fmt.Errorf("failed to reorder triggers: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+type Trigger struct {
+	ID          int64          `json:"id,omitempty"`
+	Title       string         `json:"title"`
+	Active      bool           `json:"active"`
+	Position    *int64         `json:"position,omitempty"`
+	CategoryID  string         `json:"category_id,omitempty"`
+	Description string         `json:"description"`
+	Conditions  RuleConditions `json:"conditions"`
+	Actions     []RuleAction   `json:"actions"`
+	UpdatedAt   string         `json:"updated_at,omitempty"`
+}
+
+type triggerWrapper struct {
+	Trigger Trigger `json:"trigger"`
+}
+
+func (c *Client) ReadTrigger(ctx context.Context, id int64) (*Trigger, error) {
+	var result triggerWrapper
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/v2/triggers/%d.json", id), nil, &result); err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read trigger: %w", err)
+	}
+
+	return &result.Trigger, nil
+}
+
+func (c *Client) CreateTrigger(ctx context.Context, trigger Trigger) (*Trigger, error) {
+	var result triggerWrapper
+	if err := c.doRequest(ctx, "POST", "/api/v2/triggers.json", triggerWrapper{Trigger: trigger}, &result); err != nil {
+		return nil, fmt.Errorf("failed to create trigger: %w", err)
+	}
+
+	return &result.Trigger, nil
+}
+
+func (c *Client) UpdateTrigger(ctx context.Context, id int64, trigger Trigger) (*Trigger, error) {
+	var result triggerWrapper
+	if err := c.doRequest(ctx, "PUT", fmt.Sprintf("/api/v2/triggers/%d.json", id), triggerWrapper{Trigger: trigger}, &result); err != nil {
+		return nil, fmt.Errorf("failed to update trigger: %w", err)
+	}
+
+	return &result.Trigger, nil
+}
+
+func (c *Client) DeleteTrigger(ctx context.Context, id int64) error {
+	if err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/v2/triggers/%d.json", id), nil, nil); err != nil {
+		return fmt.Errorf("failed to delete trigger: %w", err)
+	}
+
+	return nil
+}
+
+// ListTriggers returns the triggers matching the given filters (category_id, active).
+func (c *Client) ListTriggers(ctx context.Context, params url.Values) ([]Trigger, error) {
+	query := url.Values{}
+	for key, values := range params {
+		query[key] = values
+	}
+	query.Set("page[size]", "100")
+
+	triggers, err := listAll[Trigger](ctx, c, "/api/v2/triggers.json?"+query.Encode(), "triggers")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list triggers: %w", err)
+	}
+
+	return triggers, nil
+}
+
+// SearchTriggers returns the triggers whose title matches the query.
+func (c *Client) SearchTriggers(ctx context.Context, query string) ([]Trigger, error) {
+	path := "/api/v2/triggers/search.json?" + url.Values{"query": []string{query}}.Encode()
+	triggers, err := listAll[Trigger](ctx, c, path, "triggers")
+	if err != nil {
+		return nil, fmt.Errorf("failed to search triggers: %w", err)
+	}
+
+	return triggers, nil
+}
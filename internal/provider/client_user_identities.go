@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+)
+
+// UserIdentity is a way a user can be reached or sign in, such as an email address or a phone
+// number.
+type UserIdentity struct {
+	ID       int64  `json:"id,omitempty"`
+	UserID   int64  `json:"user_id,omitempty"`
+	Type     string `json:"type,omitempty"`
+	Value    string `json:"value"`
+	Verified bool   `json:"verified,omitempty"`
+	Primary  bool   `json:"primary,omitempty"`
+}
+
+type userIdentityWrapper struct {
+	Identity UserIdentity `json:"identity"`
+}
+
+func userIdentityPath(userID, id int64, action string) string {
+	return fmt.Sprintf("/api/v2/users/%d/identities/%d%s.json", userID, id, action)
+}
+
+func (c *Client) ReadUserIdentity(ctx context.Context, userID, id int64) (*UserIdentity, error) {
+	var result userIdentityWrapper
+	if err := c.doRequest(ctx, "GET", userIdentityPath(userID, id, ""), nil, &result); err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read user identity: %w", err)
+	}
+
+	return &result.Identity, nil
+}
+
+// CreateUserIdentity adds an identity to the user. Identities created as verified skip the
+// verification email.
+func (c *Client) CreateUserIdentity(ctx context.Context, userID int64, identity UserIdentity) (*UserIdentity, error) {
+	path := fmt.Sprintf("/api/v2/users/%d/identities.json", userID)
+	if identity.Verified {
+		path += "?skip_verify_email=true"
+	}
+
+	var result userIdentityWrapper
+	if err := c.doRequest(ctx, "POST", path, userIdentityWrapper{Identity: identity}, &result); err != nil {
+		return nil, fmt.Errorf("failed to create user identity: %w", err)
+	}
+
+	return &result.Identity, nil
+}
+
+func (c *Client) UpdateUserIdentity(ctx context.Context, userID, id int64, identity UserIdentity) (*UserIdentity, error) {
+	var result userIdentityWrapper
+	if err := c.doRequest(ctx, "PUT", userIdentityPath(userID, id, ""), userIdentityWrapper{Identity: identity}, &result); err != nil {
+		return nil, fmt.Errorf("failed to update user identity: %w", err)
+	}
+
+	return &result.Identity, nil
+}
+
+// MakeUserIdentityPrimary makes the identity the primary identity of its type, which demotes the
+// previous primary identity.
+func (c *Client) MakeUserIdentityPrimary(ctx context.Context, userID, id int64) error {
+	if err := c.doRequest(ctx, "PUT", userIdentityPath(userID, id, "/make_primary"), nil, nil); err != nil {
+		return fmt.Errorf("failed to make user identity primary: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteUserIdentity removes the identity from the user. An identity that no longer exists is not
+// an error.
+func (c *Client) DeleteUserIdentity(ctx context.Context, userID, id int64) error {
+	if err := c.doRequest(ctx, "DELETE", userIdentityPath(userID, id, ""), nil, nil); err != nil {
+		if isNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete user identity: %w", err)
+	}
+
+	return nil
+}
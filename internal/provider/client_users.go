@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+type User struct {
+	ID             int64                  `json:"id,omitempty"`
+	Name           string                 `json:"name"`
+	Email          string                 `json:"email,omitempty"`
+	Role           string                 `json:"role,omitempty"`
+	CustomRoleID   *int64                 `json:"custom_role_id,omitempty"`
+	DefaultGroupID *int64                 `json:"default_group_id,omitempty"`
+	OrganizationID *int64                 `json:"organization_id,omitempty"`
+	ExternalID     string                 `json:"external_id,omitempty"`
+	Phone          string                 `json:"phone,omitempty"`
+	TimeZone       string                 `json:"time_zone,omitempty"`
+	Locale         string                 `json:"locale,omitempty"`
+	Tags           []string               `json:"tags,omitempty"`
+	UserFields     map[string]interface{} `json:"user_fields,omitempty"`
+	Active         bool                   `json:"active,omitempty"`
+	Suspended      bool                   `json:"suspended,omitempty"`
+	Verified       bool                   `json:"verified,omitempty"`
+	CreatedAt      string                 `json:"created_at,omitempty"`
+}
+
+type userWrapper struct {
+	User User `json:"user"`
+}
+
+func (c *Client) ReadCurrentUser(ctx context.Context) (*User, error) {
+	var result userWrapper
+	if err := c.doRequest(ctx, "GET", "/api/v2/users/me.json", nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to read current user: %w", err)
+	}
+
+	return &result.User, nil
+}
+
+func (c *Client) ReadUser(ctx context.Context, id int64) (*User, error) {
+	var result userWrapper
+	if err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/v2/users/%d.json", id), nil, &result); err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read user: %w", err)
+	}
+
+	return &result.User, nil
+}
+
+func (c *Client) CreateUser(ctx context.Context, user User) (*User, error) {
+	var result userWrapper
+	if err := c.doRequest(ctx, "POST", "/api/v2/users.json", userWrapper{User: user}, &result); err != nil {
+		return nil, fmt.Errorf("failed to create user: %w", err)
+	}
+
+	return &result.User, nil
+}
+
+func (c *Client) UpdateUser(ctx context.Context, id int64, user User) (*User, error) {
+	var result userWrapper
+	if err := c.doRequest(ctx, "PUT", fmt.Sprintf("/api/v2/users/%d.json", id), userWrapper{User: user}, &result); err != nil {
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+
+	return &result.User, nil
+}
+
+// DeleteUser soft-deletes a user. Deleted users remain readable with active set to false until
+// they are permanently deleted, and agents are downgraded to end users.
+func (c *Client) DeleteUser(ctx context.Context, id int64) error {
+	if err := c.doRequest(ctx, "DELETE", fmt.Sprintf("/api/v2/users/%d.json", id), nil, nil); err != nil {
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+
+	return nil
+}
+
+// SearchUsers calls the users search endpoint, which accepts either a query or an external_id parameter.
+func (c *Client) SearchUsers(ctx context.Context, params url.Values) ([]User, error) {
+	users, err := listAll[User](ctx, c, "/api/v2/users/search.json?"+params.Encode(), "users")
+	if err != nil {
+		return nil, fmt.Errorf("failed to search users: %w", err)
+	}
+
+	return users, nil
+}
+
+// ListUsers lists the users returned by a users list endpoint, such as /api/v2/users.json,
+// /api/v2/groups/{id}/users.json or /api/v2/organizations/{id}/users.json.
+func (c *Client) ListUsers(ctx context.Context, path string, params url.Values) ([]User, error) {
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	users, err := listAll[User](ctx, c, path, "users")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+
+	return users, nil
+}
@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+type View struct {
+	ID          int64        `json:"id,omitempty"`
+	Title       string       `json:"title"`
+	Active      bool         `json:"active"`
+	Position    int64        `json:"position,omitempty"`
+	Description string       `json:"description,omitempty"`
+	Restriction *Restriction `json:"restriction"`
+}
+
+// ListViews returns the views matching the given filters (active, group_id).
+func (c *Client) ListViews(ctx context.Context, params url.Values) ([]View, error) {
+	query := url.Values{}
+	for key, values := range params {
+		query[key] = values
+	}
+	query.Set("page[size]", "100")
+
+	views, err := listAll[View](ctx, c, "/api/v2/views.json?"+query.Encode(), "views")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list views: %w", err)
+	}
+
+	return views, nil
+}
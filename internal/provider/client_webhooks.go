@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// Webhook IDs are strings in the API.
+type Webhook struct {
+	ID            string   `json:"id,omitempty"`
+	Name          string   `json:"name"`
+	Description   string   `json:"description,omitempty"`
+	Endpoint      string   `json:"endpoint"`
+	HTTPMethod    string   `json:"http_method"`
+	RequestFormat string   `json:"request_format"`
+	Status        string   `json:"status"`
+	Subscriptions []string `json:"subscriptions,omitempty"`
+	CreatedBy     string   `json:"created_by,omitempty"`
+}
+
+// ListWebhooks returns the webhooks matching the given filters (filter[name_contains],
+// filter[status]). Signing secrets are served by a separate endpoint and are never fetched here.
+func (c *Client) ListWebhooks(ctx context.Context, params url.Values) ([]Webhook, error) {
+	query := url.Values{}
+	for key, values := range params {
+		query[key] = values
+	}
+	query.Set("page[size]", "100")
+
+	webhooks, err := listAll[Webhook](ctx, c, "/api/v2/webhooks?"+query.Encode(), "webhooks")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhooks: %w", err)
+	}
+
+	return webhooks, nil
+}
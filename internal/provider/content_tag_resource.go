@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &ContentTagResource{}
+	_ resource.ResourceWithImportState = &ContentTagResource{}
+)
+
+func NewContentTagResource() resource.Resource {
+	return &ContentTagResource{}
+}
+
+type ContentTagResource struct {
+	client *Client
+}
+
+type ContentTagResourceModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+func (r *ContentTagResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_content_tag"
+}
+
+func (r *ContentTagResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Zendesk Guide content tag, used to cross-link articles and community posts.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the content tag, an opaque string.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the content tag.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (r *ContentTagResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ContentTagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ContentTagResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tag, err := r.client.CreateContentTag(ctx, ContentTag{Name: plan.Name.ValueString()})
+	if err != nil {
+		// Adopting an existing tag silently would bypass review, so the user is pointed to import.
+		if isConflict(err) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Error Creating Content Tag",
+				fmt.Sprintf("A content tag named %q already exists. Import it with its ID instead of creating it.", plan.Name.ValueString()),
+			)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Creating Content Tag",
+			fmt.Sprintf("Could not create content tag: %v", err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(tag.ID)
+	plan.Name = types.StringValue(tag.Name)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ContentTagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ContentTagResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tag, err := r.client.ReadContentTag(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Content Tag",
+			fmt.Sprintf("Could not read content tag: %v", err),
+		)
+		return
+	}
+
+	if tag == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Name = types.StringValue(tag.Name)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ContentTagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ContentTagResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tag, err := r.client.UpdateContentTag(ctx, plan.ID.ValueString(), ContentTag{Name: plan.Name.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Content Tag",
+			fmt.Sprintf("Could not update content tag: %v", err),
+		)
+		return
+	}
+
+	plan.Name = types.StringValue(tag.Name)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ContentTagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ContentTagResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteContentTag(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Content Tag",
+			fmt.Sprintf("Could not delete content tag: %v", err),
+		)
+		return
+	}
+}
+
+func (r *ContentTagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
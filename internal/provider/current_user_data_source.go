@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &CurrentUserDataSource{}
+)
+
+func NewCurrentUserDataSource() datasource.DataSource {
+	return &CurrentUserDataSource{}
+}
+
+type CurrentUserDataSource struct {
+	client *Client
+}
+
+type CurrentUserDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Email        types.String `tfsdk:"email"`
+	Role         types.String `tfsdk:"role"`
+	CustomRoleID types.String `tfsdk:"custom_role_id"`
+	Locale       types.String `tfsdk:"locale"`
+	TimeZone     types.String `tfsdk:"time_zone"`
+	Active       types.Bool   `tfsdk:"active"`
+}
+
+func (d *CurrentUserDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_current_user"
+}
+
+func (d *CurrentUserDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns the Zendesk user the provider is authenticated as.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the user.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the user.",
+				Computed:    true,
+			},
+			"email": schema.StringAttribute{
+				Description: "The primary email address of the user.",
+				Computed:    true,
+			},
+			"role": schema.StringAttribute{
+				Description: "The role of the user (end-user, agent or admin).",
+				Computed:    true,
+			},
+			"custom_role_id": schema.StringAttribute{
+				Description: "The ID of the user's custom role, if any.",
+				Computed:    true,
+			},
+			"locale": schema.StringAttribute{
+				Description: "The locale of the user.",
+				Computed:    true,
+			},
+			"time_zone": schema.StringAttribute{
+				Description: "The time zone of the user.",
+				Computed:    true,
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether the user is active.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *CurrentUserDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CurrentUserDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	user, err := d.client.ReadCurrentUser(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Current User",
+			fmt.Sprintf("Could not read the authenticated user: %v", err),
+		)
+		return
+	}
+
+	state := CurrentUserDataSourceModel{
+		ID:           types.StringValue(strconv.FormatInt(user.ID, 10)),
+		Name:         types.StringValue(user.Name),
+		Email:        types.StringValue(user.Email),
+		Role:         types.StringValue(user.Role),
+		CustomRoleID: optionalIDValue(user.CustomRoleID),
+		Locale:       types.StringValue(user.Locale),
+		TimeZone:     types.StringValue(user.TimeZone),
+		Active:       types.BoolValue(user.Active),
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &CustomObjectFieldsDataSource{}
+)
+
+func NewCustomObjectFieldsDataSource() datasource.DataSource {
+	return &CustomObjectFieldsDataSource{}
+}
+
+type CustomObjectFieldsDataSource struct {
+	client *Client
+}
+
+type CustomObjectFieldsDataSourceModel struct {
+	ObjectKey types.String                  `tfsdk:"object_key"`
+	Fields    []CustomObjectFieldsItemModel `tfsdk:"fields"`
+}
+
+type CustomObjectFieldsItemModel struct {
+	ID                 types.String             `tfsdk:"id"`
+	Key                types.String             `tfsdk:"key"`
+	Type               types.String             `tfsdk:"type"`
+	Title              types.String             `tfsdk:"title"`
+	Description        types.String             `tfsdk:"description"`
+	Active             types.Bool               `tfsdk:"active"`
+	System             types.Bool               `tfsdk:"system"`
+	Position           types.Int64              `tfsdk:"position"`
+	CustomFieldOptions []CustomFieldOptionModel `tfsdk:"custom_field_options"`
+}
+
+func (d *CustomObjectFieldsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_object_fields"
+}
+
+func (d *CustomObjectFieldsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the fields of a Zendesk custom object, including the options of dropdown fields, sorted by position.",
+		Attributes: map[string]schema.Attribute{
+			"object_key": schema.StringAttribute{
+				Description: "The key of the custom object.",
+				Required:    true,
+			},
+			"fields": schema.ListNestedAttribute{
+				Description: "The fields of the custom object.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the field.",
+							Computed:    true,
+						},
+						"key": schema.StringAttribute{
+							Description: "The key of the field.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of the field.",
+							Computed:    true,
+						},
+						"title": schema.StringAttribute{
+							Description: "The title of the field.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the field.",
+							Computed:    true,
+						},
+						"active": schema.BoolAttribute{
+							Description: "Whether the field is active.",
+							Computed:    true,
+						},
+						"system": schema.BoolAttribute{
+							Description: "Whether the field is a system field, such as name or external_id.",
+							Computed:    true,
+						},
+						"position": schema.Int64Attribute{
+							Description: "The position of the field.",
+							Computed:    true,
+						},
+						"custom_field_options": customFieldOptionsAttribute(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CustomObjectFieldsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CustomObjectFieldsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config CustomObjectFieldsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fields, err := d.client.ListCustomObjectFields(ctx, config.ObjectKey.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Custom Object Fields",
+			fmt.Sprintf("Could not list fields of custom object %q: %v", config.ObjectKey.ValueString(), err),
+		)
+		return
+	}
+
+	sort.SliceStable(fields, func(i, j int) bool {
+		if fields[i].Position != fields[j].Position {
+			return fields[i].Position < fields[j].Position
+		}
+		return fields[i].ID < fields[j].ID
+	})
+
+	config.Fields = make([]CustomObjectFieldsItemModel, 0, len(fields))
+	for _, field := range fields {
+		config.Fields = append(config.Fields, CustomObjectFieldsItemModel{
+			ID:                 types.StringValue(strconv.FormatInt(field.ID, 10)),
+			Key:                types.StringValue(field.Key),
+			Type:               types.StringValue(field.Type),
+			Title:              types.StringValue(field.Title),
+			Description:        types.StringValue(field.Description),
+			Active:             types.BoolValue(field.Active),
+			System:             types.BoolValue(field.System),
+			Position:           types.Int64Value(field.Position),
+			CustomFieldOptions: flattenCustomFieldOptions(field.CustomFieldOptions),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,483 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// externalIDPattern matches the external IDs that can be read back: the records API filters by a
+// comma-separated list of external IDs.
+var externalIDPattern = regexp.MustCompile(`^[^,]*$`)
+
+const (
+	customObjectJobPollInterval = 2 * time.Second
+	customObjectJobTimeout      = 10 * time.Minute
+)
+
+var (
+	_ resource.Resource                   = &CustomObjectRecordsBatchResource{}
+	_ resource.ResourceWithValidateConfig = &CustomObjectRecordsBatchResource{}
+)
+
+func NewCustomObjectRecordsBatchResource() resource.Resource {
+	return &CustomObjectRecordsBatchResource{}
+}
+
+type CustomObjectRecordsBatchResource struct {
+	client *Client
+}
+
+type CustomObjectRecordsBatchResourceModel struct {
+	ID        types.String              `tfsdk:"id"`
+	ObjectKey types.String              `tfsdk:"object_key"`
+	Records   []CustomObjectRecordModel `tfsdk:"records"`
+	RecordIDs types.Map                 `tfsdk:"record_ids"`
+	Timeouts  timeouts.Value            `tfsdk:"timeouts"`
+}
+
+type CustomObjectRecordModel struct {
+	ExternalID         types.String `tfsdk:"external_id"`
+	Name               types.String `tfsdk:"name"`
+	CustomObjectFields types.Map    `tfsdk:"custom_object_fields"`
+}
+
+func (r *CustomObjectRecordsBatchResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_object_records_batch"
+}
+
+func (r *CustomObjectRecordsBatchResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a batch of Zendesk custom object records, reconciled by external ID and written through the bulk jobs endpoint.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the batch (the custom object key).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"object_key": schema.StringAttribute{
+				Description: "The key of the custom object the records belong to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"records": schema.ListNestedAttribute{
+				Description: "The records to upsert. Each record is identified by its external ID.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"external_id": schema.StringAttribute{
+							Description: "The external ID used to reconcile the record. It must not contain commas, which the records API uses to separate external IDs, and must be unique within the batch.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(externalIDPattern, "must not contain commas"),
+							},
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the record.",
+							Required:    true,
+						},
+						"custom_object_fields": schema.MapAttribute{
+							Description: "The values of the record's custom object fields, keyed by field key.",
+							Optional:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+			"record_ids": schema.MapAttribute{
+				Description: "The Zendesk record IDs, keyed by external ID.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *CustomObjectRecordsBatchResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig rejects records sharing an external ID, which would be written over each other
+// and leave the batch changing on every apply.
+func (r *CustomObjectRecordsBatchResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var records types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("records"), &records)...)
+	if resp.Diagnostics.HasError() || records.IsNull() || records.IsUnknown() {
+		return
+	}
+
+	seen := map[string]path.Path{}
+	for i, element := range records.Elements() {
+		record, ok := element.(types.Object)
+		if !ok || record.IsNull() || record.IsUnknown() {
+			continue
+		}
+		externalID, ok := record.Attributes()["external_id"].(types.String)
+		if !ok || externalID.IsNull() || externalID.IsUnknown() {
+			continue
+		}
+
+		attr := path.Root("records").AtListIndex(i).AtName("external_id")
+		if first, ok := seen[externalID.ValueString()]; ok {
+			resp.Diagnostics.AddAttributeError(
+				attr,
+				"Duplicate External ID",
+				fmt.Sprintf("External ID %q is used by more than one record; it is first used at %s.", externalID.ValueString(), first),
+			)
+			continue
+		}
+		seen[externalID.ValueString()] = attr
+	}
+}
+
+func (r *CustomObjectRecordsBatchResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan CustomObjectRecordsBatchResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records, diags := expandCustomObjectRecords(ctx, plan.Records)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, customObjectJobTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.runJobs(ctx, plan.ObjectKey.ValueString(), "create_or_update_by_external_id", records)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.ObjectKey
+	resp.Diagnostics.Append(r.refreshRecordIDs(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *CustomObjectRecordsBatchResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state CustomObjectRecordsBatchResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	externalIDs := make([]string, 0, len(state.Records))
+	for _, record := range state.Records {
+		externalIDs = append(externalIDs, record.ExternalID.ValueString())
+	}
+
+	remote, err := r.client.ListCustomObjectRecordsByExternalIDs(ctx, state.ObjectKey.ValueString(), externalIDs)
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Custom Object Records",
+			fmt.Sprintf("Could not read custom object records: %v", err),
+		)
+		return
+	}
+
+	byExternalID := make(map[string]CustomObjectRecord, len(remote))
+	for _, record := range remote {
+		byExternalID[record.ExternalID] = record
+	}
+
+	records := make([]CustomObjectRecordModel, 0, len(state.Records))
+	recordIDs := make(map[string]string, len(remote))
+	for _, record := range state.Records {
+		found, ok := byExternalID[record.ExternalID.ValueString()]
+		if !ok {
+			// The record was deleted outside of Terraform; dropping it lets the next plan recreate it.
+			continue
+		}
+
+		record.Name = types.StringValue(found.Name)
+		if !record.CustomObjectFields.IsNull() {
+			fields, d := flattenManagedFields(ctx, record.CustomObjectFields, found.CustomObjectFields)
+			resp.Diagnostics.Append(d...)
+			record.CustomObjectFields = fields
+		}
+
+		records = append(records, record)
+		recordIDs[found.ExternalID] = found.ID
+	}
+
+	state.Records = records
+	state.RecordIDs, diags = types.MapValueFrom(ctx, types.StringType, recordIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *CustomObjectRecordsBatchResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state CustomObjectRecordsBatchResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned := make(map[string]CustomObjectRecordModel, len(plan.Records))
+	for _, record := range plan.Records {
+		planned[record.ExternalID.ValueString()] = record
+	}
+
+	var removed []CustomObjectRecord
+	existing := make(map[string]CustomObjectRecordModel, len(state.Records))
+	for _, record := range state.Records {
+		externalID := record.ExternalID.ValueString()
+		existing[externalID] = record
+		if _, ok := planned[externalID]; !ok {
+			removed = append(removed, CustomObjectRecord{ExternalID: externalID})
+		}
+	}
+
+	// Only records that are new or changed are sent to Zendesk.
+	var changed []CustomObjectRecordModel
+	for _, record := range plan.Records {
+		prior, ok := existing[record.ExternalID.ValueString()]
+		if ok && prior.Name.Equal(record.Name) && prior.CustomObjectFields.Equal(record.CustomObjectFields) {
+			continue
+		}
+		changed = append(changed, record)
+	}
+
+	upserts, diags := expandCustomObjectRecords(ctx, changed)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, customObjectJobTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	objectKey := plan.ObjectKey.ValueString()
+	resp.Diagnostics.Append(r.runJobs(ctx, objectKey, "delete_by_external_id", removed)...)
+	resp.Diagnostics.Append(r.runJobs(ctx, objectKey, "create_or_update_by_external_id", upserts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	resp.Diagnostics.Append(r.refreshRecordIDs(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *CustomObjectRecordsBatchResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state CustomObjectRecordsBatchResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records := make([]CustomObjectRecord, 0, len(state.Records))
+	for _, record := range state.Records {
+		records = append(records, CustomObjectRecord{ExternalID: record.ExternalID.ValueString()})
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, customObjectJobTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.runJobs(ctx, state.ObjectKey.ValueString(), "delete_by_external_id", records)...)
+}
+
+// runJobs submits the records in chunks of at most 100 items, waits for every job to finish,
+// and maps per-item failures back to the offending record. Failures without a valid index are
+// reported without a record.
+func (r *CustomObjectRecordsBatchResource) runJobs(ctx context.Context, objectKey, action string, records []CustomObjectRecord) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for start := 0; start < len(records); start += customObjectJobMaxItems {
+		end := start + customObjectJobMaxItems
+		if end > len(records) {
+			end = len(records)
+		}
+		chunk := records[start:end]
+
+		job, err := r.client.CreateCustomObjectRecordJob(ctx, objectKey, action, chunk)
+		if err != nil {
+			diags.AddError(
+				"Error Submitting Custom Object Record Job",
+				fmt.Sprintf("Could not submit %s job for records %d to %d: %v", action, start, end-1, err),
+			)
+			continue
+		}
+
+		err = waitFor(ctx, waitOptions{
+			Description: fmt.Sprintf("custom object record job %s", job.ID),
+			Interval:    customObjectJobPollInterval,
+		}, func() (bool, error) {
+			if job.Finished() {
+				return true, nil
+			}
+			next, err := r.client.ReadJobStatus(ctx, job.ID)
+			if err != nil {
+				return false, err
+			}
+			job = next
+			return job.Finished(), nil
+		})
+		if err != nil {
+			diags.AddError(
+				"Error Waiting For Custom Object Record Job",
+				fmt.Sprintf("Job %s did not finish: %v", job.ID, err),
+			)
+			continue
+		}
+
+		for _, result := range job.Results {
+			if result.Success || result.Status == "Created" || result.Status == "Updated" || result.Status == "Deleted" {
+				continue
+			}
+			if result.Index == nil || *result.Index < 0 || *result.Index >= len(chunk) {
+				diags.AddError(
+					"Error Writing Custom Object Record",
+					fmt.Sprintf("A record of job %s could not be written (%s): %s %s", job.ID, action, result.Error, result.Details),
+				)
+				continue
+			}
+
+			item := chunk[*result.Index]
+			diags.AddError(
+				"Error Writing Custom Object Record",
+				fmt.Sprintf("Record with external_id %q could not be written (%s): %s %s", item.ExternalID, action, result.Error, result.Details),
+			)
+		}
+
+		if job.Status != "completed" && !diags.HasError() {
+			diags.AddError(
+				"Custom Object Record Job Failed",
+				fmt.Sprintf("Job %s finished with status %q: %s", job.ID, job.Status, job.Message),
+			)
+		}
+	}
+
+	return diags
+}
+
+func (r *CustomObjectRecordsBatchResource) refreshRecordIDs(ctx context.Context, model *CustomObjectRecordsBatchResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	externalIDs := make([]string, 0, len(model.Records))
+	for _, record := range model.Records {
+		externalIDs = append(externalIDs, record.ExternalID.ValueString())
+	}
+
+	remote, err := r.client.ListCustomObjectRecordsByExternalIDs(ctx, model.ObjectKey.ValueString(), externalIDs)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Custom Object Records",
+			fmt.Sprintf("Could not read custom object records: %v", err),
+		)
+		return diags
+	}
+
+	recordIDs := make(map[string]string, len(remote))
+	for _, record := range remote {
+		recordIDs[record.ExternalID] = record.ID
+	}
+
+	model.RecordIDs, diags = types.MapValueFrom(ctx, types.StringType, recordIDs)
+	return diags
+}
+
+func expandCustomObjectRecords(ctx context.Context, models []CustomObjectRecordModel) ([]CustomObjectRecord, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	records := make([]CustomObjectRecord, 0, len(models))
+
+	for _, model := range models {
+		record := CustomObjectRecord{
+			ExternalID: model.ExternalID.ValueString(),
+			Name:       model.Name.ValueString(),
+		}
+
+		if !model.CustomObjectFields.IsNull() {
+			fields := map[string]string{}
+			diags.Append(model.CustomObjectFields.ElementsAs(ctx, &fields, false)...)
+
+			record.CustomObjectFields = make(map[string]interface{}, len(fields))
+			for key, value := range fields {
+				record.CustomObjectFields[key] = value
+			}
+		}
+
+		records = append(records, record)
+	}
+
+	return records, diags
+}
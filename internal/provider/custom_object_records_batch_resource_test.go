@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCustomObjectRecordsBatchDuplicateExternalID(t *testing.T) {
+	p := newProtocolTest(t, "")
+
+	tests := []struct {
+		name    string
+		records string
+		valid   bool
+	}{
+		{name: "distinct", records: `[{"external_id": "model-s", "name": "Model S"}, {"external_id": "model-x", "name": "Model X"}]`, valid: true},
+		{name: "duplicate", records: `[{"external_id": "model-s", "name": "Model S"}, {"external_id": "model-s", "name": "Model S Plaid"}]`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			diags := p.validate("zendesk_custom_object_records_batch", `{"object_key": "car", "records": `+test.records+`}`)
+			if test.valid && len(diags) > 0 {
+				t.Errorf("unexpected diagnostics: %s: %s", diags[0].Summary, diags[0].Detail)
+			}
+			if !test.valid && !hasProtocolError(diags, "Duplicate External ID") {
+				t.Errorf("expected a duplicate external ID error, got %v", diags)
+			}
+		})
+	}
+}
+
+// Failures are attributed to the record at their index, and failures without one are reported
+// without a record rather than blamed on the first.
+func TestCustomObjectRecordsBatchJobErrors(t *testing.T) {
+	p := newProtocolTest(t, "custom_object_records_batch_job_errors.json")
+
+	diags := p.applyDiagnostics("zendesk_custom_object_records_batch", `{
+		"object_key": "car",
+		"records": [{"external_id": "model-s", "name": "Model S"}, {"external_id": "model-x", "name": "Model X"}],
+		"timeouts": null
+	}`, nil)
+	if len(diags) != 2 {
+		t.Fatalf("expected 2 diagnostics, got %v", diags)
+	}
+	if !strings.Contains(diags[0].Detail, `"model-x"`) {
+		t.Errorf("expected the first error to name record model-x, got %q", diags[0].Detail)
+	}
+	if strings.Contains(diags[1].Detail, "model-") || !strings.Contains(diags[1].Detail, "V3-291e720c98aef4d8") {
+		t.Errorf("expected the second error to name the job but no record, got %q", diags[1].Detail)
+	}
+}
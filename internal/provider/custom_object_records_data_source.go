@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const customObjectRecordsMaxResults = 10000
+
+var (
+	_ datasource.DataSource                     = &CustomObjectRecordsDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &CustomObjectRecordsDataSource{}
+)
+
+func NewCustomObjectRecordsDataSource() datasource.DataSource {
+	return &CustomObjectRecordsDataSource{}
+}
+
+type CustomObjectRecordsDataSource struct {
+	client *Client
+}
+
+type CustomObjectRecordsDataSourceModel struct {
+	ObjectKey  types.String                   `tfsdk:"object_key"`
+	ExternalID types.String                   `tfsdk:"external_id"`
+	Query      types.String                   `tfsdk:"query"`
+	FilterJSON types.String                   `tfsdk:"filter_json"`
+	Records    []CustomObjectRecordsItemModel `tfsdk:"records"`
+}
+
+type CustomObjectRecordsItemModel struct {
+	ID                 types.String `tfsdk:"id"`
+	ExternalID         types.String `tfsdk:"external_id"`
+	Name               types.String `tfsdk:"name"`
+	CustomObjectFields types.Map    `tfsdk:"custom_object_fields"`
+}
+
+func (d *CustomObjectRecordsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_object_records"
+}
+
+func (d *CustomObjectRecordsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Queries the records of a Zendesk custom object, sorted by ID. At most %d records are returned.", customObjectRecordsMaxResults),
+		Attributes: map[string]schema.Attribute{
+			"object_key": schema.StringAttribute{
+				Description: "The key of the custom object.",
+				Required:    true,
+			},
+			"external_id": schema.StringAttribute{
+				Description: "Only return the record with this external ID. Conflicts with query and filter_json.",
+				Optional:    true,
+			},
+			"query": schema.StringAttribute{
+				Description: "Only return records whose name matches this search query.",
+				Optional:    true,
+			},
+			"filter_json": schema.StringAttribute{
+				Description: "A records search filter, encoded as JSON, e.g. jsonencode({ \"custom_object_fields.sku\" = { \"$eq\" = \"A-1\" } }).",
+				Optional:    true,
+			},
+			"records": schema.ListNestedAttribute{
+				Description: "The records.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the record.",
+							Computed:    true,
+						},
+						"external_id": schema.StringAttribute{
+							Description: "The external ID of the record.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the record.",
+							Computed:    true,
+						},
+						"custom_object_fields": schema.MapAttribute{
+							Description: "The field values of the record, keyed by field key.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CustomObjectRecordsDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("external_id"),
+			path.MatchRoot("query"),
+		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("external_id"),
+			path.MatchRoot("filter_json"),
+		),
+	}
+}
+
+func (d *CustomObjectRecordsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CustomObjectRecordsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config CustomObjectRecordsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	objectKey := config.ObjectKey.ValueString()
+
+	var records []CustomObjectRecord
+	if !config.ExternalID.IsNull() {
+		var err error
+		records, err = d.client.ListCustomObjectRecordsByExternalIDs(ctx, objectKey, []string{config.ExternalID.ValueString()})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Custom Object Records",
+				fmt.Sprintf("Could not read records of custom object %q: %v", objectKey, err),
+			)
+			return
+		}
+	} else {
+		var filter json.RawMessage
+		if !config.FilterJSON.IsNull() {
+			filter = json.RawMessage(config.FilterJSON.ValueString())
+			if !json.Valid(filter) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("filter_json"),
+					"Invalid Filter JSON",
+					"filter_json must be a valid JSON object.",
+				)
+				return
+			}
+		}
+
+		var truncated bool
+		var err error
+		records, truncated, err = d.client.SearchCustomObjectRecords(ctx, objectKey, config.Query.ValueString(), filter, customObjectRecordsMaxResults)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Searching Custom Object Records",
+				fmt.Sprintf("Could not search records of custom object %q: %v", objectKey, err),
+			)
+			return
+		}
+
+		if truncated {
+			resp.Diagnostics.AddWarning(
+				"Custom Object Records Result Truncated",
+				fmt.Sprintf("Only the first %d records were fetched. Narrow the query or filter to get a complete result.", customObjectRecordsMaxResults),
+			)
+		}
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].ID < records[j].ID
+	})
+
+	config.Records = make([]CustomObjectRecordsItemModel, 0, len(records))
+	for _, record := range records {
+		fields, diags := stringMapValue(ctx, record.CustomObjectFields)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		config.Records = append(config.Records, CustomObjectRecordsItemModel{
+			ID:                 types.StringValue(record.ID),
+			ExternalID:         types.StringValue(record.ExternalID),
+			Name:               types.StringValue(record.Name),
+			CustomObjectFields: fields,
+		})
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
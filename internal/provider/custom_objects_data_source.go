@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &CustomObjectsDataSource{}
+)
+
+func NewCustomObjectsDataSource() datasource.DataSource {
+	return &CustomObjectsDataSource{}
+}
+
+type CustomObjectsDataSource struct {
+	client *Client
+}
+
+type CustomObjectsDataSourceModel struct {
+	IncludeRecordCounts types.Bool               `tfsdk:"include_record_counts"`
+	CustomObjects       []CustomObjectsItemModel `tfsdk:"custom_objects"`
+}
+
+type CustomObjectsItemModel struct {
+	Key             types.String `tfsdk:"key"`
+	Title           types.String `tfsdk:"title"`
+	TitlePluralized types.String `tfsdk:"title_pluralized"`
+	Description     types.String `tfsdk:"description"`
+	RecordCount     types.Int64  `tfsdk:"record_count"`
+}
+
+func (d *CustomObjectsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_objects"
+}
+
+func (d *CustomObjectsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists Zendesk custom objects, sorted by key.",
+		Attributes: map[string]schema.Attribute{
+			"include_record_counts": schema.BoolAttribute{
+				Description: "Whether to fetch the record count of every object, which takes one extra request per object.",
+				Optional:    true,
+			},
+			"custom_objects": schema.ListNestedAttribute{
+				Description: "The custom objects.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Description: "The key of the custom object.",
+							Computed:    true,
+						},
+						"title": schema.StringAttribute{
+							Description: "The title of the custom object.",
+							Computed:    true,
+						},
+						"title_pluralized": schema.StringAttribute{
+							Description: "The plural title of the custom object.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the custom object.",
+							Computed:    true,
+						},
+						"record_count": schema.Int64Attribute{
+							Description: "The number of records of the custom object. Null unless include_record_counts is true.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CustomObjectsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CustomObjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config CustomObjectsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	objects, err := d.client.ListCustomObjects(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Custom Objects",
+			fmt.Sprintf("Could not list custom objects: %v", err),
+		)
+		return
+	}
+
+	sort.SliceStable(objects, func(i, j int) bool {
+		return objects[i].Key < objects[j].Key
+	})
+
+	config.CustomObjects = make([]CustomObjectsItemModel, 0, len(objects))
+	for _, object := range objects {
+		recordCount := types.Int64Null()
+		if config.IncludeRecordCounts.ValueBool() {
+			count, err := d.client.CountCustomObjectRecords(ctx, object.Key)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error Counting Custom Object Records",
+					fmt.Sprintf("Could not count records of custom object %q: %v", object.Key, err),
+				)
+				return
+			}
+			recordCount = types.Int64Value(count)
+		}
+
+		config.CustomObjects = append(config.CustomObjects, CustomObjectsItemModel{
+			Key:             types.StringValue(object.Key),
+			Title:           types.StringValue(object.Title),
+			TitlePluralized: types.StringValue(object.TitlePluralized),
+			Description:     types.StringValue(object.Description),
+			RecordCount:     recordCount,
+		})
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,339 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// customRoleConfigurationKeys lists the keys of a custom role configuration the resource
+// manages, with their types. Keys left unset in Terraform keep the Zendesk defaults.
+var customRoleConfigurationKeys = map[string]attr.Type{
+	"assign_tickets_to_any_group":     types.BoolType,
+	"chat_access":                     types.BoolType,
+	"end_user_list_access":            types.StringType,
+	"end_user_profile_access":         types.StringType,
+	"explore_access":                  types.StringType,
+	"forum_access":                    types.StringType,
+	"forum_access_restricted_content": types.BoolType,
+	"group_access":                    types.BoolType,
+	"light_agent":                     types.BoolType,
+	"macro_access":                    types.StringType,
+	"manage_business_rules":           types.BoolType,
+	"manage_contextual_workspaces":    types.BoolType,
+	"manage_dynamic_content":          types.BoolType,
+	"manage_extensions_and_channels":  types.BoolType,
+	"manage_facebook":                 types.BoolType,
+	"manage_group_memberships":        types.BoolType,
+	"manage_groups":                   types.BoolType,
+	"manage_organization_fields":      types.BoolType,
+	"manage_organizations":            types.BoolType,
+	"manage_skills":                   types.BoolType,
+	"manage_slas":                     types.BoolType,
+	"manage_suspended_tickets":        types.BoolType,
+	"manage_team_members":             types.StringType,
+	"manage_ticket_fields":            types.BoolType,
+	"manage_ticket_forms":             types.BoolType,
+	"manage_user_fields":              types.BoolType,
+	"moderate_forums":                 types.BoolType,
+	"organization_editing":            types.BoolType,
+	"organization_notes_editing":      types.BoolType,
+	"report_access":                   types.StringType,
+	"side_conversation_create":        types.BoolType,
+	"ticket_access":                   types.StringType,
+	"ticket_bulk_edit":                types.BoolType,
+	"ticket_comment_access":           types.StringType,
+	"ticket_deletion":                 types.BoolType,
+	"ticket_editing":                  types.BoolType,
+	"ticket_merge":                    types.BoolType,
+	"ticket_tag_editing":              types.BoolType,
+	"twitter_search_access":           types.BoolType,
+	"user_view_access":                types.StringType,
+	"view_access":                     types.StringType,
+	"view_deleted_tickets":            types.BoolType,
+	"voice_access":                    types.BoolType,
+	"voice_dashboard_access":          types.BoolType,
+}
+
+var (
+	_ resource.Resource                = &CustomRoleResource{}
+	_ resource.ResourceWithImportState = &CustomRoleResource{}
+)
+
+func NewCustomRoleResource() resource.Resource {
+	return &CustomRoleResource{}
+}
+
+type CustomRoleResource struct {
+	client *Client
+}
+
+type CustomRoleResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	Configuration types.Object `tfsdk:"configuration"`
+}
+
+func (r *CustomRoleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_role"
+}
+
+func (r *CustomRoleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	configuration := make(map[string]schema.Attribute, len(customRoleConfigurationKeys))
+	for key, keyType := range customRoleConfigurationKeys {
+		description := fmt.Sprintf("The %s permission. Defaults to the Zendesk default when not set.", key)
+		if keyType == types.BoolType {
+			configuration[key] = schema.BoolAttribute{Description: description, Optional: true}
+		} else {
+			configuration[key] = schema.StringAttribute{Description: description, Optional: true}
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a Zendesk custom agent role. Requires a Zendesk Enterprise plan.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the custom role.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the custom role.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the custom role.",
+				Optional:    true,
+			},
+			"configuration": schema.SingleNestedAttribute{
+				Description: "The permissions of the role, e.g. ticket_access = \"within-groups\". Only the keys set here are managed; the others keep the Zendesk defaults and are not refreshed.",
+				Optional:    true,
+				Attributes:  configuration,
+			},
+		},
+	}
+}
+
+func (r *CustomRoleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CustomRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan CustomRoleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, err := r.client.CreateCustomRole(ctx, expandCustomRole(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Custom Role",
+			fmt.Sprintf("Could not create custom role: %v", err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flattenCustomRole(role, &plan)...)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *CustomRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state CustomRoleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Custom Role ID",
+			fmt.Sprintf("Could not parse custom role ID: %v", err),
+		)
+		return
+	}
+
+	role, err := r.client.ReadCustomRole(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Custom Role",
+			fmt.Sprintf("Could not read custom role: %v", err),
+		)
+		return
+	}
+
+	if role == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(flattenCustomRole(role, &state)...)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *CustomRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan CustomRoleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(plan.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Custom Role ID",
+			fmt.Sprintf("Could not parse custom role ID: %v", err),
+		)
+		return
+	}
+
+	role, err := r.client.UpdateCustomRole(ctx, id, expandCustomRole(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Custom Role",
+			fmt.Sprintf("Could not update custom role: %v", err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flattenCustomRole(role, &plan)...)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *CustomRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state CustomRoleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Custom Role ID",
+			fmt.Sprintf("Could not parse custom role ID: %v", err),
+		)
+		return
+	}
+
+	err = r.client.DeleteCustomRole(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Custom Role",
+			fmt.Sprintf("Could not delete custom role: %v", err),
+		)
+		return
+	}
+}
+
+func (r *CustomRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// expandCustomRole builds a custom agent role (role type 0) whose configuration only holds the
+// keys set in the model.
+func expandCustomRole(model CustomRoleResourceModel) CustomRoleDefinition {
+	role := CustomRoleDefinition{
+		Name:        model.Name.ValueString(),
+		Description: model.Description.ValueString(),
+	}
+
+	if model.Configuration.IsNull() || model.Configuration.IsUnknown() {
+		return role
+	}
+
+	role.Configuration = map[string]interface{}{}
+	for key, value := range model.Configuration.Attributes() {
+		switch v := value.(type) {
+		case types.Bool:
+			if !v.IsNull() && !v.IsUnknown() {
+				role.Configuration[key] = v.ValueBool()
+			}
+		case types.String:
+			if !v.IsNull() && !v.IsUnknown() {
+				role.Configuration[key] = v.ValueString()
+			}
+		}
+	}
+
+	return role
+}
+
+// flattenCustomRole maps a role back to the model. Only the configuration keys already set in
+// the model are refreshed, so the keys left to Zendesk defaults never show up as drift.
+func flattenCustomRole(role *CustomRoleDefinition, model *CustomRoleResourceModel) diag.Diagnostics {
+	model.ID = types.StringValue(strconv.FormatInt(role.ID, 10))
+	model.Name = types.StringValue(role.Name)
+	if role.Description != "" || !model.Description.IsNull() {
+		model.Description = types.StringValue(role.Description)
+	}
+
+	if model.Configuration.IsNull() {
+		return nil
+	}
+
+	prior := model.Configuration.Attributes()
+	values := make(map[string]attr.Value, len(customRoleConfigurationKeys))
+	for key, keyType := range customRoleConfigurationKeys {
+		remote, found := role.Configuration[key]
+		priorValue, set := prior[key]
+		managed := set && !priorValue.IsNull()
+
+		if keyType == types.BoolType {
+			b, ok := remote.(bool)
+			if !managed || !found || !ok {
+				values[key] = types.BoolNull()
+			} else {
+				values[key] = types.BoolValue(b)
+			}
+			continue
+		}
+
+		s, ok := remote.(string)
+		if !managed || !found || !ok {
+			values[key] = types.StringNull()
+		} else {
+			values[key] = types.StringValue(s)
+		}
+	}
+
+	configuration, diags := types.ObjectValue(customRoleConfigurationKeys, values)
+	model.Configuration = configuration
+	return diags
+}
@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &CustomRolesDataSource{}
+)
+
+func NewCustomRolesDataSource() datasource.DataSource {
+	return &CustomRolesDataSource{}
+}
+
+type CustomRolesDataSource struct {
+	client *Client
+}
+
+type CustomRolesDataSourceModel struct {
+	Name  types.String           `tfsdk:"name"`
+	Roles []CustomRolesItemModel `tfsdk:"roles"`
+}
+
+type CustomRolesItemModel struct {
+	ID                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	Description          types.String `tfsdk:"description"`
+	TicketAccess         types.String `tfsdk:"ticket_access"`
+	TicketEditing        types.Bool   `tfsdk:"ticket_editing"`
+	EndUserProfileAccess types.String `tfsdk:"end_user_profile_access"`
+	ManageBusinessRules  types.Bool   `tfsdk:"manage_business_rules"`
+	ExploreAccess        types.String `tfsdk:"explore_access"`
+}
+
+func (d *CustomRolesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_roles"
+}
+
+func (d *CustomRolesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists Zendesk custom agent roles, sorted by name. Requires a Zendesk Enterprise plan.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Only return the role with this exact name. An error is returned if none match.",
+				Optional:    true,
+			},
+			"roles": schema.ListNestedAttribute{
+				Description: "The custom roles.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the custom role.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the custom role.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the custom role.",
+							Computed:    true,
+						},
+						"ticket_access": schema.StringAttribute{
+							Description: "Which tickets agents with the role can access (e.g., 'all', 'within-groups', 'assigned-only').",
+							Computed:    true,
+						},
+						"ticket_editing": schema.BoolAttribute{
+							Description: "Whether agents with the role can edit ticket properties.",
+							Computed:    true,
+						},
+						"end_user_profile_access": schema.StringAttribute{
+							Description: "The access agents with the role have to end user profiles.",
+							Computed:    true,
+						},
+						"manage_business_rules": schema.BoolAttribute{
+							Description: "Whether agents with the role can manage triggers, automations and SLAs.",
+							Computed:    true,
+						},
+						"explore_access": schema.StringAttribute{
+							Description: "The access agents with the role have to Explore.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CustomRolesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CustomRolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config CustomRolesDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roles, err := d.client.ListCustomRoles(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Custom Roles",
+			fmt.Sprintf("Could not list custom roles: %v", err),
+		)
+		return
+	}
+
+	sort.SliceStable(roles, func(i, j int) bool {
+		if roles[i].Name != roles[j].Name {
+			return roles[i].Name < roles[j].Name
+		}
+		return roles[i].ID < roles[j].ID
+	})
+
+	config.Roles = make([]CustomRolesItemModel, 0, len(roles))
+	for _, role := range roles {
+		if !config.Name.IsNull() && role.Name != config.Name.ValueString() {
+			continue
+		}
+
+		config.Roles = append(config.Roles, CustomRolesItemModel{
+			ID:                   types.StringValue(strconv.FormatInt(role.ID, 10)),
+			Name:                 types.StringValue(role.Name),
+			Description:          types.StringValue(role.Description),
+			TicketAccess:         types.StringValue(role.Configuration.TicketAccess),
+			TicketEditing:        types.BoolValue(role.Configuration.TicketEditing),
+			EndUserProfileAccess: types.StringValue(role.Configuration.EndUserProfileAccess),
+			ManageBusinessRules:  types.BoolValue(role.Configuration.ManageBusinessRules),
+			ExploreAccess:        types.StringValue(role.Configuration.ExploreAccess),
+		})
+	}
+
+	if !config.Name.IsNull() && len(config.Roles) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Custom Role Not Found",
+			fmt.Sprintf("No custom role found with name %q.", config.Name.ValueString()),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &CustomStatusesDataSource{}
+)
+
+func NewCustomStatusesDataSource() datasource.DataSource {
+	return &CustomStatusesDataSource{}
+}
+
+type CustomStatusesDataSource struct {
+	client *Client
+}
+
+type CustomStatusesDataSourceModel struct {
+	StatusCategory types.String              `tfsdk:"status_category"`
+	Active         types.Bool                `tfsdk:"active"`
+	Statuses       []CustomStatusesItemModel `tfsdk:"statuses"`
+	ByLabel        types.Map                 `tfsdk:"by_label"`
+}
+
+type CustomStatusesItemModel struct {
+	ID             types.String `tfsdk:"id"`
+	StatusCategory types.String `tfsdk:"status_category"`
+	AgentLabel     types.String `tfsdk:"agent_label"`
+	Active         types.Bool   `tfsdk:"active"`
+	Default        types.Bool   `tfsdk:"default"`
+}
+
+func (d *CustomStatusesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_statuses"
+}
+
+func (d *CustomStatusesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists Zendesk custom ticket statuses, sorted by ID. Returns an empty list on accounts without custom statuses.",
+		Attributes: map[string]schema.Attribute{
+			"status_category": schema.StringAttribute{
+				Description: "Only return statuses in this category: 'new', 'open', 'pending', 'hold' or 'solved'.",
+				Optional:    true,
+			},
+			"active": schema.BoolAttribute{
+				Description: "Only return active (true) or inactive (false) statuses.",
+				Optional:    true,
+			},
+			"statuses": schema.ListNestedAttribute{
+				Description: "The custom statuses.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the custom status.",
+							Computed:    true,
+						},
+						"status_category": schema.StringAttribute{
+							Description: "The category of the custom status.",
+							Computed:    true,
+						},
+						"agent_label": schema.StringAttribute{
+							Description: "The label shown to agents.",
+							Computed:    true,
+						},
+						"active": schema.BoolAttribute{
+							Description: "Whether the custom status is active.",
+							Computed:    true,
+						},
+						"default": schema.BoolAttribute{
+							Description: "Whether the custom status is the default of its category.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"by_label": schema.MapAttribute{
+				Description: "The custom status IDs keyed by agent label. When several statuses share a label, the one with the lowest ID is used.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (d *CustomStatusesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CustomStatusesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config CustomStatusesDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := url.Values{}
+	if !config.StatusCategory.IsNull() {
+		params.Set("status_categories", config.StatusCategory.ValueString())
+	}
+	if !config.Active.IsNull() {
+		params.Set("active", strconv.FormatBool(config.Active.ValueBool()))
+	}
+
+	statuses, err := d.client.ListCustomStatuses(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Custom Statuses",
+			fmt.Sprintf("Could not list custom statuses: %v", err),
+		)
+		return
+	}
+
+	sort.SliceStable(statuses, func(i, j int) bool {
+		return statuses[i].ID < statuses[j].ID
+	})
+
+	byLabel := make(map[string]string, len(statuses))
+	config.Statuses = make([]CustomStatusesItemModel, 0, len(statuses))
+	for _, status := range statuses {
+		id := strconv.FormatInt(status.ID, 10)
+		if _, ok := byLabel[status.AgentLabel]; !ok {
+			byLabel[status.AgentLabel] = id
+		}
+
+		config.Statuses = append(config.Statuses, CustomStatusesItemModel{
+			ID:             types.StringValue(id),
+			StatusCategory: types.StringValue(status.StatusCategory),
+			AgentLabel:     types.StringValue(status.AgentLabel),
+			Active:         types.BoolValue(status.Active),
+			Default:        types.BoolValue(status.Default),
+		})
+	}
+
+	config.ByLabel, diags = types.MapValueFrom(ctx, types.StringType, byLabel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
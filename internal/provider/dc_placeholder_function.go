@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var (
+	_ function.Function = &DCPlaceholderFunction{}
+)
+
+func NewDCPlaceholderFunction() function.Function {
+	return &DCPlaceholderFunction{}
+}
+
+type DCPlaceholderFunction struct{}
+
+func (f *DCPlaceholderFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "dc_placeholder"
+}
+
+func (f *DCPlaceholderFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Builds the placeholder of a dynamic content item.",
+		Description: "Returns the {{dc.<key>}} placeholder Zendesk assigns to a dynamic content item with the given name: the name is lowercased, whitespace becomes underscores, and other punctuation is dropped.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "name",
+				Description: "The name of the dynamic content item.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *DCPlaceholderFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := dynamicContentPlaceholder(name)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// runStringFunction runs f with string arguments and returns its string result.
+func runStringFunction(t *testing.T, f function.Function, args ...string) (string, *function.FuncError) {
+	t.Helper()
+
+	values := make([]attr.Value, 0, len(args))
+	for _, arg := range args {
+		values = append(values, types.StringValue(arg))
+	}
+
+	req := function.RunRequest{Arguments: function.NewArgumentsData(values)}
+	resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	f.Run(context.Background(), req, resp)
+	if resp.Error != nil {
+		return "", resp.Error
+	}
+
+	result, ok := resp.Result.Value().(types.String)
+	if !ok {
+		t.Fatalf("result is %T, want types.String", resp.Result.Value())
+	}
+	return result.ValueString(), nil
+}
+
+func TestDCPlaceholderFunction(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "Order Confirmation", want: "{{dc.order_confirmation}}"},
+		{name: "order_confirmation", want: "{{dc.order_confirmation}}"},
+		{name: "Hello, World!", want: "{{dc.hello_world}}"},
+		{name: "  Refund   Policy ", want: "{{dc.refund___policy}}"},
+		{name: "Política de Reembolso", want: "{{dc.política_de_reembolso}}"},
+		{name: "Step 2", want: "{{dc.step_2}}"},
+	}
+
+	for _, test := range tests {
+		got, err := runStringFunction(t, NewDCPlaceholderFunction(), test.name)
+		if err != nil {
+			t.Errorf("dc_placeholder(%q) failed: %s", test.name, err.Text)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("dc_placeholder(%q) = %q, want %q", test.name, got, test.want)
+		}
+	}
+}
+
+func TestDCPlaceholderFunctionInvalid(t *testing.T) {
+	for _, name := range []string{"", "   ", "!!!"} {
+		_, err := runStringFunction(t, NewDCPlaceholderFunction(), name)
+		if err == nil {
+			t.Errorf("dc_placeholder(%q) succeeded, want an error", name)
+			continue
+		}
+		if err.FunctionArgument == nil || *err.FunctionArgument != 0 {
+			t.Errorf("dc_placeholder(%q) error is not about the name argument: %v", name, err)
+		}
+	}
+}
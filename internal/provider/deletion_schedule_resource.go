@@ -0,0 +1,245 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &DeletionScheduleResource{}
+	_ resource.ResourceWithImportState = &DeletionScheduleResource{}
+)
+
+func NewDeletionScheduleResource() resource.Resource {
+	return &DeletionScheduleResource{}
+}
+
+type DeletionScheduleResource struct {
+	client *Client
+}
+
+type DeletionScheduleResourceModel struct {
+	ID          types.String         `tfsdk:"id"`
+	Name        types.String         `tfsdk:"name"`
+	Description types.String         `tfsdk:"description"`
+	Active      types.Bool           `tfsdk:"active"`
+	Conditions  *RuleConditionsModel `tfsdk:"conditions"`
+}
+
+func (r *DeletionScheduleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deletion_schedule"
+}
+
+func (r *DeletionScheduleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Zendesk deletion schedule, which permanently deletes the tickets matching its conditions.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the deletion schedule.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the deletion schedule.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the deletion schedule.",
+				Optional:    true,
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether the deletion schedule deletes tickets. Defaults to false, so that a new schedule can be reviewed before it is enabled.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"conditions": ruleConditionsAttribute("The conditions of the tickets to delete, e.g. closed tickets not updated for a year."),
+		},
+	}
+}
+
+func (r *DeletionScheduleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DeletionScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DeletionScheduleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedule := expandDeletionSchedule(plan)
+	schedule.Object = "ticket"
+
+	created, err := r.client.CreateDeletionSchedule(ctx, schedule)
+	if err != nil {
+		if diags := ruleValidationDiagnostics("Error Creating Deletion Schedule", err); diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Creating Deletion Schedule",
+			fmt.Sprintf("Could not create deletion schedule: %v", err),
+		)
+		return
+	}
+
+	flattenDeletionSchedule(created, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *DeletionScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DeletionScheduleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Deletion Schedule ID",
+			fmt.Sprintf("Could not parse deletion schedule ID: %v", err),
+		)
+		return
+	}
+
+	schedule, err := r.client.ReadDeletionSchedule(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Deletion Schedule",
+			fmt.Sprintf("Could not read deletion schedule: %v", err),
+		)
+		return
+	}
+
+	if schedule == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	flattenDeletionSchedule(schedule, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *DeletionScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DeletionScheduleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(plan.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Deletion Schedule ID",
+			fmt.Sprintf("Could not parse deletion schedule ID: %v", err),
+		)
+		return
+	}
+
+	schedule, err := r.client.UpdateDeletionSchedule(ctx, id, expandDeletionSchedule(plan))
+	if err != nil {
+		if diags := ruleValidationDiagnostics("Error Updating Deletion Schedule", err); diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Updating Deletion Schedule",
+			fmt.Sprintf("Could not update deletion schedule: %v", err),
+		)
+		return
+	}
+
+	flattenDeletionSchedule(schedule, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *DeletionScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DeletionScheduleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Deletion Schedule ID",
+			fmt.Sprintf("Could not parse deletion schedule ID: %v", err),
+		)
+		return
+	}
+
+	err = r.client.DeleteDeletionSchedule(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Deletion Schedule",
+			fmt.Sprintf("Could not delete deletion schedule %q: %v", state.Name.ValueString(), err),
+		)
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Deletion Schedule Removed",
+		fmt.Sprintf("The deletion schedule %q was deleted. Only the schedule was removed: no tickets were deleted, and tickets it already deleted are not restored.", state.Name.ValueString()),
+	)
+}
+
+func (r *DeletionScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func expandDeletionSchedule(model DeletionScheduleResourceModel) DeletionSchedule {
+	return DeletionSchedule{
+		Name:        model.Name.ValueString(),
+		Description: model.Description.ValueString(),
+		Active:      model.Active.ValueBool(),
+		Conditions:  expandRuleConditions(model.Conditions),
+	}
+}
+
+func flattenDeletionSchedule(schedule *DeletionSchedule, model *DeletionScheduleResourceModel) {
+	model.ID = types.StringValue(strconv.FormatInt(schedule.ID, 10))
+	model.Name = types.StringValue(schedule.Name)
+	model.Description = optionalStringValue(schedule.Description)
+	model.Active = types.BoolValue(schedule.Active)
+	model.Conditions = flattenRuleConditions(schedule.Conditions, model.Conditions)
+}
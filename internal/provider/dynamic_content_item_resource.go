@@ -0,0 +1,281 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &DynamicContentItemResource{}
+	_ resource.ResourceWithImportState = &DynamicContentItemResource{}
+)
+
+func NewDynamicContentItemResource() resource.Resource {
+	return &DynamicContentItemResource{}
+}
+
+type DynamicContentItemResource struct {
+	client *Client
+}
+
+type DynamicContentItemResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	DefaultLocaleID types.String `tfsdk:"default_locale_id"`
+	Content         types.String `tfsdk:"content"`
+	Placeholder     types.String `tfsdk:"placeholder"`
+}
+
+func (r *DynamicContentItemResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dynamic_content_item"
+}
+
+func (r *DynamicContentItemResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Zendesk dynamic content item and the content of its default variant.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the dynamic content item.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the dynamic content item.",
+				Required:    true,
+			},
+			"default_locale_id": schema.StringAttribute{
+				Description: "The ID of the locale of the default variant. Changing it forces a new resource, as Zendesk requires a variant in the new locale first.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				Description: "The content of the default variant.",
+				Required:    true,
+			},
+			"placeholder": schema.StringAttribute{
+				Description: "The placeholder of the item, e.g. '{{dc.welcome_message}}', for use in triggers and macros. Zendesk derives it from the name on creation and keeps it when the item is renamed.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DynamicContentItemResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DynamicContentItemResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DynamicContentItemResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	localeID, err := strconv.ParseInt(plan.DefaultLocaleID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_locale_id"),
+			"Error Parsing Locale ID",
+			fmt.Sprintf("Could not parse locale ID: %v", err),
+		)
+		return
+	}
+
+	// Zendesk requires at least one variant, so the default one is created with the item.
+	item, err := r.client.CreateDynamicContentItem(ctx, DynamicContentItem{
+		Name:            plan.Name.ValueString(),
+		DefaultLocaleID: localeID,
+		Variants: []DynamicContentVariant{{
+			Content:  plan.Content.ValueString(),
+			LocaleID: localeID,
+			Active:   true,
+			Default:  true,
+		}},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Dynamic Content Item",
+			fmt.Sprintf("Could not create dynamic content item: %v", err),
+		)
+		return
+	}
+
+	flattenDynamicContentItem(item, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *DynamicContentItemResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DynamicContentItemResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Dynamic Content Item ID",
+			fmt.Sprintf("Could not parse dynamic content item ID: %v", err),
+		)
+		return
+	}
+
+	item, err := r.client.ReadDynamicContentItem(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Dynamic Content Item",
+			fmt.Sprintf("Could not read dynamic content item: %v", err),
+		)
+		return
+	}
+
+	if item == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	flattenDynamicContentItem(item, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *DynamicContentItemResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state DynamicContentItemResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(plan.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Dynamic Content Item ID",
+			fmt.Sprintf("Could not parse dynamic content item ID: %v", err),
+		)
+		return
+	}
+
+	localeID, err := strconv.ParseInt(plan.DefaultLocaleID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_locale_id"),
+			"Error Parsing Locale ID",
+			fmt.Sprintf("Could not parse locale ID: %v", err),
+		)
+		return
+	}
+
+	item, err := r.client.UpdateDynamicContentItem(ctx, id, DynamicContentItem{
+		Name:            plan.Name.ValueString(),
+		DefaultLocaleID: localeID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Dynamic Content Item",
+			fmt.Sprintf("Could not update dynamic content item: %v", err),
+		)
+		return
+	}
+
+	if !plan.Content.Equal(state.Content) {
+		variant := item.DefaultVariant()
+		if variant == nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Dynamic Content Item",
+				fmt.Sprintf("Dynamic content item %d has no default variant to update.", id),
+			)
+			return
+		}
+
+		variant.Content = plan.Content.ValueString()
+		updated, err := r.client.UpdateDynamicContentVariant(ctx, id, *variant)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Dynamic Content Item",
+				fmt.Sprintf("Could not update the default variant: %v", err),
+			)
+			return
+		}
+		*variant = *updated
+	}
+
+	flattenDynamicContentItem(item, &plan)
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *DynamicContentItemResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DynamicContentItemResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Dynamic Content Item ID",
+			fmt.Sprintf("Could not parse dynamic content item ID: %v", err),
+		)
+		return
+	}
+
+	err = r.client.DeleteDynamicContentItem(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Dynamic Content Item",
+			fmt.Sprintf("Could not delete dynamic content item: %v", err),
+		)
+		return
+	}
+}
+
+func (r *DynamicContentItemResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func flattenDynamicContentItem(item *DynamicContentItem, model *DynamicContentItemResourceModel) {
+	model.ID = types.StringValue(strconv.FormatInt(item.ID, 10))
+	model.Name = types.StringValue(item.Name)
+	model.DefaultLocaleID = types.StringValue(strconv.FormatInt(item.DefaultLocaleID, 10))
+	model.Placeholder = types.StringValue(item.Placeholder)
+	if variant := item.DefaultVariant(); variant != nil {
+		model.Content = types.StringValue(variant.Content)
+	}
+}
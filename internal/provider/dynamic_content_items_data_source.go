@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &DynamicContentItemsDataSource{}
+)
+
+func NewDynamicContentItemsDataSource() datasource.DataSource {
+	return &DynamicContentItemsDataSource{}
+}
+
+type DynamicContentItemsDataSource struct {
+	client *Client
+}
+
+type DynamicContentItemsDataSourceModel struct {
+	Name            types.String                   `tfsdk:"name"`
+	IncludeVariants types.Bool                     `tfsdk:"include_variants"`
+	Items           []DynamicContentItemsItemModel `tfsdk:"items"`
+	ByPlaceholder   types.Map                      `tfsdk:"by_placeholder"`
+}
+
+type DynamicContentItemsItemModel struct {
+	ID               types.String                 `tfsdk:"id"`
+	Name             types.String                 `tfsdk:"name"`
+	Placeholder      types.String                 `tfsdk:"placeholder"`
+	DefaultLocaleID  types.String                 `tfsdk:"default_locale_id"`
+	VariantLocaleIDs []types.String               `tfsdk:"variant_locale_ids"`
+	Variants         []DynamicContentVariantModel `tfsdk:"variants"`
+}
+
+type DynamicContentVariantModel struct {
+	ID       types.String `tfsdk:"id"`
+	LocaleID types.String `tfsdk:"locale_id"`
+	Content  types.String `tfsdk:"content"`
+	Active   types.Bool   `tfsdk:"active"`
+	Default  types.Bool   `tfsdk:"default"`
+}
+
+func (d *DynamicContentItemsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dynamic_content_items"
+}
+
+func (d *DynamicContentItemsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists Zendesk dynamic content items, sorted by name.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Only return the items with this exact name.",
+				Optional:    true,
+			},
+			"include_variants": schema.BoolAttribute{
+				Description: "Whether to export the variants of every item, including their content. Defaults to false to keep the payload small.",
+				Optional:    true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "The dynamic content items.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the item.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the item.",
+							Computed:    true,
+						},
+						"placeholder": schema.StringAttribute{
+							Description: "The placeholder referencing the item (e.g., '{{dc.welcome}}').",
+							Computed:    true,
+						},
+						"default_locale_id": schema.StringAttribute{
+							Description: "The ID of the locale of the default variant.",
+							Computed:    true,
+						},
+						"variant_locale_ids": schema.ListAttribute{
+							Description: "The IDs of the locales the item has a variant for.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"variants": schema.ListNestedAttribute{
+							Description: "The variants of the item. Null unless include_variants is true.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Description: "The ID of the variant.",
+										Computed:    true,
+									},
+									"locale_id": schema.StringAttribute{
+										Description: "The ID of the locale of the variant.",
+										Computed:    true,
+									},
+									"content": schema.StringAttribute{
+										Description: "The content of the variant.",
+										Computed:    true,
+									},
+									"active": schema.BoolAttribute{
+										Description: "Whether the variant is active.",
+										Computed:    true,
+									},
+									"default": schema.BoolAttribute{
+										Description: "Whether the variant is the default variant.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"by_placeholder": schema.MapAttribute{
+				Description: "The IDs of the returned items, keyed by placeholder.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (d *DynamicContentItemsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DynamicContentItemsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DynamicContentItemsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, err := d.client.ListDynamicContentItems(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Dynamic Content Items",
+			fmt.Sprintf("Could not list dynamic content items: %v", err),
+		)
+		return
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].Name != items[j].Name {
+			return items[i].Name < items[j].Name
+		}
+		return items[i].ID < items[j].ID
+	})
+
+	byPlaceholder := make(map[string]string, len(items))
+	config.Items = make([]DynamicContentItemsItemModel, 0, len(items))
+	for _, item := range items {
+		if !config.Name.IsNull() && item.Name != config.Name.ValueString() {
+			continue
+		}
+
+		id := strconv.FormatInt(item.ID, 10)
+		byPlaceholder[item.Placeholder] = id
+
+		localeIDs := make([]int64, 0, len(item.Variants))
+		var variants []DynamicContentVariantModel
+		for _, variant := range item.Variants {
+			localeIDs = append(localeIDs, variant.LocaleID)
+
+			if config.IncludeVariants.ValueBool() {
+				variants = append(variants, DynamicContentVariantModel{
+					ID:       types.StringValue(strconv.FormatInt(variant.ID, 10)),
+					LocaleID: types.StringValue(strconv.FormatInt(variant.LocaleID, 10)),
+					Content:  types.StringValue(variant.Content),
+					Active:   types.BoolValue(variant.Active),
+					Default:  types.BoolValue(variant.Default),
+				})
+			}
+		}
+		sort.Slice(localeIDs, func(i, j int) bool {
+			return localeIDs[i] < localeIDs[j]
+		})
+
+		config.Items = append(config.Items, DynamicContentItemsItemModel{
+			ID:               types.StringValue(id),
+			Name:             types.StringValue(item.Name),
+			Placeholder:      types.StringValue(item.Placeholder),
+			DefaultLocaleID:  types.StringValue(strconv.FormatInt(item.DefaultLocaleID, 10)),
+			VariantLocaleIDs: idListValue(localeIDs),
+			Variants:         variants,
+		})
+	}
+
+	config.ByPlaceholder, diags = types.MapValueFrom(ctx, types.StringType, byPlaceholder)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type CustomFieldOptionModel struct {
+	ID    types.String `tfsdk:"id"`
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
+
+func customFieldOptionsAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: "The options of a dropdown or multiselect field, in display order.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Description: "The ID of the option.",
+					Computed:    true,
+				},
+				"name": schema.StringAttribute{
+					Description: "The display name of the option.",
+					Computed:    true,
+				},
+				"value": schema.StringAttribute{
+					Description: "The value (tag) of the option.",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func flattenCustomFieldOptions(options []CustomFieldOption) []CustomFieldOptionModel {
+	models := make([]CustomFieldOptionModel, 0, len(options))
+	for _, option := range options {
+		models = append(models, CustomFieldOptionModel{
+			ID:    types.StringValue(strconv.FormatInt(option.ID, 10)),
+			Name:  types.StringValue(option.Name),
+			Value: types.StringValue(option.Value),
+		})
+	}
+	return models
+}
+
+// filterFields applies the type and active filters shared by the field data sources and sorts
+// the remaining fields by ID.
+func filterFields(fields []Field, fieldType types.String, active types.Bool) []Field {
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].ID < fields[j].ID
+	})
+
+	filtered := make([]Field, 0, len(fields))
+	for _, field := range fields {
+		if !fieldType.IsNull() && field.Type != fieldType.ValueString() {
+			continue
+		}
+		if !active.IsNull() && field.Active != active.ValueBool() {
+			continue
+		}
+		filtered = append(filtered, field)
+	}
+	return filtered
+}
+
+var (
+	_ datasource.DataSource = &KeyedFieldsDataSource{}
+)
+
+// KeyedFieldsDataSource lists the fields identified by a key, i.e. user and organization
+// fields. The data sources only differ in the kind of field they list.
+type KeyedFieldsDataSource struct {
+	client *Client
+	kind   string
+	list   func(*Client, context.Context) ([]Field, error)
+}
+
+type KeyedFieldsDataSourceModel struct {
+	Type   types.String           `tfsdk:"type"`
+	Active types.Bool             `tfsdk:"active"`
+	Fields []KeyedFieldsItemModel `tfsdk:"fields"`
+	ByKey  types.Map              `tfsdk:"by_key"`
+}
+
+type KeyedFieldsItemModel struct {
+	ID                 types.String             `tfsdk:"id"`
+	Key                types.String             `tfsdk:"key"`
+	Type               types.String             `tfsdk:"type"`
+	Title              types.String             `tfsdk:"title"`
+	Active             types.Bool               `tfsdk:"active"`
+	CustomFieldOptions []CustomFieldOptionModel `tfsdk:"custom_field_options"`
+}
+
+func (d *KeyedFieldsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_%s_fields", req.ProviderTypeName, d.kind)
+}
+
+func (d *KeyedFieldsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Lists all Zendesk %s fields, including the options of dropdown fields, sorted by ID.", d.kind),
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Description: "Only return fields of this type (e.g., 'dropdown').",
+				Optional:    true,
+			},
+			"active": schema.BoolAttribute{
+				Description: "Only return active (true) or inactive (false) fields.",
+				Optional:    true,
+			},
+			"fields": schema.ListNestedAttribute{
+				Description: fmt.Sprintf("The %s fields.", d.kind),
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the field.",
+							Computed:    true,
+						},
+						"key": schema.StringAttribute{
+							Description: "The key of the field.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of the field.",
+							Computed:    true,
+						},
+						"title": schema.StringAttribute{
+							Description: "The title of the field.",
+							Computed:    true,
+						},
+						"active": schema.BoolAttribute{
+							Description: "Whether the field is active.",
+							Computed:    true,
+						},
+						"custom_field_options": customFieldOptionsAttribute(),
+					},
+				},
+			},
+			"by_key": schema.MapAttribute{
+				Description: "The IDs of the returned fields, keyed by field key.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (d *KeyedFieldsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *KeyedFieldsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config KeyedFieldsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fields, err := d.list(d.client, ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Fields",
+			fmt.Sprintf("Could not list %s fields: %v", d.kind, err),
+		)
+		return
+	}
+
+	fields = filterFields(fields, config.Type, config.Active)
+
+	byKey := make(map[string]string, len(fields))
+	config.Fields = make([]KeyedFieldsItemModel, 0, len(fields))
+	for _, field := range fields {
+		id := strconv.FormatInt(field.ID, 10)
+		byKey[field.Key] = id
+
+		config.Fields = append(config.Fields, KeyedFieldsItemModel{
+			ID:                 types.StringValue(id),
+			Key:                types.StringValue(field.Key),
+			Type:               types.StringValue(field.Type),
+			Title:              types.StringValue(field.Title),
+			Active:             types.BoolValue(field.Active),
+			CustomFieldOptions: flattenCustomFieldOptions(field.CustomFieldOptions),
+		})
+	}
+
+	config.ByKey, diags = types.MapValueFrom(ctx, types.StringType, byKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	// fixtureSubdomain replaces the subdomain of the recording account in fixtures.
+	fixtureSubdomain = "example"
+
+	// fixtureFirstID is the first of the IDs that replace the real IDs in fixtures.
+	fixtureFirstID = 1000001
+)
+
+var (
+	// fixtureSecretPattern matches the JSON members whose values are redacted in fixtures.
+	fixtureSecretPattern = regexp.MustCompile(`"(full_token|token|secret|password|api_token|access_token)"\s*:\s*"[^"]*"`)
+
+	// fixtureIDPattern matches the numbers normalized in fixtures. Zendesk IDs are large, so
+	// shorter numbers such as positions and counts are left alone.
+	fixtureIDPattern = regexp.MustCompile(`\b[0-9]{7,}\b`)
+)
+
+// fixtureInteraction is a recorded request and its response.
+type fixtureInteraction struct {
+	Method       string `json:"method"`
+	URL          string `json:"url"`
+	RequestBody  string `json:"request_body,omitempty"`
+	Status       int    `json:"status"`
+	ResponseBody string `json:"response_body,omitempty"`
+}
+
+// fixtureTransport records the interactions of a client to a file, or replays them from it.
+// Recorded interactions are sanitized: secrets are redacted, the subdomain is replaced, and
+// IDs are renumbered consistently so that requests built from earlier responses still match.
+// Replay is strict: every request must match the next recorded interaction.
+type fixtureTransport struct {
+	path      string
+	subdomain string
+	record    bool
+	next      http.RoundTripper
+
+	mu           sync.Mutex
+	loaded       bool
+	interactions []fixtureInteraction
+	position     int
+	ids          map[string]string
+}
+
+// newFixtureTransportFromEnv returns a fixture transport when ZENDESK_TEST_FIXTURES names a
+// fixture file. ZENDESK_TEST_RECORD=1 records the file against the real API; otherwise the
+// file is replayed without any network access.
+func newFixtureTransportFromEnv(subdomain string, next http.RoundTripper) http.RoundTripper {
+	path := os.Getenv("ZENDESK_TEST_FIXTURES")
+	if path == "" {
+		return next
+	}
+
+	return &fixtureTransport{
+		path:      path,
+		subdomain: subdomain,
+		record:    os.Getenv("ZENDESK_TEST_RECORD") == "1",
+		next:      next,
+		ids:       map[string]string{},
+	}
+}
+
+func (t *fixtureTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	if t.record {
+		return t.recordInteraction(req, body)
+	}
+	return t.replayInteraction(req, body)
+}
+
+func (t *fixtureTransport) recordInteraction(req *http.Request, body []byte) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	t.interactions = append(t.interactions, fixtureInteraction{
+		Method:       req.Method,
+		URL:          t.sanitize(req.URL.String()),
+		RequestBody:  t.sanitize(string(body)),
+		Status:       resp.StatusCode,
+		ResponseBody: t.sanitize(string(responseBody)),
+	})
+
+	if err := t.save(); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (t *fixtureTransport) replayInteraction(req *http.Request, body []byte) (*http.Response, error) {
+	if !t.loaded {
+		raw, err := os.ReadFile(t.path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read fixture: %w", err)
+		}
+		if err := json.Unmarshal(raw, &t.interactions); err != nil {
+			return nil, fmt.Errorf("failed to parse fixture %s: %w", t.path, err)
+		}
+		t.loaded = true
+	}
+
+	requestURL := t.normalizeSubdomain(req.URL.String())
+	if t.position >= len(t.interactions) {
+		return nil, fmt.Errorf("fixture %s has no interaction left for %s %s", t.path, req.Method, requestURL)
+	}
+
+	interaction := t.interactions[t.position]
+	if interaction.Method != req.Method || interaction.URL != requestURL || !equalJSON(interaction.RequestBody, t.normalizeSubdomain(string(body))) {
+		return nil, fmt.Errorf("fixture %s expected %s %s as interaction %d, got %s %s", t.path, interaction.Method, interaction.URL, t.position, req.Method, requestURL)
+	}
+	t.position++
+
+	return &http.Response{
+		Status:     fmt.Sprintf("%d %s", interaction.Status, http.StatusText(interaction.Status)),
+		StatusCode: interaction.Status,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(interaction.ResponseBody)),
+		Request:    req,
+	}, nil
+}
+
+func (t *fixtureTransport) save() error {
+	raw, err := json.MarshalIndent(t.interactions, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(t.path), 0o755); err != nil {
+		return fmt.Errorf("failed to create fixture directory: %w", err)
+	}
+
+	if err := os.WriteFile(t.path, raw, 0o644); err != nil {
+		return fmt.Errorf("failed to write fixture: %w", err)
+	}
+
+	return nil
+}
+
+func (t *fixtureTransport) normalizeSubdomain(value string) string {
+	return strings.ReplaceAll(value, "://"+t.subdomain+".zendesk.com", "://"+fixtureSubdomain+".zendesk.com")
+}
+
+// sanitize redacts secrets, replaces the subdomain, and renumbers IDs in a recorded value.
+func (t *fixtureTransport) sanitize(value string) string {
+	value = fixtureSecretPattern.ReplaceAllStringFunc(value, func(member string) string {
+		name, _, _ := strings.Cut(member, ":")
+		return name + `:"REDACTED"`
+	})
+	value = t.normalizeSubdomain(value)
+
+	return fixtureIDPattern.ReplaceAllStringFunc(value, func(id string) string {
+		normalized, ok := t.ids[id]
+		if !ok {
+			normalized = strconv.Itoa(fixtureFirstID + len(t.ids))
+			t.ids[id] = normalized
+		}
+		return normalized
+	})
+}
+
+// equalJSON compares two request bodies, ignoring formatting when both are JSON.
+func equalJSON(a, b string) bool {
+	var compactA, compactB bytes.Buffer
+	if json.Compact(&compactA, []byte(a)) != nil || json.Compact(&compactB, []byte(b)) != nil {
+		return a == b
+	}
+	return compactA.String() == compactB.String()
+}
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// A recording is sanitized and then replays the same responses without the API.
+func TestFixtureTransportRecordAndReplay(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/v2/oauth/clients.json":
+			if !strings.Contains(string(body), `"identifier":"deploy_bot"`) {
+				t.Errorf("unexpected request body %s", body)
+			}
+			w.WriteHeader(http.StatusCreated)
+			io.WriteString(w, `{"client": {"id": 360012345678, "name": "Deploy bot", "identifier": "deploy_bot", "kind": "confidential", "secret": "s3cr3t", "url": "https://acme.zendesk.com/api/v2/oauth/clients/360012345678.json"}}`)
+		case r.Method == "GET" && r.URL.Path == "/api/v2/oauth/clients/360012345678.json":
+			io.WriteString(w, `{"client": {"id": 360012345678, "name": "Deploy bot", "identifier": "deploy_bot", "kind": "confidential", "secret": "s3cr...", "url": "https://acme.zendesk.com/api/v2/oauth/clients/360012345678.json"}}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	target, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fixture := filepath.Join(t.TempDir(), "oauth_client.json")
+	t.Setenv("ZENDESK_TEST_FIXTURES", fixture)
+
+	ctx := context.Background()
+
+	t.Setenv("ZENDESK_TEST_RECORD", "1")
+	recording := NewClient("acme", "agent@example.com", "token")
+	recording.http = &http.Client{Transport: newFixtureTransportFromEnv("acme", redirectTransport{target: target})}
+
+	created, err := recording.CreateOAuthClient(ctx, "Deploy bot", "deploy_bot", "confidential", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := recording.ReadOAuthClient(ctx, created.ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	raw, err := os.ReadFile(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, leaked := range []string{"s3cr3t", "s3cr...", "acme", "360012345678"} {
+		if strings.Contains(string(raw), leaked) {
+			t.Errorf("expected %q to be sanitized from the fixture:\n%s", leaked, raw)
+		}
+	}
+
+	var interactions []fixtureInteraction
+	if err := json.Unmarshal(raw, &interactions); err != nil {
+		t.Fatal(err)
+	}
+	if len(interactions) != 2 {
+		t.Fatalf("expected 2 interactions, got %d", len(interactions))
+	}
+	if want := "https://example.zendesk.com/api/v2/oauth/clients/1000001.json"; interactions[1].URL != want {
+		t.Errorf("expected the renumbered URL %s, got %s", want, interactions[1].URL)
+	}
+
+	// The replay serves the fixture alone; the test server is closed to prove it.
+	server.Close()
+	t.Setenv("ZENDESK_TEST_RECORD", "")
+	replaying := NewClient("example", "agent@example.com", "token")
+
+	replayed, err := replaying.CreateOAuthClient(ctx, "Deploy bot", "deploy_bot", "confidential", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if replayed.ID != 1000001 || replayed.Secret != "REDACTED" {
+		t.Errorf("expected the sanitized client, got %+v", replayed)
+	}
+	if _, err := replaying.ReadOAuthClient(ctx, replayed.ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestFixtureTransportReplayIsStrict(t *testing.T) {
+	t.Setenv("ZENDESK_TEST_FIXTURES", filepath.Join("testdata", "oauth_client_deleted.json"))
+	t.Setenv("ZENDESK_TEST_RECORD", "")
+	client := NewClient("example", "agent@example.com", "token")
+
+	_, err := client.ReadOAuthClient(context.Background(), 1000002)
+	if err == nil || !strings.Contains(err.Error(), "expected GET https://example.zendesk.com/api/v2/oauth/clients/1000001.json as interaction 0") {
+		t.Fatalf("expected a fixture mismatch, got %v", err)
+	}
+}
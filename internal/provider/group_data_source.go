@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                     = &GroupDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &GroupDataSource{}
+)
+
+func NewGroupDataSource() datasource.DataSource {
+	return &GroupDataSource{}
+}
+
+type GroupDataSource struct {
+	client *Client
+}
+
+type GroupDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	IsPublic    types.Bool   `tfsdk:"is_public"`
+	Default     types.Bool   `tfsdk:"default"`
+}
+
+func (d *GroupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group"
+}
+
+func (d *GroupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a Zendesk group by ID or name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the group. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the group, matched exactly but case-insensitively. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the group.",
+				Computed:    true,
+			},
+			"is_public": schema.BoolAttribute{
+				Description: "Whether the group is public.",
+				Computed:    true,
+			},
+			"default": schema.BoolAttribute{
+				Description: "Whether the group is the default group of the account.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *GroupDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *GroupDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *GroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config GroupDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var group *Group
+	if !config.ID.IsNull() {
+		id, err := strconv.ParseInt(config.ID.ValueString(), 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Error Parsing Group ID",
+				fmt.Sprintf("Could not parse group ID: %v", err),
+			)
+			return
+		}
+
+		group, err = d.client.ReadGroup(ctx, id)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Group",
+				fmt.Sprintf("Could not read group: %v", err),
+			)
+			return
+		}
+
+		if group == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Group Not Found",
+				fmt.Sprintf("No group found with id %q.", config.ID.ValueString()),
+			)
+			return
+		}
+	} else {
+		groups, err := d.client.ListGroups(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Listing Groups",
+				fmt.Sprintf("Could not list groups: %v", err),
+			)
+			return
+		}
+
+		var found []Group
+		for _, g := range groups {
+			if !g.Deleted && strings.EqualFold(g.Name, config.Name.ValueString()) {
+				found = append(found, g)
+			}
+		}
+
+		switch len(found) {
+		case 0:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Group Not Found",
+				fmt.Sprintf("No group found with name %q.", config.Name.ValueString()),
+			)
+			return
+		case 1:
+			group = &found[0]
+		default:
+			ids := make([]string, 0, len(found))
+			for _, g := range found {
+				ids = append(ids, strconv.FormatInt(g.ID, 10))
+			}
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Multiple Groups Found",
+				fmt.Sprintf("Found %d groups with name %q (IDs: %s). Look the group up by id instead.", len(found), config.Name.ValueString(), strings.Join(ids, ", ")),
+			)
+			return
+		}
+	}
+
+	config.ID = types.StringValue(strconv.FormatInt(group.ID, 10))
+	config.Name = types.StringValue(group.Name)
+	config.Description = types.StringValue(group.Description)
+	config.IsPublic = types.BoolValue(group.IsPublic)
+	config.Default = types.BoolValue(group.Default)
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
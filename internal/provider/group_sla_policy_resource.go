@@ -0,0 +1,265 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &GroupSLAPolicyResource{}
+	_ resource.ResourceWithImportState = &GroupSLAPolicyResource{}
+)
+
+func NewGroupSLAPolicyResource() resource.Resource {
+	return &GroupSLAPolicyResource{}
+}
+
+type GroupSLAPolicyResource struct {
+	client *Client
+}
+
+type GroupSLAPolicyResourceModel struct {
+	ID            types.String           `tfsdk:"id"`
+	Title         types.String           `tfsdk:"title"`
+	Description   types.String           `tfsdk:"description"`
+	Position      types.Int64            `tfsdk:"position"`
+	Filter        *RuleConditionsModel   `tfsdk:"filter"`
+	PolicyMetrics []SLAPolicyMetricModel `tfsdk:"policy_metrics"`
+}
+
+func (r *GroupSLAPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_sla_policy"
+}
+
+func (r *GroupSLAPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Zendesk group SLA policy (OLA), which sets targets for the time a group owns a ticket. The schema mirrors zendesk_sla_policy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the group SLA policy.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "The title of the group SLA policy.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the group SLA policy.",
+				Optional:    true,
+			},
+			"position": schema.Int64Attribute{
+				Description: "The position of the group SLA policy, which determines the order in which policies are matched.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"filter":         ruleConditionsAttribute("The conditions a ticket must meet for the policy to apply."),
+			"policy_metrics": slaPolicyMetricsAttribute("The metric (e.g., 'group_ownership_time')."),
+		},
+	}
+}
+
+func (r *GroupSLAPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GroupSLAPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan GroupSLAPolicyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := r.client.CreateGroupSLAPolicy(ctx, expandGroupSLAPolicy(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Group SLA Policy",
+			fmt.Sprintf("Could not create group SLA policy: %v", err),
+		)
+		return
+	}
+
+	flattenGroupSLAPolicy(policy, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *GroupSLAPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state GroupSLAPolicyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Group SLA Policy ID",
+			fmt.Sprintf("Could not parse group SLA policy ID: %v", err),
+		)
+		return
+	}
+
+	policy, err := r.client.ReadGroupSLAPolicy(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Group SLA Policy",
+			fmt.Sprintf("Could not read group SLA policy: %v", err),
+		)
+		return
+	}
+
+	if policy == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	flattenGroupSLAPolicy(policy, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *GroupSLAPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan GroupSLAPolicyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(plan.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Group SLA Policy ID",
+			fmt.Sprintf("Could not parse group SLA policy ID: %v", err),
+		)
+		return
+	}
+
+	policy, err := r.client.UpdateGroupSLAPolicy(ctx, id, expandGroupSLAPolicy(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Group SLA Policy",
+			fmt.Sprintf("Could not update group SLA policy: %v", err),
+		)
+		return
+	}
+
+	flattenGroupSLAPolicy(policy, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *GroupSLAPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state GroupSLAPolicyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Group SLA Policy ID",
+			fmt.Sprintf("Could not parse group SLA policy ID: %v", err),
+		)
+		return
+	}
+
+	err = r.client.DeleteGroupSLAPolicy(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Group SLA Policy",
+			fmt.Sprintf("Could not delete group SLA policy: %v", err),
+		)
+		return
+	}
+}
+
+func (r *GroupSLAPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func expandGroupSLAPolicy(model GroupSLAPolicyResourceModel) GroupSLAPolicy {
+	return GroupSLAPolicy{
+		Title:         model.Title.ValueString(),
+		Description:   model.Description.ValueString(),
+		Position:      model.Position.ValueInt64(),
+		Filter:        expandRuleConditions(model.Filter),
+		PolicyMetrics: expandGroupSLAPolicyMetrics(model.PolicyMetrics),
+	}
+}
+
+func flattenGroupSLAPolicy(policy *GroupSLAPolicy, model *GroupSLAPolicyResourceModel) {
+	model.ID = types.StringValue(strconv.FormatInt(policy.ID, 10))
+	model.Title = types.StringValue(policy.Title)
+	if policy.Description != "" || !model.Description.IsNull() {
+		model.Description = types.StringValue(policy.Description)
+	}
+	model.Position = types.Int64Value(policy.Position)
+	model.Filter = flattenRuleConditions(policy.Filter, model.Filter)
+	model.PolicyMetrics = flattenGroupSLAPolicyMetrics(policy.PolicyMetrics)
+}
+
+// expandGroupSLAPolicyMetrics converts the targets, in minutes as for SLA policies, to the
+// seconds the group SLA API uses.
+func expandGroupSLAPolicyMetrics(models []SLAPolicyMetricModel) []GroupSLAPolicyMetric {
+	metrics := make([]GroupSLAPolicyMetric, 0, len(models))
+	for _, metric := range expandSLAPolicyMetrics(models) {
+		metrics = append(metrics, GroupSLAPolicyMetric{
+			Priority:        metric.Priority,
+			Metric:          metric.Metric,
+			TargetInSeconds: metric.Target * 60,
+			BusinessHours:   metric.BusinessHours,
+		})
+	}
+	return metrics
+}
+
+// flattenGroupSLAPolicyMetrics converts the targets back to minutes. Targets set outside
+// Terraform to a number of seconds that is not a whole minute are rounded up.
+func flattenGroupSLAPolicyMetrics(metrics []GroupSLAPolicyMetric) []SLAPolicyMetricModel {
+	converted := make([]SLAPolicyMetric, 0, len(metrics))
+	for _, metric := range metrics {
+		converted = append(converted, SLAPolicyMetric{
+			Priority:      metric.Priority,
+			Metric:        metric.Metric,
+			Target:        (metric.TargetInSeconds + 59) / 60,
+			BusinessHours: metric.BusinessHours,
+		})
+	}
+	return flattenSLAPolicyMetrics(converted)
+}
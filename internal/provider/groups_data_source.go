@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &GroupsDataSource{}
+)
+
+func NewGroupsDataSource() datasource.DataSource {
+	return &GroupsDataSource{}
+}
+
+type GroupsDataSource struct {
+	client *Client
+}
+
+type GroupsDataSourceModel struct {
+	NamePrefix types.String      `tfsdk:"name_prefix"`
+	Groups     []GroupsItemModel `tfsdk:"groups"`
+}
+
+type GroupsItemModel struct {
+	ID      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Default types.Bool   `tfsdk:"default"`
+}
+
+func (d *GroupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_groups"
+}
+
+func (d *GroupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all Zendesk groups, excluding deleted ones, sorted by name.",
+		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				Description: "Only return groups whose name starts with this prefix.",
+				Optional:    true,
+			},
+			"groups": schema.ListNestedAttribute{
+				Description: "The groups.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the group.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the group.",
+							Computed:    true,
+						},
+						"default": schema.BoolAttribute{
+							Description: "Whether the group is the default group of the account.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *GroupsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *GroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config GroupsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groups, err := d.client.ListGroups(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Groups",
+			fmt.Sprintf("Could not list groups: %v", err),
+		)
+		return
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		if groups[i].Name != groups[j].Name {
+			return groups[i].Name < groups[j].Name
+		}
+		return groups[i].ID < groups[j].ID
+	})
+
+	config.Groups = make([]GroupsItemModel, 0, len(groups))
+	for _, group := range groups {
+		if group.Deleted {
+			continue
+		}
+		if !config.NamePrefix.IsNull() && !strings.HasPrefix(group.Name, config.NamePrefix.ValueString()) {
+			continue
+		}
+
+		config.Groups = append(config.Groups, GroupsItemModel{
+			ID:      types.StringValue(strconv.FormatInt(group.ID, 10)),
+			Name:    types.StringValue(group.Name),
+			Default: types.BoolValue(group.Default),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,228 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const hcArticlesDefaultMaxResults = 1000
+
+var (
+	_ datasource.DataSource = &HCArticlesDataSource{}
+)
+
+func NewHCArticlesDataSource() datasource.DataSource {
+	return &HCArticlesDataSource{}
+}
+
+type HCArticlesDataSource struct {
+	client *Client
+}
+
+type HCArticlesDataSourceModel struct {
+	BrandID      types.String          `tfsdk:"brand_id"`
+	Locale       types.String          `tfsdk:"locale"`
+	Query        types.String          `tfsdk:"query"`
+	SectionID    types.String          `tfsdk:"section_id"`
+	LabelNames   []types.String        `tfsdk:"label_names"`
+	UpdatedAfter types.String          `tfsdk:"updated_after"`
+	IncludeBody  types.Bool            `tfsdk:"include_body"`
+	MaxResults   types.Int64           `tfsdk:"max_results"`
+	Articles     []HCArticlesItemModel `tfsdk:"articles"`
+}
+
+type HCArticlesItemModel struct {
+	ID         types.String   `tfsdk:"id"`
+	Title      types.String   `tfsdk:"title"`
+	HTMLURL    types.String   `tfsdk:"html_url"`
+	SectionID  types.String   `tfsdk:"section_id"`
+	Draft      types.Bool     `tfsdk:"draft"`
+	LabelNames []types.String `tfsdk:"label_names"`
+	UpdatedAt  types.String   `tfsdk:"updated_at"`
+	Body       types.String   `tfsdk:"body"`
+}
+
+func (d *HCArticlesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hc_articles"
+}
+
+func (d *HCArticlesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Searches the articles of a brand's Help Center. Without search arguments, all articles of the locale are listed.",
+		Attributes: map[string]schema.Attribute{
+			"brand_id": helpCenterBrandIDAttribute(),
+			"locale":   helpCenterLocaleAttribute(),
+			"query": schema.StringAttribute{
+				Description: "A full-text search query.",
+				Optional:    true,
+			},
+			"section_id": schema.StringAttribute{
+				Description: "Only return articles in this section.",
+				Optional:    true,
+			},
+			"label_names": schema.ListAttribute{
+				Description: "Only return articles with all of these labels.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"updated_after": schema.StringAttribute{
+				Description: "Only return articles updated after this date (YYYY-MM-DD).",
+				Optional:    true,
+			},
+			"include_body": schema.BoolAttribute{
+				Description: "Whether to export the body of every article. Defaults to false given the payload size.",
+				Optional:    true,
+			},
+			"max_results": schema.Int64Attribute{
+				Description: fmt.Sprintf("The maximum number of articles to return. Defaults to %d.", hcArticlesDefaultMaxResults),
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"articles": schema.ListNestedAttribute{
+				Description: "The articles, in the order returned by the API.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the article.",
+							Computed:    true,
+						},
+						"title": schema.StringAttribute{
+							Description: "The title of the article.",
+							Computed:    true,
+						},
+						"html_url": schema.StringAttribute{
+							Description: "The URL of the article in the Help Center.",
+							Computed:    true,
+						},
+						"section_id": schema.StringAttribute{
+							Description: "The ID of the section the article belongs to.",
+							Computed:    true,
+						},
+						"draft": schema.BoolAttribute{
+							Description: "Whether the article is a draft.",
+							Computed:    true,
+						},
+						"label_names": schema.ListAttribute{
+							Description: "The labels of the article.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "When the article was last updated.",
+							Computed:    true,
+						},
+						"body": schema.StringAttribute{
+							Description: "The HTML body of the article. Null unless include_body is true.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *HCArticlesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *HCArticlesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config HCArticlesDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	brandSubdomain, diags := helpCenterBrandSubdomain(ctx, d.client, config.BrandID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := url.Values{}
+	if !config.Query.IsNull() {
+		params.Set("query", config.Query.ValueString())
+	}
+	if !config.SectionID.IsNull() {
+		params.Set("section", config.SectionID.ValueString())
+	}
+	if len(config.LabelNames) > 0 {
+		labels := make([]string, 0, len(config.LabelNames))
+		for _, label := range config.LabelNames {
+			labels = append(labels, label.ValueString())
+		}
+		params.Set("label_names", strings.Join(labels, ","))
+	}
+	if !config.UpdatedAfter.IsNull() {
+		params.Set("updated_after", config.UpdatedAfter.ValueString())
+	}
+
+	maxResults := hcArticlesDefaultMaxResults
+	if !config.MaxResults.IsNull() {
+		maxResults = int(config.MaxResults.ValueInt64())
+	}
+
+	articles, truncated, err := d.client.SearchHCArticles(ctx, brandSubdomain, config.Locale.ValueString(), params, maxResults)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Searching Help Center Articles",
+			fmt.Sprintf("Could not search Help Center articles: %v", err),
+		)
+		return
+	}
+
+	if truncated {
+		resp.Diagnostics.AddWarning(
+			"Help Center Articles Result Truncated",
+			fmt.Sprintf("Only the first %d articles were fetched. Narrow the search or raise max_results to get a complete result.", maxResults),
+		)
+	}
+
+	config.Articles = make([]HCArticlesItemModel, 0, len(articles))
+	for _, article := range articles {
+		body := types.StringNull()
+		if config.IncludeBody.ValueBool() {
+			body = types.StringValue(article.Body)
+		}
+
+		config.Articles = append(config.Articles, HCArticlesItemModel{
+			ID:         types.StringValue(strconv.FormatInt(article.ID, 10)),
+			Title:      types.StringValue(article.Title),
+			HTMLURL:    types.StringValue(article.HTMLURL),
+			SectionID:  types.StringValue(strconv.FormatInt(article.SectionID, 10)),
+			Draft:      types.BoolValue(article.Draft),
+			LabelNames: stringListValue(article.LabelNames),
+			UpdatedAt:  types.StringValue(article.UpdatedAt),
+			Body:       body,
+		})
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
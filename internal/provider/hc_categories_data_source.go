@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &HCCategoriesDataSource{}
+)
+
+func NewHCCategoriesDataSource() datasource.DataSource {
+	return &HCCategoriesDataSource{}
+}
+
+type HCCategoriesDataSource struct {
+	client *Client
+}
+
+type HCCategoriesDataSourceModel struct {
+	BrandID    types.String            `tfsdk:"brand_id"`
+	Locale     types.String            `tfsdk:"locale"`
+	Name       types.String            `tfsdk:"name"`
+	Categories []HCCategoriesItemModel `tfsdk:"categories"`
+}
+
+type HCCategoriesItemModel struct {
+	ID       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Position types.Int64  `tfsdk:"position"`
+	HTMLURL  types.String `tfsdk:"html_url"`
+}
+
+func (d *HCCategoriesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hc_categories"
+}
+
+func (d *HCCategoriesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the categories of a brand's Help Center, sorted by position.",
+		Attributes: map[string]schema.Attribute{
+			"brand_id": helpCenterBrandIDAttribute(),
+			"locale":   helpCenterLocaleAttribute(),
+			"name": schema.StringAttribute{
+				Description: "Only return the category with this exact name. An error is returned if none or several match.",
+				Optional:    true,
+			},
+			"categories": schema.ListNestedAttribute{
+				Description: "The categories.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the category.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the category.",
+							Computed:    true,
+						},
+						"position": schema.Int64Attribute{
+							Description: "The position of the category.",
+							Computed:    true,
+						},
+						"html_url": schema.StringAttribute{
+							Description: "The URL of the category in the Help Center.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *HCCategoriesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *HCCategoriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config HCCategoriesDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	brandSubdomain, diags := helpCenterBrandSubdomain(ctx, d.client, config.BrandID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	categories, err := d.client.ListHCCategories(ctx, brandSubdomain, config.Locale.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Help Center Categories",
+			fmt.Sprintf("Could not list Help Center categories: %v", err),
+		)
+		return
+	}
+
+	sort.SliceStable(categories, func(i, j int) bool {
+		if categories[i].Position != categories[j].Position {
+			return categories[i].Position < categories[j].Position
+		}
+		return categories[i].ID < categories[j].ID
+	})
+
+	var matched []int64
+	config.Categories = make([]HCCategoriesItemModel, 0, len(categories))
+	for _, category := range categories {
+		if !config.Name.IsNull() {
+			if category.Name != config.Name.ValueString() {
+				continue
+			}
+			matched = append(matched, category.ID)
+		}
+
+		config.Categories = append(config.Categories, HCCategoriesItemModel{
+			ID:       types.StringValue(strconv.FormatInt(category.ID, 10)),
+			Name:     types.StringValue(category.Name),
+			Position: types.Int64Value(category.Position),
+			HTMLURL:  types.StringValue(category.HTMLURL),
+		})
+	}
+
+	if !config.Name.IsNull() {
+		resp.Diagnostics.Append(checkUniqueName("Help Center Category", "Help Center Categories", config.Name.ValueString(), matched)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &HCPermissionGroupsDataSource{}
+)
+
+func NewHCPermissionGroupsDataSource() datasource.DataSource {
+	return &HCPermissionGroupsDataSource{}
+}
+
+type HCPermissionGroupsDataSource struct {
+	client *Client
+}
+
+type HCPermissionGroupsDataSourceModel struct {
+	Name             types.String                  `tfsdk:"name"`
+	PermissionGroups []HCPermissionGroupsItemModel `tfsdk:"permission_groups"`
+}
+
+type HCPermissionGroupsItemModel struct {
+	ID      types.String   `tfsdk:"id"`
+	Name    types.String   `tfsdk:"name"`
+	BuiltIn types.Bool     `tfsdk:"built_in"`
+	Publish []types.String `tfsdk:"publish"`
+	Edit    []types.String `tfsdk:"edit"`
+}
+
+func (d *HCPermissionGroupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hc_permission_groups"
+}
+
+func (d *HCPermissionGroupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists Help Center permission groups, sorted by name.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Only return the permission group with this exact name. An error is returned if none or several match.",
+				Optional:    true,
+			},
+			"permission_groups": schema.ListNestedAttribute{
+				Description: "The permission groups.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the permission group.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the permission group.",
+							Computed:    true,
+						},
+						"built_in": schema.BoolAttribute{
+							Description: "Whether the permission group is built into Zendesk.",
+							Computed:    true,
+						},
+						"publish": schema.ListAttribute{
+							Description: "The IDs of the agent groups that can publish content.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"edit": schema.ListAttribute{
+							Description: "The IDs of the agent groups that can edit content.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *HCPermissionGroupsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *HCPermissionGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config HCPermissionGroupsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groups, err := d.client.ListHCPermissionGroups(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Help Center Permission Groups",
+			fmt.Sprintf("Could not list Help Center permission groups: %v", err),
+		)
+		return
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		if groups[i].Name != groups[j].Name {
+			return groups[i].Name < groups[j].Name
+		}
+		return groups[i].ID < groups[j].ID
+	})
+
+	var matched []int64
+	config.PermissionGroups = make([]HCPermissionGroupsItemModel, 0, len(groups))
+	for _, group := range groups {
+		if !config.Name.IsNull() {
+			if group.Name != config.Name.ValueString() {
+				continue
+			}
+			matched = append(matched, group.ID)
+		}
+
+		config.PermissionGroups = append(config.PermissionGroups, HCPermissionGroupsItemModel{
+			ID:      types.StringValue(strconv.FormatInt(group.ID, 10)),
+			Name:    types.StringValue(group.Name),
+			BuiltIn: types.BoolValue(group.BuiltIn),
+			Publish: idListValue(group.Publish),
+			Edit:    idListValue(group.Edit),
+		})
+	}
+
+	if !config.Name.IsNull() {
+		resp.Diagnostics.Append(checkUniqueName("Help Center Permission Group", "Help Center Permission Groups", config.Name.ValueString(), matched)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
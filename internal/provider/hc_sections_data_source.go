@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &HCSectionsDataSource{}
+)
+
+func NewHCSectionsDataSource() datasource.DataSource {
+	return &HCSectionsDataSource{}
+}
+
+type HCSectionsDataSource struct {
+	client *Client
+}
+
+type HCSectionsDataSourceModel struct {
+	BrandID    types.String          `tfsdk:"brand_id"`
+	Locale     types.String          `tfsdk:"locale"`
+	CategoryID types.String          `tfsdk:"category_id"`
+	Name       types.String          `tfsdk:"name"`
+	Sections   []HCSectionsItemModel `tfsdk:"sections"`
+}
+
+type HCSectionsItemModel struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Position        types.Int64  `tfsdk:"position"`
+	CategoryID      types.String `tfsdk:"category_id"`
+	ParentSectionID types.String `tfsdk:"parent_section_id"`
+	HTMLURL         types.String `tfsdk:"html_url"`
+}
+
+func (d *HCSectionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hc_sections"
+}
+
+func (d *HCSectionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the sections of a brand's Help Center, sorted by position.",
+		Attributes: map[string]schema.Attribute{
+			"brand_id": helpCenterBrandIDAttribute(),
+			"locale":   helpCenterLocaleAttribute(),
+			"category_id": schema.StringAttribute{
+				Description: "Only return sections in this category.",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Only return the section with this exact name. An error is returned if none or several match.",
+				Optional:    true,
+			},
+			"sections": schema.ListNestedAttribute{
+				Description: "The sections.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the section.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the section.",
+							Computed:    true,
+						},
+						"position": schema.Int64Attribute{
+							Description: "The position of the section.",
+							Computed:    true,
+						},
+						"category_id": schema.StringAttribute{
+							Description: "The ID of the category the section belongs to.",
+							Computed:    true,
+						},
+						"parent_section_id": schema.StringAttribute{
+							Description: "The ID of the parent section, if the section is nested.",
+							Computed:    true,
+						},
+						"html_url": schema.StringAttribute{
+							Description: "The URL of the section in the Help Center.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *HCSectionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *HCSectionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config HCSectionsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var categoryID int64
+	if !config.CategoryID.IsNull() {
+		var err error
+		categoryID, err = strconv.ParseInt(config.CategoryID.ValueString(), 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("category_id"),
+				"Error Parsing Category ID",
+				fmt.Sprintf("Could not parse category ID: %v", err),
+			)
+			return
+		}
+	}
+
+	brandSubdomain, diags := helpCenterBrandSubdomain(ctx, d.client, config.BrandID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sections, err := d.client.ListHCSections(ctx, brandSubdomain, config.Locale.ValueString(), categoryID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Help Center Sections",
+			fmt.Sprintf("Could not list Help Center sections: %v", err),
+		)
+		return
+	}
+
+	sort.SliceStable(sections, func(i, j int) bool {
+		if sections[i].Position != sections[j].Position {
+			return sections[i].Position < sections[j].Position
+		}
+		return sections[i].ID < sections[j].ID
+	})
+
+	var matched []int64
+	config.Sections = make([]HCSectionsItemModel, 0, len(sections))
+	for _, section := range sections {
+		if !config.Name.IsNull() {
+			if section.Name != config.Name.ValueString() {
+				continue
+			}
+			matched = append(matched, section.ID)
+		}
+
+		config.Sections = append(config.Sections, HCSectionsItemModel{
+			ID:              types.StringValue(strconv.FormatInt(section.ID, 10)),
+			Name:            types.StringValue(section.Name),
+			Position:        types.Int64Value(section.Position),
+			CategoryID:      types.StringValue(strconv.FormatInt(section.CategoryID, 10)),
+			ParentSectionID: optionalIDValue(section.ParentSectionID),
+			HTMLURL:         types.StringValue(section.HTMLURL),
+		})
+	}
+
+	if !config.Name.IsNull() {
+		resp.Diagnostics.Append(checkUniqueName("Help Center Section", "Help Center Sections", config.Name.ValueString(), matched)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
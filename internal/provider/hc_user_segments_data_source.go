@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &HCUserSegmentsDataSource{}
+)
+
+func NewHCUserSegmentsDataSource() datasource.DataSource {
+	return &HCUserSegmentsDataSource{}
+}
+
+type HCUserSegmentsDataSource struct {
+	client *Client
+}
+
+type HCUserSegmentsDataSourceModel struct {
+	Name         types.String              `tfsdk:"name"`
+	UserSegments []HCUserSegmentsItemModel `tfsdk:"user_segments"`
+}
+
+type HCUserSegmentsItemModel struct {
+	ID              types.String   `tfsdk:"id"`
+	Name            types.String   `tfsdk:"name"`
+	UserType        types.String   `tfsdk:"user_type"`
+	GroupIDs        []types.String `tfsdk:"group_ids"`
+	OrganizationIDs []types.String `tfsdk:"organization_ids"`
+	Tags            []types.String `tfsdk:"tags"`
+	OrTags          []types.String `tfsdk:"or_tags"`
+	BuiltIn         types.Bool     `tfsdk:"built_in"`
+}
+
+func (d *HCUserSegmentsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hc_user_segments"
+}
+
+func (d *HCUserSegmentsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Lists Help Center user segments, sorted by name. The list starts with a built-in \"Everyone\" segment with the ID %q, which stands for content without a user segment.", hcUserSegmentEveryone),
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Only return the user segment with this exact name. An error is returned if none or several match.",
+				Optional:    true,
+			},
+			"user_segments": schema.ListNestedAttribute{
+				Description: "The user segments.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the user segment.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the user segment.",
+							Computed:    true,
+						},
+						"user_type": schema.StringAttribute{
+							Description: "The type of users in the segment: 'signed_in_users', 'staff', or 'everyone' for the Everyone segment.",
+							Computed:    true,
+						},
+						"group_ids": schema.ListAttribute{
+							Description: "The IDs of the groups whose members belong to the segment.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"organization_ids": schema.ListAttribute{
+							Description: "The IDs of the organizations whose members belong to the segment.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"tags": schema.ListAttribute{
+							Description: "The tags a user must all have to belong to the segment.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"or_tags": schema.ListAttribute{
+							Description: "The tags of which a user must have at least one to belong to the segment.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"built_in": schema.BoolAttribute{
+							Description: "Whether the user segment is built into Zendesk and cannot be modified.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *HCUserSegmentsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *HCUserSegmentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config HCUserSegmentsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	segments, err := d.client.ListHCUserSegments(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Help Center User Segments",
+			fmt.Sprintf("Could not list Help Center user segments: %v", err),
+		)
+		return
+	}
+
+	sort.SliceStable(segments, func(i, j int) bool {
+		if segments[i].Name != segments[j].Name {
+			return segments[i].Name < segments[j].Name
+		}
+		return segments[i].ID < segments[j].ID
+	})
+
+	// Content visible to everyone has no user segment. It is listed as a built-in segment so
+	// configurations can reference it like any other.
+	everyone := HCUserSegmentsItemModel{
+		ID:              types.StringValue(hcUserSegmentEveryone),
+		Name:            types.StringValue("Everyone"),
+		UserType:        types.StringValue(hcUserSegmentEveryone),
+		GroupIDs:        []types.String{},
+		OrganizationIDs: []types.String{},
+		Tags:            []types.String{},
+		OrTags:          []types.String{},
+		BuiltIn:         types.BoolValue(true),
+	}
+
+	var matched []int64
+	config.UserSegments = make([]HCUserSegmentsItemModel, 0, len(segments)+1)
+	if config.Name.IsNull() || config.Name.ValueString() == everyone.Name.ValueString() {
+		config.UserSegments = append(config.UserSegments, everyone)
+		matched = append(matched, 0)
+	}
+
+	for _, segment := range segments {
+		if !config.Name.IsNull() {
+			if segment.Name != config.Name.ValueString() {
+				continue
+			}
+			matched = append(matched, segment.ID)
+		}
+
+		config.UserSegments = append(config.UserSegments, HCUserSegmentsItemModel{
+			ID:              types.StringValue(strconv.FormatInt(segment.ID, 10)),
+			Name:            types.StringValue(segment.Name),
+			UserType:        types.StringValue(segment.UserType),
+			GroupIDs:        idListValue(segment.GroupIDs),
+			OrganizationIDs: idListValue(segment.OrganizationIDs),
+			Tags:            stringListValue(segment.Tags),
+			OrTags:          stringListValue(segment.OrTags),
+			BuiltIn:         types.BoolValue(segment.BuiltIn),
+		})
+	}
+
+	if !config.Name.IsNull() {
+		resp.Diagnostics.Append(checkUniqueName("Help Center User Segment", "Help Center User Segments", config.Name.ValueString(), matched)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
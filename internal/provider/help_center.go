@@ -0,0 +1,191 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// hcUserSegmentEveryone stands for the absence of a user segment, which makes content visible
+// to everyone. The API represents it as a null user_segment_id.
+const hcUserSegmentEveryone = "everyone"
+
+// hcBodyHashKey is the private state key holding the hash of the HTML body Zendesk returned after
+// the last apply.
+const hcBodyHashKey = "body_sha256"
+
+func hcBodyHash(body string) []byte {
+	sum := sha256.Sum256([]byte(body))
+	return []byte(strconv.Quote(hex.EncodeToString(sum[:])))
+}
+
+// hcBodyValue returns the HTML body to store in the state. Zendesk rewrites bodies slightly, e.g.
+// reordering attributes and encoding entities, so the prior body is kept as long as Zendesk
+// returns the same body as after the last apply, whose hash is appliedHash.
+func hcBodyValue(prior types.String, remote string, appliedHash []byte) types.String {
+	if !prior.IsNull() && !prior.IsUnknown() && (prior.ValueString() == remote || bytes.Equal(hcBodyHash(remote), appliedHash)) {
+		return prior
+	}
+	return types.StringValue(remote)
+}
+
+// expandHCUserSegmentID converts a user_segment_id, which is "everyone" for content without a
+// user segment, into its API form.
+func expandHCUserSegmentID(value types.String, attr path.Path) (*int64, diag.Diagnostics) {
+	if value.ValueString() == hcUserSegmentEveryone {
+		return nil, nil
+	}
+	return expandOptionalID(value, attr)
+}
+
+func flattenHCUserSegmentID(id *int64) types.String {
+	if id == nil {
+		return types.StringValue(hcUserSegmentEveryone)
+	}
+	return optionalIDValue(id)
+}
+
+func helpCenterBrandIDAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "The ID of the brand whose Help Center is queried. Defaults to the default brand.",
+		Optional:    true,
+	}
+}
+
+func helpCenterLocaleAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "The locale of the returned content (e.g., 'en-us'). Defaults to the Help Center's default locale.",
+		Optional:    true,
+	}
+}
+
+func helpCenterResourceBrandIDAttribute() resourceschema.StringAttribute {
+	return resourceschema.StringAttribute{
+		Description: "The ID of the brand whose Help Center the content belongs to. Defaults to the default brand. Changing this forces a new resource.",
+		Optional:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+func helpCenterResourceLocaleAttribute() resourceschema.StringAttribute {
+	return resourceschema.StringAttribute{
+		Description: "The locale of the content (e.g., 'en-us'). Defaults to the Help Center's default locale. Changing this forces a new resource.",
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// helpCenterLocaleValue returns the locale reported by the API, keeping the prior value when
+// they only differ in case, as the API lowercases locales.
+func helpCenterLocaleValue(prior types.String, locale string) types.String {
+	if strings.EqualFold(prior.ValueString(), locale) && !prior.IsNull() && !prior.IsUnknown() {
+		return prior
+	}
+	return optionalStringValue(locale)
+}
+
+// importHelpCenterResource imports Help Center content of the default brand by ID, or of another
+// brand by "brand_id/<idName>".
+func importHelpCenterResource(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, idName string) {
+	if !strings.Contains(req.ID, "/") {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	parts, err := splitImportID(req.ID, "brand_id", idName)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("brand_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
+
+// helpCenterBrandSubdomain resolves the optional brand_id of a Help Center data source or
+// resource into the subdomain its requests are sent to. An empty subdomain means the default
+// brand.
+func helpCenterBrandSubdomain(ctx context.Context, client *Client, brandID types.String) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if brandID.IsNull() {
+		return "", diags
+	}
+
+	id, err := strconv.ParseInt(brandID.ValueString(), 10, 64)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("brand_id"),
+			"Error Parsing Brand ID",
+			fmt.Sprintf("Could not parse brand ID: %v", err),
+		)
+		return "", diags
+	}
+
+	brand, err := client.ReadBrand(ctx, id)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Brand",
+			fmt.Sprintf("Could not read brand: %v", err),
+		)
+		return "", diags
+	}
+
+	if brand == nil {
+		diags.AddAttributeError(
+			path.Root("brand_id"),
+			"Brand Not Found",
+			fmt.Sprintf("No brand found with id %q.", brandID.ValueString()),
+		)
+		return "", diags
+	}
+
+	return brand.Subdomain, diags
+}
+
+// checkUniqueName reports an error on the name attribute when an exact-name lookup matched no
+// or several items, listing the candidate IDs in the latter case. kind and kinds are the
+// singular and plural names of the items, e.g. "Section" and "Sections".
+func checkUniqueName(kind, kinds, name string, ids []int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch len(ids) {
+	case 0:
+		diags.AddAttributeError(
+			path.Root("name"),
+			fmt.Sprintf("%s Not Found", kind),
+			fmt.Sprintf("No %s found with name %q.", strings.ToLower(kind), name),
+		)
+	case 1:
+	default:
+		candidates := make([]string, 0, len(ids))
+		for _, id := range ids {
+			candidates = append(candidates, strconv.FormatInt(id, 10))
+		}
+		diags.AddAttributeError(
+			path.Root("name"),
+			fmt.Sprintf("Multiple %s Found", kinds),
+			fmt.Sprintf("Found %d %s with name %q (IDs: %s).", len(ids), strings.ToLower(kinds), name, strings.Join(candidates, ", ")),
+		)
+	}
+
+	return diags
+}
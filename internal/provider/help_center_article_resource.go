@@ -0,0 +1,400 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &HelpCenterArticleResource{}
+	_ resource.ResourceWithImportState = &HelpCenterArticleResource{}
+)
+
+func NewHelpCenterArticleResource() resource.Resource {
+	return &HelpCenterArticleResource{}
+}
+
+type HelpCenterArticleResource struct {
+	client *Client
+}
+
+type HelpCenterArticleResourceModel struct {
+	ID                types.String   `tfsdk:"id"`
+	BrandID           types.String   `tfsdk:"brand_id"`
+	Locale            types.String   `tfsdk:"locale"`
+	SectionID         types.String   `tfsdk:"section_id"`
+	Title             types.String   `tfsdk:"title"`
+	Body              types.String   `tfsdk:"body"`
+	AuthorID          types.String   `tfsdk:"author_id"`
+	PermissionGroupID types.String   `tfsdk:"permission_group_id"`
+	UserSegmentID     types.String   `tfsdk:"user_segment_id"`
+	Draft             types.Bool     `tfsdk:"draft"`
+	Promoted          types.Bool     `tfsdk:"promoted"`
+	Position          types.Int64    `tfsdk:"position"`
+	LabelNames        []types.String `tfsdk:"label_names"`
+	HTMLURL           types.String   `tfsdk:"html_url"`
+}
+
+func (r *HelpCenterArticleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_help_center_article"
+}
+
+func (r *HelpCenterArticleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an article of a brand's Help Center, in its source locale.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the article.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"brand_id": helpCenterResourceBrandIDAttribute(),
+			"locale":   helpCenterResourceLocaleAttribute(),
+			"section_id": schema.StringAttribute{
+				Description: "The ID of the section the article belongs to. Changing it moves the article.",
+				Required:    true,
+			},
+			"title": schema.StringAttribute{
+				Description: "The title of the article.",
+				Required:    true,
+			},
+			"body": schema.StringAttribute{
+				Description: "The HTML body of the article. Zendesk rewrites HTML slightly, so only changes made to the body outside Terraform are reported as drift.",
+				Required:    true,
+			},
+			"author_id": schema.StringAttribute{
+				Description: "The ID of the agent shown as the author. Defaults to the user of the provider.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"permission_group_id": schema.StringAttribute{
+				Description: "The ID of the permission group defining who can edit and publish the article.",
+				Required:    true,
+			},
+			"user_segment_id": schema.StringAttribute{
+				Description: fmt.Sprintf("The ID of the user segment that can view the article, or %q. Defaults to %q.", hcUserSegmentEveryone, hcUserSegmentEveryone),
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(hcUserSegmentEveryone),
+			},
+			"draft": schema.BoolAttribute{
+				Description: "Whether the article is a draft, hidden from end users. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"promoted": schema.BoolAttribute{
+				Description: "Whether the article is promoted, i.e. shown first in its section. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"position": schema.Int64Attribute{
+				Description: "The position of the article in its section.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"label_names": schema.SetAttribute{
+				Description: "The labels of the article, used by the search and related articles.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"html_url": schema.StringAttribute{
+				Description: "The URL of the article in the Help Center.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *HelpCenterArticleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *HelpCenterArticleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan HelpCenterArticleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	article, diags := expandHelpCenterArticle(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	brandSubdomain, diags := helpCenterBrandSubdomain(ctx, r.client, plan.BrandID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateHCArticle(ctx, brandSubdomain, plan.Locale.ValueString(), article)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Help Center Article",
+			fmt.Sprintf("Could not create Help Center article: %v", err),
+		)
+		return
+	}
+
+	flattenHelpCenterArticle(created, &plan)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, hcBodyHashKey, hcBodyHash(created.Body))...)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *HelpCenterArticleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state HelpCenterArticleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Help Center Article ID",
+			fmt.Sprintf("Could not parse Help Center article ID: %v", err),
+		)
+		return
+	}
+
+	brandSubdomain, diags := helpCenterBrandSubdomain(ctx, r.client, state.BrandID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	article, err := r.client.ReadHCArticle(ctx, brandSubdomain, state.Locale.ValueString(), id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Help Center Article",
+			fmt.Sprintf("Could not read Help Center article: %v", err),
+		)
+		return
+	}
+
+	if article == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	appliedHash, diags := req.Private.GetKey(ctx, hcBodyHashKey)
+	resp.Diagnostics.Append(diags...)
+
+	prior := state.Body
+	flattenHelpCenterArticle(article, &state)
+	state.Body = hcBodyValue(prior, article.Body, appliedHash)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *HelpCenterArticleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state HelpCenterArticleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(plan.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Help Center Article ID",
+			fmt.Sprintf("Could not parse Help Center article ID: %v", err),
+		)
+		return
+	}
+
+	article, diags := expandHelpCenterArticle(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	brandSubdomain, diags := helpCenterBrandSubdomain(ctx, r.client, plan.BrandID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateHCArticle(ctx, brandSubdomain, plan.Locale.ValueString(), id, article)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Help Center Article",
+			fmt.Sprintf("Could not update Help Center article: %v", err),
+		)
+		return
+	}
+
+	// The title, body and draft status belong to the translation of the article's locale.
+	if !plan.Title.Equal(state.Title) || !plan.Body.Equal(state.Body) || !plan.Draft.Equal(state.Draft) {
+		translation, err := r.client.UpdateHCArticleTranslation(ctx, brandSubdomain, id, HCTranslation{
+			Locale: updated.Locale,
+			Title:  article.Title,
+			Body:   article.Body,
+			Draft:  article.Draft,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Help Center Article",
+				fmt.Sprintf("Could not update the %s translation of Help Center article %d: %v", updated.Locale, id, err),
+			)
+			return
+		}
+
+		updated.Title = translation.Title
+		updated.Body = translation.Body
+		updated.Draft = translation.Draft
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, hcBodyHashKey, hcBodyHash(translation.Body))...)
+	}
+
+	flattenHelpCenterArticle(updated, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *HelpCenterArticleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state HelpCenterArticleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Help Center Article ID",
+			fmt.Sprintf("Could not parse Help Center article ID: %v", err),
+		)
+		return
+	}
+
+	brandSubdomain, diags := helpCenterBrandSubdomain(ctx, r.client, state.BrandID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err = r.client.DeleteHCArticle(ctx, brandSubdomain, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Help Center Article",
+			fmt.Sprintf("Could not delete Help Center article: %v", err),
+		)
+		return
+	}
+}
+
+func (r *HelpCenterArticleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importHelpCenterResource(ctx, req, resp, "article_id")
+}
+
+func expandHelpCenterArticle(model HelpCenterArticleResourceModel) (HCArticle, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	sectionID, err := strconv.ParseInt(model.SectionID.ValueString(), 10, 64)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("section_id"),
+			"Error Parsing Help Center Section ID",
+			fmt.Sprintf("Could not parse Help Center section ID: %v", err),
+		)
+	}
+
+	permissionGroupID, err := strconv.ParseInt(model.PermissionGroupID.ValueString(), 10, 64)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("permission_group_id"),
+			"Error Parsing Permission Group ID",
+			fmt.Sprintf("Could not parse permission group ID: %v", err),
+		)
+	}
+
+	authorID, d := expandOptionalID(model.AuthorID, path.Root("author_id"))
+	diags.Append(d...)
+	userSegmentID, d := expandHCUserSegmentID(model.UserSegmentID, path.Root("user_segment_id"))
+	diags.Append(d...)
+
+	article := HCArticle{
+		Title:             model.Title.ValueString(),
+		Body:              model.Body.ValueString(),
+		SectionID:         sectionID,
+		PermissionGroupID: permissionGroupID,
+		UserSegmentID:     userSegmentID,
+		Draft:             model.Draft.ValueBool(),
+		Promoted:          model.Promoted.ValueBool(),
+		Position:          model.Position.ValueInt64(),
+		LabelNames:        make([]string, 0, len(model.LabelNames)),
+		Locale:            model.Locale.ValueString(),
+	}
+	if authorID != nil {
+		article.AuthorID = *authorID
+	}
+	for _, label := range model.LabelNames {
+		article.LabelNames = append(article.LabelNames, label.ValueString())
+	}
+
+	return article, diags
+}
+
+// flattenHelpCenterArticle refreshes the model from article, except for the body, which is kept
+// as configured; see hcBodyValue.
+func flattenHelpCenterArticle(article *HCArticle, model *HelpCenterArticleResourceModel) {
+	model.ID = types.StringValue(strconv.FormatInt(article.ID, 10))
+	model.Locale = helpCenterLocaleValue(model.Locale, article.Locale)
+	model.SectionID = types.StringValue(strconv.FormatInt(article.SectionID, 10))
+	model.Title = types.StringValue(article.Title)
+	model.AuthorID = types.StringValue(strconv.FormatInt(article.AuthorID, 10))
+	model.PermissionGroupID = types.StringValue(strconv.FormatInt(article.PermissionGroupID, 10))
+	model.UserSegmentID = flattenHCUserSegmentID(article.UserSegmentID)
+	model.Draft = types.BoolValue(article.Draft)
+	model.Promoted = types.BoolValue(article.Promoted)
+	model.Position = types.Int64Value(article.Position)
+	model.HTMLURL = types.StringValue(article.HTMLURL)
+
+	if len(article.LabelNames) > 0 || model.LabelNames != nil {
+		model.LabelNames = stringListValue(article.LabelNames)
+	}
+}
@@ -0,0 +1,356 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &HelpCenterArticleTranslationResource{}
+	_ resource.ResourceWithImportState = &HelpCenterArticleTranslationResource{}
+)
+
+func NewHelpCenterArticleTranslationResource() resource.Resource {
+	return &HelpCenterArticleTranslationResource{}
+}
+
+type HelpCenterArticleTranslationResource struct {
+	client *Client
+}
+
+type HelpCenterArticleTranslationResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	BrandID   types.String `tfsdk:"brand_id"`
+	ArticleID types.String `tfsdk:"article_id"`
+	Locale    types.String `tfsdk:"locale"`
+	Title     types.String `tfsdk:"title"`
+	Body      types.String `tfsdk:"body"`
+	Draft     types.Bool   `tfsdk:"draft"`
+}
+
+func (r *HelpCenterArticleTranslationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_help_center_article_translation"
+}
+
+func (r *HelpCenterArticleTranslationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the translation of a Help Center article into a locale other than its source locale.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the translation, as article_id/locale.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"brand_id": helpCenterResourceBrandIDAttribute(),
+			"article_id": schema.StringAttribute{
+				Description: "The ID of the article.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"locale": schema.StringAttribute{
+				Description: "The locale of the translation (e.g., 'pt-br'). It must be enabled in the Help Center.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "The translated title.",
+				Required:    true,
+			},
+			"body": schema.StringAttribute{
+				Description: "The translated HTML body. Zendesk rewrites HTML slightly, so only changes made to the body outside Terraform are reported as drift.",
+				Required:    true,
+			},
+			"draft": schema.BoolAttribute{
+				Description: "Whether the translation is a draft, hidden from end users. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *HelpCenterArticleTranslationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *HelpCenterArticleTranslationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan HelpCenterArticleTranslationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	articleID, err := strconv.ParseInt(plan.ArticleID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("article_id"),
+			"Error Parsing Help Center Article ID",
+			fmt.Sprintf("Could not parse Help Center article ID: %v", err),
+		)
+		return
+	}
+
+	brandSubdomain, diags := helpCenterBrandSubdomain(ctx, r.client, plan.BrandID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	translation, err := r.client.CreateHCArticleTranslation(ctx, brandSubdomain, articleID, expandHelpCenterArticleTranslation(plan))
+	if err != nil {
+		// Zendesk rejects a translation that already exists, including the one of the source
+		// locale. Adopting it silently would bypass review, so the user is pointed to import.
+		if messages := validationMessages(err); messages != nil {
+			detail := strings.Join(messages, " ")
+			if existing, readErr := r.client.ReadHCArticleTranslation(ctx, brandSubdomain, articleID, plan.Locale.ValueString()); readErr == nil && existing != nil {
+				detail += fmt.Sprintf(" Article %d already has a %s translation; import it as %q instead of creating it, or manage the source locale with zendesk_help_center_article.", articleID, plan.Locale.ValueString(), helpCenterTranslationID(articleID, plan.Locale.ValueString()))
+			}
+			resp.Diagnostics.AddAttributeError(path.Root("locale"), "Error Creating Help Center Article Translation", detail)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Creating Help Center Article Translation",
+			fmt.Sprintf("Could not create Help Center article translation: %v", err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(helpCenterTranslationID(articleID, plan.Locale.ValueString()))
+	flattenHelpCenterArticleTranslation(translation, &plan)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, hcBodyHashKey, hcBodyHash(translation.Body))...)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *HelpCenterArticleTranslationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state HelpCenterArticleTranslationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	articleID, err := strconv.ParseInt(state.ArticleID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Help Center Article ID",
+			fmt.Sprintf("Could not parse Help Center article ID: %v", err),
+		)
+		return
+	}
+
+	brandSubdomain, diags := helpCenterBrandSubdomain(ctx, r.client, state.BrandID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	translation, err := r.client.ReadHCArticleTranslation(ctx, brandSubdomain, articleID, state.Locale.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Help Center Article Translation",
+			fmt.Sprintf("Could not read Help Center article translation: %v", err),
+		)
+		return
+	}
+
+	if translation == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	appliedHash, diags := req.Private.GetKey(ctx, hcBodyHashKey)
+	resp.Diagnostics.Append(diags...)
+
+	prior := state.Body
+	flattenHelpCenterArticleTranslation(translation, &state)
+	state.Body = hcBodyValue(prior, translation.Body, appliedHash)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *HelpCenterArticleTranslationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan HelpCenterArticleTranslationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	articleID, err := strconv.ParseInt(plan.ArticleID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Help Center Article ID",
+			fmt.Sprintf("Could not parse Help Center article ID: %v", err),
+		)
+		return
+	}
+
+	brandSubdomain, diags := helpCenterBrandSubdomain(ctx, r.client, plan.BrandID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	translation, err := r.client.UpdateHCArticleTranslation(ctx, brandSubdomain, articleID, expandHelpCenterArticleTranslation(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Help Center Article Translation",
+			fmt.Sprintf("Could not update Help Center article translation: %v", err),
+		)
+		return
+	}
+
+	flattenHelpCenterArticleTranslation(translation, &plan)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, hcBodyHashKey, hcBodyHash(translation.Body))...)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *HelpCenterArticleTranslationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state HelpCenterArticleTranslationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	articleID, err := strconv.ParseInt(state.ArticleID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Help Center Article ID",
+			fmt.Sprintf("Could not parse Help Center article ID: %v", err),
+		)
+		return
+	}
+
+	brandSubdomain, diags := helpCenterBrandSubdomain(ctx, r.client, state.BrandID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	article, err := r.client.ReadHCArticle(ctx, brandSubdomain, "", articleID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Help Center Article Translation",
+			fmt.Sprintf("Could not read Help Center article: %v", err),
+		)
+		return
+	}
+
+	// The translations of an archived article are gone with it.
+	if article == nil {
+		return
+	}
+
+	if strings.EqualFold(article.SourceLocale, state.Locale.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("locale"),
+			"Error Deleting Help Center Article Translation",
+			fmt.Sprintf("%s is the source locale of article %d, whose translation cannot be deleted. Remove the resource from the state with 'terraform state rm', or destroy the article itself.", state.Locale.ValueString(), articleID),
+		)
+		return
+	}
+
+	translation, err := r.client.ReadHCArticleTranslation(ctx, brandSubdomain, articleID, state.Locale.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Help Center Article Translation",
+			fmt.Sprintf("Could not read Help Center article translation: %v", err),
+		)
+		return
+	}
+
+	if translation == nil {
+		return
+	}
+
+	err = r.client.DeleteHCTranslation(ctx, brandSubdomain, translation.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Help Center Article Translation",
+			fmt.Sprintf("Could not delete Help Center article translation: %v", err),
+		)
+		return
+	}
+}
+
+func (r *HelpCenterArticleTranslationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var parts []string
+	var err error
+	if strings.Count(req.ID, "/") == 2 {
+		parts, err = splitImportID(req.ID, "brand_id", "article_id", "locale")
+	} else {
+		parts, err = splitImportID(req.ID, "article_id", "locale")
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	if len(parts) == 3 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("brand_id"), parts[0])...)
+		parts = parts[1:]
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[0]+"/"+parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("article_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("locale"), parts[1])...)
+}
+
+func helpCenterTranslationID(articleID int64, locale string) string {
+	return fmt.Sprintf("%d/%s", articleID, locale)
+}
+
+func expandHelpCenterArticleTranslation(model HelpCenterArticleTranslationResourceModel) HCTranslation {
+	return HCTranslation{
+		Locale: model.Locale.ValueString(),
+		Title:  model.Title.ValueString(),
+		Body:   model.Body.ValueString(),
+		Draft:  model.Draft.ValueBool(),
+	}
+}
+
+// flattenHelpCenterArticleTranslation refreshes the model from translation, except for the body,
+// which is kept as configured; see hcBodyValue.
+func flattenHelpCenterArticleTranslation(translation *HCTranslation, model *HelpCenterArticleTranslationResourceModel) {
+	model.Locale = helpCenterLocaleValue(model.Locale, translation.Locale)
+	model.Title = types.StringValue(translation.Title)
+	model.Draft = types.BoolValue(translation.Draft)
+}
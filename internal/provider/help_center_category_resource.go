@@ -0,0 +1,260 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &HelpCenterCategoryResource{}
+	_ resource.ResourceWithImportState = &HelpCenterCategoryResource{}
+)
+
+func NewHelpCenterCategoryResource() resource.Resource {
+	return &HelpCenterCategoryResource{}
+}
+
+type HelpCenterCategoryResource struct {
+	client *Client
+}
+
+type HelpCenterCategoryResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	BrandID     types.String `tfsdk:"brand_id"`
+	Locale      types.String `tfsdk:"locale"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Position    types.Int64  `tfsdk:"position"`
+	HTMLURL     types.String `tfsdk:"html_url"`
+}
+
+func (r *HelpCenterCategoryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_help_center_category"
+}
+
+func (r *HelpCenterCategoryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a category of a brand's Help Center.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the category.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"brand_id": helpCenterResourceBrandIDAttribute(),
+			"locale":   helpCenterResourceLocaleAttribute(),
+			"name": schema.StringAttribute{
+				Description: "The name of the category.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the category.",
+				Optional:    true,
+			},
+			"position": schema.Int64Attribute{
+				Description: "The position of the category on the Help Center home page.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"html_url": schema.StringAttribute{
+				Description: "The URL of the category in the Help Center.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *HelpCenterCategoryResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *HelpCenterCategoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan HelpCenterCategoryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	brandSubdomain, diags := helpCenterBrandSubdomain(ctx, r.client, plan.BrandID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	category, err := r.client.CreateHCCategory(ctx, brandSubdomain, plan.Locale.ValueString(), expandHelpCenterCategory(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Help Center Category",
+			fmt.Sprintf("Could not create Help Center category: %v", err),
+		)
+		return
+	}
+
+	flattenHelpCenterCategory(category, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *HelpCenterCategoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state HelpCenterCategoryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Help Center Category ID",
+			fmt.Sprintf("Could not parse Help Center category ID: %v", err),
+		)
+		return
+	}
+
+	brandSubdomain, diags := helpCenterBrandSubdomain(ctx, r.client, state.BrandID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	category, err := r.client.ReadHCCategory(ctx, brandSubdomain, state.Locale.ValueString(), id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Help Center Category",
+			fmt.Sprintf("Could not read Help Center category: %v", err),
+		)
+		return
+	}
+
+	if category == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	flattenHelpCenterCategory(category, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *HelpCenterCategoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan HelpCenterCategoryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(plan.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Help Center Category ID",
+			fmt.Sprintf("Could not parse Help Center category ID: %v", err),
+		)
+		return
+	}
+
+	brandSubdomain, diags := helpCenterBrandSubdomain(ctx, r.client, plan.BrandID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	category, err := r.client.UpdateHCCategory(ctx, brandSubdomain, plan.Locale.ValueString(), id, expandHelpCenterCategory(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Help Center Category",
+			fmt.Sprintf("Could not update Help Center category: %v", err),
+		)
+		return
+	}
+
+	flattenHelpCenterCategory(category, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *HelpCenterCategoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state HelpCenterCategoryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Help Center Category ID",
+			fmt.Sprintf("Could not parse Help Center category ID: %v", err),
+		)
+		return
+	}
+
+	brandSubdomain, diags := helpCenterBrandSubdomain(ctx, r.client, state.BrandID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err = r.client.DeleteHCCategory(ctx, brandSubdomain, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Help Center Category",
+			fmt.Sprintf("Could not delete Help Center category: %v", err),
+		)
+		return
+	}
+}
+
+func (r *HelpCenterCategoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importHelpCenterResource(ctx, req, resp, "category_id")
+}
+
+func expandHelpCenterCategory(model HelpCenterCategoryResourceModel) HCCategory {
+	return HCCategory{
+		Name:        model.Name.ValueString(),
+		Description: model.Description.ValueString(),
+		Position:    model.Position.ValueInt64(),
+		Locale:      model.Locale.ValueString(),
+	}
+}
+
+func flattenHelpCenterCategory(category *HCCategory, model *HelpCenterCategoryResourceModel) {
+	model.ID = types.StringValue(strconv.FormatInt(category.ID, 10))
+	model.Locale = helpCenterLocaleValue(model.Locale, category.Locale)
+	model.Name = types.StringValue(category.Name)
+	model.Description = optionalStringValue(category.Description)
+	model.Position = types.Int64Value(category.Position)
+	model.HTMLURL = types.StringValue(category.HTMLURL)
+}
@@ -0,0 +1,250 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &HelpCenterPermissionGroupResource{}
+	_ resource.ResourceWithImportState = &HelpCenterPermissionGroupResource{}
+)
+
+func NewHelpCenterPermissionGroupResource() resource.Resource {
+	return &HelpCenterPermissionGroupResource{}
+}
+
+type HelpCenterPermissionGroupResource struct {
+	client *Client
+}
+
+type HelpCenterPermissionGroupResourceModel struct {
+	ID      types.String   `tfsdk:"id"`
+	Name    types.String   `tfsdk:"name"`
+	Publish []types.String `tfsdk:"publish"`
+	Edit    []types.String `tfsdk:"edit"`
+}
+
+func (r *HelpCenterPermissionGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_help_center_permission_group"
+}
+
+func (r *HelpCenterPermissionGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	emptySet := setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{}))
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a Help Center permission group, which defines the agents who can publish and edit articles.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the permission group.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the permission group.",
+				Required:    true,
+			},
+			"publish": schema.SetAttribute{
+				Description: "The IDs of the agents who can publish articles. Defaults to nobody.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Default:     emptySet,
+			},
+			"edit": schema.SetAttribute{
+				Description: "The IDs of the agents who can edit articles. Defaults to nobody.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Default:     emptySet,
+			},
+		},
+	}
+}
+
+func (r *HelpCenterPermissionGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *HelpCenterPermissionGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan HelpCenterPermissionGroupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, diags := expandHelpCenterPermissionGroup(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateHCPermissionGroup(ctx, group)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Help Center Permission Group",
+			fmt.Sprintf("Could not create Help Center permission group: %v", err),
+		)
+		return
+	}
+
+	flattenHelpCenterPermissionGroup(created, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *HelpCenterPermissionGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state HelpCenterPermissionGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Help Center Permission Group ID",
+			fmt.Sprintf("Could not parse Help Center permission group ID: %v", err),
+		)
+		return
+	}
+
+	group, err := r.client.ReadHCPermissionGroup(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Help Center Permission Group",
+			fmt.Sprintf("Could not read Help Center permission group: %v", err),
+		)
+		return
+	}
+
+	if group == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	flattenHelpCenterPermissionGroup(group, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *HelpCenterPermissionGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan HelpCenterPermissionGroupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(plan.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Help Center Permission Group ID",
+			fmt.Sprintf("Could not parse Help Center permission group ID: %v", err),
+		)
+		return
+	}
+
+	group, diags := expandHelpCenterPermissionGroup(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateHCPermissionGroup(ctx, id, group)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Help Center Permission Group",
+			fmt.Sprintf("Could not update Help Center permission group: %v", err),
+		)
+		return
+	}
+
+	flattenHelpCenterPermissionGroup(updated, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *HelpCenterPermissionGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state HelpCenterPermissionGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Help Center Permission Group ID",
+			fmt.Sprintf("Could not parse Help Center permission group ID: %v", err),
+		)
+		return
+	}
+
+	err = r.client.DeleteHCPermissionGroup(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Help Center Permission Group",
+			fmt.Sprintf("Could not delete Help Center permission group: %v", err),
+		)
+		return
+	}
+}
+
+func (r *HelpCenterPermissionGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func expandHelpCenterPermissionGroup(model HelpCenterPermissionGroupResourceModel) (HCPermissionGroup, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	publish, d := expandIDList(model.Publish, path.Root("publish"))
+	diags.Append(d...)
+	edit, d := expandIDList(model.Edit, path.Root("edit"))
+	diags.Append(d...)
+
+	return HCPermissionGroup{
+		Name:    model.Name.ValueString(),
+		Publish: publish,
+		Edit:    edit,
+	}, diags
+}
+
+func flattenHelpCenterPermissionGroup(group *HCPermissionGroup, model *HelpCenterPermissionGroupResourceModel) {
+	model.ID = types.StringValue(strconv.FormatInt(group.ID, 10))
+	model.Name = types.StringValue(group.Name)
+	model.Publish = idListValue(group.Publish)
+	model.Edit = idListValue(group.Edit)
+}
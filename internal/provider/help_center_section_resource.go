@@ -0,0 +1,304 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &HelpCenterSectionResource{}
+	_ resource.ResourceWithImportState = &HelpCenterSectionResource{}
+)
+
+func NewHelpCenterSectionResource() resource.Resource {
+	return &HelpCenterSectionResource{}
+}
+
+type HelpCenterSectionResource struct {
+	client *Client
+}
+
+type HelpCenterSectionResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	BrandID         types.String `tfsdk:"brand_id"`
+	Locale          types.String `tfsdk:"locale"`
+	CategoryID      types.String `tfsdk:"category_id"`
+	ParentSectionID types.String `tfsdk:"parent_section_id"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	Position        types.Int64  `tfsdk:"position"`
+	HTMLURL         types.String `tfsdk:"html_url"`
+}
+
+func (r *HelpCenterSectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_help_center_section"
+}
+
+func (r *HelpCenterSectionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a section of a brand's Help Center.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the section.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"brand_id": helpCenterResourceBrandIDAttribute(),
+			"locale":   helpCenterResourceLocaleAttribute(),
+			"category_id": schema.StringAttribute{
+				Description: "The ID of the category the section belongs to. Changing it moves the section.",
+				Required:    true,
+			},
+			"parent_section_id": schema.StringAttribute{
+				Description: "The ID of the parent section, for sections nested in another section of the same category.",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the section.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the section.",
+				Optional:    true,
+			},
+			"position": schema.Int64Attribute{
+				Description: "The position of the section in its category or parent section.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"html_url": schema.StringAttribute{
+				Description: "The URL of the section in the Help Center.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *HelpCenterSectionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *HelpCenterSectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan HelpCenterSectionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	section, diags := expandHelpCenterSection(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	brandSubdomain, diags := helpCenterBrandSubdomain(ctx, r.client, plan.BrandID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateHCSection(ctx, brandSubdomain, plan.Locale.ValueString(), section)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Help Center Section",
+			fmt.Sprintf("Could not create Help Center section: %v", err),
+		)
+		return
+	}
+
+	flattenHelpCenterSection(created, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *HelpCenterSectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state HelpCenterSectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Help Center Section ID",
+			fmt.Sprintf("Could not parse Help Center section ID: %v", err),
+		)
+		return
+	}
+
+	brandSubdomain, diags := helpCenterBrandSubdomain(ctx, r.client, state.BrandID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Deleting a category deletes its sections, so this also detects a deleted category.
+	section, err := r.client.ReadHCSection(ctx, brandSubdomain, state.Locale.ValueString(), id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Help Center Section",
+			fmt.Sprintf("Could not read Help Center section: %v", err),
+		)
+		return
+	}
+
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	flattenHelpCenterSection(section, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *HelpCenterSectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan HelpCenterSectionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(plan.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Help Center Section ID",
+			fmt.Sprintf("Could not parse Help Center section ID: %v", err),
+		)
+		return
+	}
+
+	section, diags := expandHelpCenterSection(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	brandSubdomain, diags := helpCenterBrandSubdomain(ctx, r.client, plan.BrandID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateHCSection(ctx, brandSubdomain, plan.Locale.ValueString(), id, section)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Help Center Section",
+			fmt.Sprintf("Could not update Help Center section: %v", err),
+		)
+		return
+	}
+
+	flattenHelpCenterSection(updated, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *HelpCenterSectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state HelpCenterSectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Help Center Section ID",
+			fmt.Sprintf("Could not parse Help Center section ID: %v", err),
+		)
+		return
+	}
+
+	brandSubdomain, diags := helpCenterBrandSubdomain(ctx, r.client, state.BrandID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err = r.client.DeleteHCSection(ctx, brandSubdomain, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Help Center Section",
+			fmt.Sprintf("Could not delete Help Center section: %v", err),
+		)
+		return
+	}
+}
+
+func (r *HelpCenterSectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importHelpCenterResource(ctx, req, resp, "section_id")
+}
+
+func expandHelpCenterSection(model HelpCenterSectionResourceModel) (HCSection, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	categoryID, err := strconv.ParseInt(model.CategoryID.ValueString(), 10, 64)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("category_id"),
+			"Error Parsing Help Center Category ID",
+			fmt.Sprintf("Could not parse Help Center category ID: %v", err),
+		)
+		return HCSection{}, diags
+	}
+
+	parentSectionID, idDiags := expandOptionalID(model.ParentSectionID, path.Root("parent_section_id"))
+	diags.Append(idDiags...)
+
+	return HCSection{
+		Name:            model.Name.ValueString(),
+		Description:     model.Description.ValueString(),
+		Position:        model.Position.ValueInt64(),
+		CategoryID:      categoryID,
+		ParentSectionID: parentSectionID,
+		Locale:          model.Locale.ValueString(),
+	}, diags
+}
+
+func flattenHelpCenterSection(section *HCSection, model *HelpCenterSectionResourceModel) {
+	model.ID = types.StringValue(strconv.FormatInt(section.ID, 10))
+	model.Locale = helpCenterLocaleValue(model.Locale, section.Locale)
+	model.CategoryID = types.StringValue(strconv.FormatInt(section.CategoryID, 10))
+	model.ParentSectionID = optionalIDValue(section.ParentSectionID)
+	model.Name = types.StringValue(section.Name)
+	model.Description = optionalStringValue(section.Description)
+	model.Position = types.Int64Value(section.Position)
+	model.HTMLURL = types.StringValue(section.HTMLURL)
+}
@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const testHelpCenterSectionState = `{
+	"id": "1000003", "brand_id": null, "locale": "en-us", "category_id": "1000001", "parent_section_id": null,
+	"name": "Billing", "description": null, "position": 0,
+	"html_url": "https://example.zendesk.com/hc/en-us/sections/1000003-Billing"
+}`
+
+func TestHelpCenterSectionRoundTrip(t *testing.T) {
+	model := HelpCenterSectionResourceModel{
+		Locale:          types.StringValue("en-US"),
+		CategoryID:      types.StringValue("1000001"),
+		ParentSectionID: types.StringValue("1000002"),
+		Name:            types.StringValue("Invoices"),
+		Description:     types.StringNull(),
+		Position:        types.Int64Value(2),
+	}
+
+	section, diags := expandHelpCenterSection(model)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if section.CategoryID != 1000001 || section.ParentSectionID == nil || *section.ParentSectionID != 1000002 || section.Position != 2 {
+		t.Fatalf("unexpected section %+v", section)
+	}
+
+	// The API lowercases locales and returns an empty description for sections without one.
+	section.ID = 1000003
+	section.Locale = "en-us"
+	flattenHelpCenterSection(&section, &model)
+	if model.ID.ValueString() != "1000003" || model.Locale.ValueString() != "en-US" || !model.Description.IsNull() {
+		t.Errorf("unexpected model %+v", model)
+	}
+	if model.CategoryID.ValueString() != "1000001" || model.ParentSectionID.ValueString() != "1000002" {
+		t.Errorf("expected category 1000001 and parent section 1000002, got %s and %s", model.CategoryID, model.ParentSectionID)
+	}
+
+	section.ParentSectionID = nil
+	flattenHelpCenterSection(&section, &model)
+	if !model.ParentSectionID.IsNull() {
+		t.Errorf("expected a top-level section to have no parent section, got %s", model.ParentSectionID)
+	}
+}
+
+func TestExpandHelpCenterSectionInvalid(t *testing.T) {
+	cases := map[string]struct {
+		model HelpCenterSectionResourceModel
+		path  path.Path
+	}{
+		"category_id": {
+			model: HelpCenterSectionResourceModel{CategoryID: types.StringValue("billing"), ParentSectionID: types.StringNull()},
+			path:  path.Root("category_id"),
+		},
+		"parent_section_id": {
+			model: HelpCenterSectionResourceModel{CategoryID: types.StringValue("1000001"), ParentSectionID: types.StringValue("invoices")},
+			path:  path.Root("parent_section_id"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, diags := expandHelpCenterSection(tc.model)
+			if len(diags) != 1 || !diags.HasError() {
+				t.Fatalf("expected one error, got %v", diags)
+			}
+			if d, ok := diags[0].(diag.DiagnosticWithPath); !ok || !d.Path().Equal(tc.path) {
+				t.Errorf("expected an error on %s, got %v", tc.path, diags[0])
+			}
+		})
+	}
+}
+
+func TestHelpCenterSectionMoveCategory(t *testing.T) {
+	p := newProtocolTest(t, "help_center_section_move.json")
+
+	state := p.config("zendesk_help_center_section", testHelpCenterSectionState)
+	config := `{"category_id": "1000002", "name": "Billing"}`
+	if resp := p.plan("zendesk_help_center_section", config, state); len(resp.RequiresReplace) > 0 {
+		t.Fatalf("expected the move to be in place, got replacement for %v", resp.RequiresReplace)
+	}
+
+	state = p.apply("zendesk_help_center_section", config, state)
+	if got, want := p.attribute("zendesk_help_center_section", state, "category_id"), tftypes.NewValue(tftypes.String, "1000002"); !got.Equal(want) {
+		t.Errorf("expected category_id %s, got %s", want, got)
+	}
+	if got, want := p.attribute("zendesk_help_center_section", state, "id"), tftypes.NewValue(tftypes.String, "1000003"); !got.Equal(want) {
+		t.Errorf("expected id %s, got %s", want, got)
+	}
+}
+
+// Two sections of a new category swap places through position, and each update sends its new
+// position. The fixture fails the test if an update body has another position.
+func TestHelpCenterSectionReorder(t *testing.T) {
+	p := newProtocolTest(t, "help_center_section_reorder.json")
+
+	category := p.apply("zendesk_help_center_category", `{"locale": "en-us", "name": "Billing", "position": 1}`, nil)
+	categoryID := p.attribute("zendesk_help_center_category", category, "id")
+	if want := tftypes.NewValue(tftypes.String, "1000001"); !categoryID.Equal(want) {
+		t.Fatalf("expected category id %s, got %s", want, categoryID)
+	}
+
+	sectionConfig := func(name string, position int) string {
+		return fmt.Sprintf(`{"locale": "en-us", "category_id": "1000001", "name": %q, "position": %d}`, name, position)
+	}
+
+	sections := []struct {
+		name     string
+		id       string
+		position int
+		state    *tfprotov6.DynamicValue
+	}{
+		{name: "Invoices", id: "1000002", position: 1},
+		{name: "Refunds", id: "1000003", position: 2},
+	}
+
+	for i := range sections {
+		sections[i].state = p.apply("zendesk_help_center_section", sectionConfig(sections[i].name, sections[i].position), nil)
+		if got, want := p.attribute("zendesk_help_center_section", sections[i].state, "id"), tftypes.NewValue(tftypes.String, sections[i].id); !got.Equal(want) {
+			t.Errorf("expected %s id %s, got %s", sections[i].name, want, got)
+		}
+	}
+
+	for i := range sections {
+		position := len(sections) - i
+		state := p.apply("zendesk_help_center_section", sectionConfig(sections[i].name, position), sections[i].state)
+		if got, want := p.attribute("zendesk_help_center_section", state, "position"), tftypes.NewValue(tftypes.Number, position); !got.Equal(want) {
+			t.Errorf("expected %s position %s, got %s", sections[i].name, want, got)
+		}
+		if got, want := p.attribute("zendesk_help_center_section", state, "category_id"), categoryID; !got.Equal(want) {
+			t.Errorf("expected %s category_id %s, got %s", sections[i].name, want, got)
+		}
+	}
+}
+
+// Deleting a category deletes its sections, so a section 404s when either was deleted.
+func TestHelpCenterSectionReadDeleted(t *testing.T) {
+	p := newProtocolTest(t, "help_center_section_deleted.json")
+
+	state := p.read("zendesk_help_center_section", testHelpCenterSectionState)
+	if !p.value("zendesk_help_center_section", state).IsNull() {
+		t.Errorf("expected the section to be removed, got %s", p.value("zendesk_help_center_section", state))
+	}
+}
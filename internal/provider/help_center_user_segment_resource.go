@@ -0,0 +1,293 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &HelpCenterUserSegmentResource{}
+	_ resource.ResourceWithImportState = &HelpCenterUserSegmentResource{}
+)
+
+func NewHelpCenterUserSegmentResource() resource.Resource {
+	return &HelpCenterUserSegmentResource{}
+}
+
+type HelpCenterUserSegmentResource struct {
+	client *Client
+}
+
+type HelpCenterUserSegmentResourceModel struct {
+	ID              types.String   `tfsdk:"id"`
+	Name            types.String   `tfsdk:"name"`
+	UserType        types.String   `tfsdk:"user_type"`
+	GroupIDs        []types.String `tfsdk:"group_ids"`
+	OrganizationIDs []types.String `tfsdk:"organization_ids"`
+	Tags            []types.String `tfsdk:"tags"`
+	OrTags          []types.String `tfsdk:"or_tags"`
+}
+
+func (r *HelpCenterUserSegmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_help_center_user_segment"
+}
+
+func (r *HelpCenterUserSegmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Help Center user segment, which restricts who can view content. Built-in segments cannot be managed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the user segment.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the user segment.",
+				Required:    true,
+			},
+			"user_type": schema.StringAttribute{
+				Description: "The users the segment is based on: 'signed_in_users' or 'staff' (agents and admins).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("signed_in_users", "staff"),
+				},
+			},
+			"group_ids": schema.SetAttribute{
+				Description: "The IDs of the groups whose agents belong to the segment.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"organization_ids": schema.SetAttribute{
+				Description: "The IDs of the organizations whose users belong to the segment.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"tags": schema.SetAttribute{
+				Description: "The tags a user must all have to belong to the segment.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"or_tags": schema.SetAttribute{
+				Description: "The tags a user must have at least one of to belong to the segment.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *HelpCenterUserSegmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *HelpCenterUserSegmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan HelpCenterUserSegmentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	segment, diags := expandHelpCenterUserSegment(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateHCUserSegment(ctx, segment)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Help Center User Segment",
+			fmt.Sprintf("Could not create Help Center user segment: %v", err),
+		)
+		return
+	}
+
+	flattenHelpCenterUserSegment(created, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *HelpCenterUserSegmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state HelpCenterUserSegmentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Help Center User Segment ID",
+			fmt.Sprintf("Could not parse Help Center user segment ID: %v", err),
+		)
+		return
+	}
+
+	segment, err := r.client.ReadHCUserSegment(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Help Center User Segment",
+			fmt.Sprintf("Could not read Help Center user segment: %v", err),
+		)
+		return
+	}
+
+	if segment == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Built-in segments, such as "Signed-in users" and "Agents and admins", can only be
+	// imported, which would let a destroy attempt to delete them.
+	if segment.BuiltIn {
+		resp.Diagnostics.AddError(
+			"Built-In Help Center User Segment",
+			fmt.Sprintf("User segment %d (%q) is built into Zendesk and cannot be managed. Reference its ID directly, or look it up with the zendesk_hc_user_segments data source.", segment.ID, segment.Name),
+		)
+		return
+	}
+
+	flattenHelpCenterUserSegment(segment, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *HelpCenterUserSegmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan HelpCenterUserSegmentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(plan.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Help Center User Segment ID",
+			fmt.Sprintf("Could not parse Help Center user segment ID: %v", err),
+		)
+		return
+	}
+
+	segment, diags := expandHelpCenterUserSegment(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateHCUserSegment(ctx, id, segment)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Help Center User Segment",
+			fmt.Sprintf("Could not update Help Center user segment: %v", err),
+		)
+		return
+	}
+
+	flattenHelpCenterUserSegment(updated, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *HelpCenterUserSegmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state HelpCenterUserSegmentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Help Center User Segment ID",
+			fmt.Sprintf("Could not parse Help Center user segment ID: %v", err),
+		)
+		return
+	}
+
+	err = r.client.DeleteHCUserSegment(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Help Center User Segment",
+			fmt.Sprintf("Could not delete Help Center user segment: %v", err),
+		)
+		return
+	}
+}
+
+func (r *HelpCenterUserSegmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == hcUserSegmentEveryone {
+		resp.Diagnostics.AddError(
+			"Built-In Help Center User Segment",
+			fmt.Sprintf("%q stands for content without a user segment and cannot be managed.", hcUserSegmentEveryone),
+		)
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func expandHelpCenterUserSegment(model HelpCenterUserSegmentResourceModel) (HCUserSegment, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	groupIDs, d := expandIDList(model.GroupIDs, path.Root("group_ids"))
+	diags.Append(d...)
+	organizationIDs, d := expandIDList(model.OrganizationIDs, path.Root("organization_ids"))
+	diags.Append(d...)
+
+	return HCUserSegment{
+		Name:            model.Name.ValueString(),
+		UserType:        model.UserType.ValueString(),
+		GroupIDs:        groupIDs,
+		OrganizationIDs: organizationIDs,
+		Tags:            expandStringList(model.Tags),
+		OrTags:          expandStringList(model.OrTags),
+	}, diags
+}
+
+func flattenHelpCenterUserSegment(segment *HCUserSegment, model *HelpCenterUserSegmentResourceModel) {
+	model.ID = types.StringValue(strconv.FormatInt(segment.ID, 10))
+	model.Name = types.StringValue(segment.Name)
+	model.UserType = types.StringValue(segment.UserType)
+	model.GroupIDs = flattenIDList(segment.GroupIDs, model.GroupIDs)
+	model.OrganizationIDs = flattenIDList(segment.OrganizationIDs, model.OrganizationIDs)
+
+	if len(segment.Tags) > 0 || model.Tags != nil {
+		model.Tags = stringListValue(segment.Tags)
+	}
+	if len(segment.OrTags) > 0 || model.OrTags != nil {
+		model.OrTags = stringListValue(segment.OrTags)
+	}
+}
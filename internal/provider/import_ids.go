@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+)
+
+// splitImportID splits a composite import ID such as "parent_id/child_id" into its parts.
+func splitImportID(id string, parts ...string) ([]string, error) {
+	values := strings.Split(id, "/")
+	if len(values) != len(parts) {
+		return nil, fmt.Errorf("expected import ID in the format %q, got: %q", strings.Join(parts, "/"), id)
+	}
+
+	for _, value := range values {
+		if value == "" {
+			return nil, fmt.Errorf("expected import ID in the format %q, got: %q", strings.Join(parts, "/"), id)
+		}
+	}
+
+	return values, nil
+}
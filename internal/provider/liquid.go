@@ -0,0 +1,213 @@
+package provider
+
+import (
+	_ "embed"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+//go:embed liquid_placeholders.txt
+var liquidPlaceholderCatalog string
+
+// liquidPlaceholders holds the catalog split into path segments.
+var liquidPlaceholders = parseLiquidPlaceholderCatalog(liquidPlaceholderCatalog)
+
+var liquidDelimiterPattern = regexp.MustCompile(`\{[{%]`)
+
+var liquidVariablePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z0-9_]+|\[[^\]]*\])*$`)
+
+// liquidEscaper outputs the delimiters through a Liquid string literal, so Zendesk renders them
+// instead of parsing them as a placeholder or tag.
+var liquidEscaper = strings.NewReplacer(
+	"{{", `{{ "{{" }}`,
+	"{%", `{{ "{%" }}`,
+)
+
+// dynamicContentKey derives the key Zendesk gives a dynamic content item from its name: the
+// name is lowercased, whitespace becomes underscores, and other punctuation is dropped.
+func dynamicContentKey(name string) (string, error) {
+	var key strings.Builder
+	for _, r := range strings.ToLower(strings.TrimSpace(name)) {
+		switch {
+		case unicode.IsSpace(r):
+			key.WriteRune('_')
+		case r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r):
+			key.WriteRune(r)
+		}
+	}
+
+	if key.Len() == 0 {
+		return "", fmt.Errorf("%q does not contain any letter or digit", name)
+	}
+
+	return key.String(), nil
+}
+
+func dynamicContentPlaceholder(name string) (string, error) {
+	key, err := dynamicContentKey(name)
+	if err != nil {
+		return "", err
+	}
+
+	return "{{dc." + key + "}}", nil
+}
+
+func liquidEscape(text string) string {
+	return liquidEscaper.Replace(text)
+}
+
+func parseLiquidPlaceholderCatalog(catalog string) [][]string {
+	var placeholders [][]string
+	for _, line := range strings.Split(catalog, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		placeholders = append(placeholders, strings.Split(line, "."))
+	}
+	return placeholders
+}
+
+func matchLiquidSegment(pattern, segment string) bool {
+	if pattern == "*" {
+		return true
+	}
+
+	prefix, suffix, ok := strings.Cut(pattern, "<id>")
+	if !ok {
+		return pattern == segment
+	}
+
+	if !strings.HasPrefix(segment, prefix) || !strings.HasSuffix(segment, suffix) || len(segment) <= len(prefix)+len(suffix) {
+		return false
+	}
+	for _, r := range segment[len(prefix) : len(segment)-len(suffix)] {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func knownLiquidPlaceholder(segments []string) bool {
+	for _, placeholder := range liquidPlaceholders {
+		if len(placeholder) != len(segments) {
+			continue
+		}
+
+		matched := true
+		for i := range placeholder {
+			if !matchLiquidSegment(placeholder[i], segments[i]) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+// checkLiquidPlaceholders parses the placeholders of a Liquid text. It returns the placeholders
+// missing from the catalog, and an error when a placeholder or tag is malformed. Filters are
+// ignored, as are variables introduced by for, assign and capture tags.
+func checkLiquidPlaceholders(text string) ([]string, error) {
+	var unknown []string
+	locals := map[string]bool{}
+
+	for rest := text; ; {
+		delimiter := liquidDelimiterPattern.FindStringIndex(rest)
+		if delimiter == nil {
+			return unknown, nil
+		}
+		start := delimiter[0]
+
+		if rest[start+1] == '%' {
+			end := strings.Index(rest[start+2:], "%}")
+			if end < 0 {
+				return unknown, fmt.Errorf("unterminated tag %q", truncateLiquid(rest[start:]))
+			}
+
+			fields := strings.Fields(strings.ReplaceAll(rest[start+2:start+2+end], "=", " = "))
+			if len(fields) > 1 {
+				switch fields[0] {
+				case "for", "assign", "capture":
+					locals[fields[1]] = true
+				}
+			}
+
+			rest = rest[start+2+end+2:]
+			continue
+		}
+
+		end := strings.Index(rest[start+2:], "}}")
+		if end < 0 {
+			return unknown, fmt.Errorf("unterminated placeholder %q", truncateLiquid(rest[start:]))
+		}
+		content := rest[start+2 : start+2+end]
+		rest = rest[start+2+end+2:]
+
+		expression, _, _ := strings.Cut(content, "|")
+		expression = strings.TrimSpace(expression)
+		if expression == "" {
+			return unknown, fmt.Errorf("empty placeholder {{%s}}", content)
+		}
+
+		// String and number literals, as output by liquid_escape.
+		if strings.HasPrefix(expression, `"`) || strings.HasPrefix(expression, "'") || (expression[0] >= '0' && expression[0] <= '9') {
+			continue
+		}
+
+		if !liquidVariablePattern.MatchString(expression) {
+			return unknown, fmt.Errorf("malformed placeholder {{%s}}", content)
+		}
+
+		var segments []string
+		for _, segment := range strings.Split(expression, ".") {
+			segment, _, _ = strings.Cut(segment, "[")
+			segments = append(segments, segment)
+		}
+
+		if !locals[segments[0]] && !knownLiquidPlaceholder(segments) {
+			unknown = append(unknown, expression)
+		}
+	}
+}
+
+func truncateLiquid(text string) string {
+	if len(text) > 40 {
+		return text[:40] + "..."
+	}
+	return text
+}
+
+// liquidPlaceholderDiagnostics reports malformed placeholders in text as an error and the ones
+// missing from the catalog as warnings, which can be false positives for newer placeholders.
+func liquidPlaceholderDiagnostics(attributePath path.Path, text string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	unknown, err := checkLiquidPlaceholders(text)
+	if err != nil {
+		diags.AddAttributeError(
+			attributePath,
+			"Malformed Liquid Placeholder",
+			fmt.Sprintf("The text contains a %v. Zendesk would render it literally.", err),
+		)
+	}
+
+	for _, placeholder := range unknown {
+		diags.AddAttributeWarning(
+			attributePath,
+			"Unknown Liquid Placeholder",
+			fmt.Sprintf("{{%s}} is not a known Zendesk placeholder and may be a typo. Unknown placeholders are rendered as empty text.", placeholder),
+		)
+	}
+
+	return diags
+}
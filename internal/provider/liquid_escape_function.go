@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var (
+	_ function.Function = &LiquidEscapeFunction{}
+)
+
+func NewLiquidEscapeFunction() function.Function {
+	return &LiquidEscapeFunction{}
+}
+
+type LiquidEscapeFunction struct{}
+
+func (f *LiquidEscapeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "liquid_escape"
+}
+
+func (f *LiquidEscapeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Escapes literal Liquid delimiters.",
+		Description: "Rewrites every {{ and {% in the text so that Zendesk renders them literally in notification bodies instead of evaluating them as placeholders or tags.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "text",
+				Description: "The text to escape.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *LiquidEscapeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var text string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &text))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, liquidEscape(text)))
+}
@@ -0,0 +1,44 @@
+package provider
+
+import "testing"
+
+func TestLiquidEscapeFunction(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{text: "No placeholders", want: "No placeholders"},
+		{text: "Use {{ticket.id}}", want: `Use {{ "{{" }}ticket.id}}`},
+		{text: "{% if %}", want: `{{ "{%" }} if %}`},
+		{text: "Single { braces }", want: "Single { braces }"},
+	}
+
+	for _, test := range tests {
+		got, err := runStringFunction(t, NewLiquidEscapeFunction(), test.text)
+		if err != nil {
+			t.Errorf("liquid_escape(%q) failed: %s", test.text, err.Text)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("liquid_escape(%q) = %q, want %q", test.text, got, test.want)
+		}
+	}
+}
+
+// The escaped text must pass the placeholder check, whatever the delimiters it contained.
+func TestLiquidEscapeFunctionValidPlaceholders(t *testing.T) {
+	for _, text := range []string{"{{ticket.nope", "{{}}", "{% unknown_tag", "{{ticket.id}} and {{dc.greeting}}"} {
+		escaped, err := runStringFunction(t, NewLiquidEscapeFunction(), text)
+		if err != nil {
+			t.Fatalf("liquid_escape(%q) failed: %s", text, err.Text)
+		}
+
+		unknown, checkErr := checkLiquidPlaceholders(escaped)
+		if checkErr != nil {
+			t.Errorf("escaped %q is malformed: %v", text, checkErr)
+		}
+		if len(unknown) > 0 {
+			t.Errorf("escaped %q has unknown placeholders %v", text, unknown)
+		}
+	}
+}
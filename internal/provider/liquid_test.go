@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCheckLiquidPlaceholders(t *testing.T) {
+	tests := []struct {
+		text    string
+		unknown []string
+	}{
+		{text: "Hello {{ticket.requester.first_name}}, ticket #{{ticket.id}}"},
+		{text: "{{ticket.ticket_field_360001234 | upcase}}"},
+		{text: "{{dc.greeting}}"},
+		{text: "{% for comment in ticket.comments %}{{comment.value}}{% endfor %}"},
+		{text: `{{ "{{" }}not a placeholder}}`},
+		{text: "{{ticket.requster.name}}", unknown: []string{"ticket.requster.name"}},
+	}
+
+	for _, test := range tests {
+		unknown, err := checkLiquidPlaceholders(test.text)
+		if err != nil {
+			t.Errorf("checkLiquidPlaceholders(%q) failed: %v", test.text, err)
+			continue
+		}
+		if !reflect.DeepEqual(unknown, test.unknown) {
+			t.Errorf("checkLiquidPlaceholders(%q) unknown = %v, want %v", test.text, unknown, test.unknown)
+		}
+	}
+}
+
+func TestCheckLiquidPlaceholdersMalformed(t *testing.T) {
+	for _, text := range []string{"{{ticket.id", "{{ }}", "{{ticket..id}}", "{% if ticket.id"} {
+		if _, err := checkLiquidPlaceholders(text); err == nil {
+			t.Errorf("checkLiquidPlaceholders(%q) succeeded, want an error", text)
+		}
+	}
+}
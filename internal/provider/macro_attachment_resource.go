@@ -0,0 +1,318 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                     = &MacroAttachmentResource{}
+	_ resource.ResourceWithImportState      = &MacroAttachmentResource{}
+	_ resource.ResourceWithConfigValidators = &MacroAttachmentResource{}
+)
+
+func NewMacroAttachmentResource() resource.Resource {
+	return &MacroAttachmentResource{}
+}
+
+type MacroAttachmentResource struct {
+	client *Client
+}
+
+type MacroAttachmentResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	MacroID       types.String `tfsdk:"macro_id"`
+	Source        types.String `tfsdk:"source"`
+	ContentBase64 types.String `tfsdk:"content_base64"`
+	Filename      types.String `tfsdk:"filename"`
+	SourceHash    types.String `tfsdk:"source_hash"`
+	ContentType   types.String `tfsdk:"content_type"`
+	ContentURL    types.String `tfsdk:"content_url"`
+	Size          types.Int64  `tfsdk:"size"`
+}
+
+func (r *MacroAttachmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_macro_attachment"
+}
+
+func (r *MacroAttachmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Uploads a file as an attachment of a macro. Attachments cannot be changed once uploaded, so any change to the file replaces the attachment.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the attachment.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"macro_id": schema.StringAttribute{
+				Description: "The ID of the macro.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source": schema.StringAttribute{
+				Description: "The path of a local file to upload. Conflicts with content_base64.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					requiresReplaceUnlessImported(),
+				},
+			},
+			"content_base64": schema.StringAttribute{
+				Description: "The base64-encoded content to upload. Conflicts with source and requires filename.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("filename")),
+				},
+				PlanModifiers: []planmodifier.String{
+					requiresReplaceUnlessImported(),
+				},
+			},
+			"filename": schema.StringAttribute{
+				Description: "The name of the uploaded file. Defaults to the base name of source.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_hash": schema.StringAttribute{
+				Description: "A hash of the file content, such as filesha256(source). Changing it uploads the file again, which is how changes to the file itself are detected.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					requiresReplaceUnlessImported(),
+				},
+			},
+			"content_type": schema.StringAttribute{
+				Description: "The content type of the attachment, as detected by Zendesk.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"content_url": schema.StringAttribute{
+				Description: "The URL the attachment can be downloaded from.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"size": schema.Int64Attribute{
+				Description: "The size of the attachment in bytes.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *MacroAttachmentResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("source"),
+			path.MatchRoot("content_base64"),
+		),
+	}
+}
+
+func (r *MacroAttachmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *MacroAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan MacroAttachmentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	macroID, err := strconv.ParseInt(plan.MacroID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Macro ID",
+			fmt.Sprintf("Could not parse macro ID: %v", err),
+		)
+		return
+	}
+
+	filename, content, err := macroAttachmentContent(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Attachment Content",
+			fmt.Sprintf("Could not read the content to upload: %v", err),
+		)
+		return
+	}
+
+	attachment, err := r.client.CreateMacroAttachment(ctx, macroID, filename, content)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Macro Attachment",
+			fmt.Sprintf("Could not upload %s to macro %d: %v", filename, macroID, err),
+		)
+		return
+	}
+
+	flattenMacroAttachment(attachment, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *MacroAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state MacroAttachmentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	macroID, err := strconv.ParseInt(state.MacroID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Macro ID",
+			fmt.Sprintf("Could not parse macro ID: %v", err),
+		)
+		return
+	}
+
+	attachments, err := r.client.ListMacroAttachments(ctx, macroID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Macro Attachment",
+			fmt.Sprintf("Could not list the attachments of macro %d: %v", macroID, err),
+		)
+		return
+	}
+
+	for _, attachment := range attachments {
+		if strconv.FormatInt(attachment.ID, 10) == state.ID.ValueString() {
+			flattenMacroAttachment(&attachment, &state)
+
+			diags = resp.State.Set(ctx, &state)
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Update only records the content arguments of an imported attachment. Every other change replaces
+// the attachment.
+func (r *MacroAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan MacroAttachmentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete only removes the attachment from state: the API has no endpoint to delete a macro
+// attachment. Attachments that are not referenced by a macro are purged by Zendesk.
+func (r *MacroAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state MacroAttachmentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Macro Attachment Not Deleted",
+		fmt.Sprintf("Zendesk does not support deleting macro attachments. Attachment %s was removed from the Terraform state but remains on macro %s.", state.ID.ValueString(), state.MacroID.ValueString()),
+	)
+}
+
+// ImportState accepts macro_id/attachment_id.
+func (r *MacroAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := splitImportID(req.ID, "macro_id", "attachment_id")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("macro_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
+
+// macroAttachmentContent returns the filename and content to upload for the plan.
+func macroAttachmentContent(plan MacroAttachmentResourceModel) (string, []byte, error) {
+	if !plan.ContentBase64.IsNull() {
+		content, err := base64.StdEncoding.DecodeString(plan.ContentBase64.ValueString())
+		if err != nil {
+			return "", nil, fmt.Errorf("content_base64 is not valid base64: %w", err)
+		}
+		return plan.Filename.ValueString(), content, nil
+	}
+
+	content, err := os.ReadFile(plan.Source.ValueString())
+	if err != nil {
+		return "", nil, err
+	}
+
+	filename := plan.Filename.ValueString()
+	if plan.Filename.IsNull() || plan.Filename.IsUnknown() {
+		filename = filepath.Base(plan.Source.ValueString())
+	}
+
+	return filename, content, nil
+}
+
+func flattenMacroAttachment(attachment *MacroAttachment, model *MacroAttachmentResourceModel) {
+	model.ID = types.StringValue(strconv.FormatInt(attachment.ID, 10))
+	model.Filename = types.StringValue(attachment.Filename)
+	model.ContentType = types.StringValue(attachment.ContentType)
+	model.ContentURL = types.StringValue(attachment.ContentURL)
+	model.Size = types.Int64Value(attachment.Size)
+}
+
+// requiresReplaceUnlessImported replaces the attachment when the argument changes, except when it
+// is first set on an attachment that was imported and has no prior value in state.
+func requiresReplaceUnlessImported() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !req.StateValue.IsNull()
+		},
+		"Replaces the attachment when the value changes, unless the attachment was imported.",
+		"Replaces the attachment when the value changes, unless the attachment was imported.",
+	)
+}
@@ -0,0 +1,345 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &MacroResource{}
+	_ resource.ResourceWithImportState = &MacroResource{}
+	_ resource.ResourceWithModifyPlan  = &MacroResource{}
+)
+
+func NewMacroResource() resource.Resource {
+	return &MacroResource{}
+}
+
+type MacroResource struct {
+	client *Client
+}
+
+type MacroResourceModel struct {
+	ID                  types.String      `tfsdk:"id"`
+	Title               types.String      `tfsdk:"title"`
+	Active              types.Bool        `tfsdk:"active"`
+	Position            types.Int64       `tfsdk:"position"`
+	Description         types.String      `tfsdk:"description"`
+	Actions             []RuleActionModel `tfsdk:"actions"`
+	DeactivateOnDelete  types.Bool        `tfsdk:"deactivate_on_delete"`
+	IgnoreServerChanges types.Set         `tfsdk:"ignore_server_changes"`
+}
+
+func (r *MacroResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_macro"
+}
+
+func (r *MacroResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Zendesk macro.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the macro.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "The title of the macro.",
+				Required:    true,
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether the macro is active. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"position": schema.Int64Attribute{
+				Description: "The position of the macro in the list of macros.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the macro.",
+				Optional:    true,
+			},
+			"actions":               ruleActionsAttribute("The actions performed on the ticket when an agent applies the macro."),
+			"deactivate_on_delete":  deactivateOnDeleteAttribute(),
+			"ignore_server_changes": ignoreServerChangesAttribute("position"),
+		},
+	}
+}
+
+func (r *MacroResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *MacroResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || !r.client.validatePlaceholders || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan MacroResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(ruleActionPlaceholderDiagnostics(path.Root("actions"), plan.Actions)...)
+}
+
+func (r *MacroResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan MacroResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	position := rulePosition(plan.Position, types.Int64Null(), plan.IgnoreServerChanges)
+	macro, err := r.client.CreateMacro(ctx, expandMacro(plan, position))
+	if err != nil {
+		if messages := validationMessages(err); messages != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("actions"), "Error Creating Macro", macroValidationDetail(messages, plan.Actions))
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Creating Macro",
+			fmt.Sprintf("Could not create macro: %v", err),
+		)
+		return
+	}
+
+	planned := plan.Position
+	flattenMacro(macro, &plan)
+	if position == nil && !planned.IsUnknown() {
+		plan.Position = planned
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *MacroResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state MacroResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Macro ID",
+			fmt.Sprintf("Could not parse macro ID: %v", err),
+		)
+		return
+	}
+
+	macro, err := r.client.ReadMacro(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Macro",
+			fmt.Sprintf("Could not read macro: %v", err),
+		)
+		return
+	}
+
+	if macro == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	position := state.Position
+	flattenMacro(macro, &state)
+	if ignoresServerChanges(state.IgnoreServerChanges, "position") && !position.IsNull() {
+		state.Position = position
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *MacroResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan MacroResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The planned position of a macro that does not configure one is the prior state, so only
+	// the configured position is sent.
+	var configured, prior types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("position"), &configured)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("position"), &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(plan.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Macro ID",
+			fmt.Sprintf("Could not parse macro ID: %v", err),
+		)
+		return
+	}
+
+	position := rulePosition(configured, prior, plan.IgnoreServerChanges)
+	macro, err := r.client.UpdateMacro(ctx, id, expandMacro(plan, position))
+	if err != nil {
+		if messages := validationMessages(err); messages != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("actions"), "Error Updating Macro", macroValidationDetail(messages, plan.Actions))
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Updating Macro",
+			fmt.Sprintf("Could not update macro: %v", err),
+		)
+		return
+	}
+
+	planned := plan.Position
+	flattenMacro(macro, &plan)
+	if position == nil && !planned.IsUnknown() {
+		plan.Position = planned
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *MacroResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state MacroResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Macro ID",
+			fmt.Sprintf("Could not parse macro ID: %v", err),
+		)
+		return
+	}
+
+	if deactivateOnDelete(r.client, state.DeactivateOnDelete) {
+		macro := expandMacro(state, nil)
+		macro.Active = false
+
+		_, err = r.client.UpdateMacro(ctx, id, macro)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Deactivating Macro",
+				fmt.Sprintf("Could not deactivate macro: %v", err),
+			)
+		}
+		return
+	}
+
+	err = r.client.DeleteMacro(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Macro",
+			fmt.Sprintf("Could not delete macro: %v", err),
+		)
+		return
+	}
+}
+
+func (r *MacroResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func expandMacro(model MacroResourceModel, position *int64) Macro {
+	return Macro{
+		Title:       model.Title.ValueString(),
+		Active:      model.Active.ValueBool(),
+		Position:    position,
+		Description: model.Description.ValueString(),
+		Actions:     expandRuleActions(model.Actions),
+	}
+}
+
+func flattenMacro(macro *Macro, model *MacroResourceModel) {
+	model.ID = types.StringValue(strconv.FormatInt(macro.ID, 10))
+	model.Title = types.StringValue(macro.Title)
+	model.Active = types.BoolValue(macro.Active)
+	model.Position = types.Int64Value(positionValue(macro.Position))
+	if macro.Description != "" || !model.Description.IsNull() {
+		model.Description = types.StringValue(macro.Description)
+	}
+	model.Actions = flattenMacroActions(macro.Actions, model.Actions)
+}
+
+// flattenMacroActions is flattenRuleActions, except that values Zendesk wraps in a single
+// element array, e.g. ["open"] for status, are unwrapped when there is no prior value to
+// compare with, such as on import.
+func flattenMacroActions(actions []RuleAction, prior []RuleActionModel) []RuleActionModel {
+	models := flattenRuleActions(actions, prior)
+	for i := range models {
+		if i < len(prior) && !prior[i].Value.IsNull() {
+			continue
+		}
+
+		var single []string
+		if err := json.Unmarshal([]byte(models[i].Value.ValueString()), &single); err == nil && len(single) == 1 {
+			models[i].Value = types.StringValue(single[0])
+		}
+	}
+	return models
+}
+
+// macroValidationDetail explains a 422 returned for a macro. The most common cause is an action
+// on a ticket field that was deleted, which Zendesk reports without naming the action.
+func macroValidationDetail(messages []string, actions []RuleActionModel) string {
+	detail := strings.Join(messages, " ")
+
+	var fields []string
+	for _, action := range actions {
+		if strings.HasPrefix(action.Field.ValueString(), "custom_fields_") {
+			fields = append(fields, action.Field.ValueString())
+		}
+	}
+	if len(fields) > 0 {
+		detail += fmt.Sprintf(" The macro sets the ticket fields %s; check that they still exist and are active.", strings.Join(fields, ", "))
+	}
+
+	return detail
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// The planned position of a macro without a configured one comes from the prior state and is
+// not sent, so that it does not undo a renumbering. The fixture fails the test if the request
+// body has a position.
+func TestMacroUpdateWithoutPosition(t *testing.T) {
+	p := newProtocolTest(t, "macro_update_without_position.json")
+
+	state := p.read("zendesk_macro", `{
+		"id": "1000001", "title": "Close and tag", "active": true, "position": 3,
+		"actions": [{"field": "status", "value": "solved"}]
+	}`)
+
+	state = p.apply("zendesk_macro", `{
+		"title": "Close and tag",
+		"actions": [{"field": "status", "value": "solved"}, {"field": "set_tags", "value": "closed_by_macro"}]
+	}`, state)
+	if got, want := p.attribute("zendesk_macro", state, "position"), tftypes.NewValue(tftypes.Number, 3); !got.Equal(want) {
+		t.Errorf("expected the planned position %s until the next refresh, got %s", want, got)
+	}
+}
+
+// A configured position whose server changes are ignored is kept through a renumbering, and
+// not sent again on update.
+func TestMacroPositionIgnored(t *testing.T) {
+	p := newProtocolTest(t, "macro_position_ignored.json")
+
+	state := p.read("zendesk_macro", `{
+		"id": "1000001", "title": "Close and tag", "active": true, "position": 3,
+		"actions": [{"field": "status", "value": "solved"}],
+		"ignore_server_changes": ["position"]
+	}`)
+	if got, want := p.attribute("zendesk_macro", state, "position"), tftypes.NewValue(tftypes.Number, 3); !got.Equal(want) {
+		t.Errorf("expected the ignored position %s to be kept, got %s", want, got)
+	}
+
+	state = p.apply("zendesk_macro", `{
+		"title": "Close and tag", "position": 3,
+		"actions": [{"field": "status", "value": "solved"}, {"field": "set_tags", "value": "closed_by_macro"}],
+		"ignore_server_changes": ["position"]
+	}`, state)
+	if got, want := p.attribute("zendesk_macro", state, "position"), tftypes.NewValue(tftypes.Number, 3); !got.Equal(want) {
+		t.Errorf("expected position %s, got %s", want, got)
+	}
+}
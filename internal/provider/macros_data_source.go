@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &MacrosDataSource{}
+)
+
+func NewMacrosDataSource() datasource.DataSource {
+	return &MacrosDataSource{}
+}
+
+type MacrosDataSource struct {
+	client *Client
+}
+
+type MacrosDataSourceModel struct {
+	Active     types.Bool        `tfsdk:"active"`
+	GroupID    types.String      `tfsdk:"group_id"`
+	Category   types.String      `tfsdk:"category"`
+	Access     types.String      `tfsdk:"access"`
+	TitleRegex types.String      `tfsdk:"title_regex"`
+	Total      types.Int64       `tfsdk:"total"`
+	Macros     []MacrosItemModel `tfsdk:"macros"`
+}
+
+type MacrosItemModel struct {
+	ID          types.String      `tfsdk:"id"`
+	Title       types.String      `tfsdk:"title"`
+	Active      types.Bool        `tfsdk:"active"`
+	Position    types.Int64       `tfsdk:"position"`
+	Restriction *RestrictionModel `tfsdk:"restriction"`
+}
+
+func (d *MacrosDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_macros"
+}
+
+func (d *MacrosDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists Zendesk macros, sorted by position.",
+		Attributes: map[string]schema.Attribute{
+			"active": schema.BoolAttribute{
+				Description: "Only return active (true) or inactive (false) macros.",
+				Optional:    true,
+			},
+			"group_id": schema.StringAttribute{
+				Description: "Only return macros available to this group.",
+				Optional:    true,
+			},
+			"category": schema.StringAttribute{
+				Description: "Only return macros in this macro category.",
+				Optional:    true,
+			},
+			"access": schema.StringAttribute{
+				Description: "Only return macros with this access level: 'personal', 'agents', 'shared' or 'account'.",
+				Optional:    true,
+			},
+			"title_regex": schema.StringAttribute{
+				Description: "Only return macros whose title matches this regular expression.",
+				Optional:    true,
+			},
+			"total": schema.Int64Attribute{
+				Description: "The number of macros returned.",
+				Computed:    true,
+			},
+			"macros": schema.ListNestedAttribute{
+				Description: "The macros.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the macro.",
+							Computed:    true,
+						},
+						"title": schema.StringAttribute{
+							Description: "The title of the macro.",
+							Computed:    true,
+						},
+						"active": schema.BoolAttribute{
+							Description: "Whether the macro is active.",
+							Computed:    true,
+						},
+						"position": schema.Int64Attribute{
+							Description: "The position of the macro.",
+							Computed:    true,
+						},
+						"restriction": restrictionDataSourceAttribute("Who may use the macro. Null when the macro is available to all agents."),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *MacrosDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *MacrosDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config MacrosDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var titleRegex *regexp.Regexp
+	if !config.TitleRegex.IsNull() {
+		var err error
+		titleRegex, err = regexp.Compile(config.TitleRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("title_regex"),
+				"Invalid Title Regex",
+				fmt.Sprintf("Could not compile title_regex: %v", err),
+			)
+			return
+		}
+	}
+
+	params := url.Values{}
+	if !config.Active.IsNull() {
+		params.Set("active", strconv.FormatBool(config.Active.ValueBool()))
+	}
+	if !config.GroupID.IsNull() {
+		params.Set("group_id", config.GroupID.ValueString())
+	}
+	if !config.Category.IsNull() {
+		params.Set("category", config.Category.ValueString())
+	}
+	if !config.Access.IsNull() {
+		params.Set("access", config.Access.ValueString())
+	}
+
+	macros, err := d.client.ListMacros(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Macros",
+			fmt.Sprintf("Could not list macros: %v", err),
+		)
+		return
+	}
+
+	sort.SliceStable(macros, func(i, j int) bool {
+		if positionValue(macros[i].Position) != positionValue(macros[j].Position) {
+			return positionValue(macros[i].Position) < positionValue(macros[j].Position)
+		}
+		return macros[i].ID < macros[j].ID
+	})
+
+	config.Macros = make([]MacrosItemModel, 0, len(macros))
+	for _, macro := range macros {
+		if titleRegex != nil && !titleRegex.MatchString(macro.Title) {
+			continue
+		}
+
+		config.Macros = append(config.Macros, MacrosItemModel{
+			ID:          types.StringValue(strconv.FormatInt(macro.ID, 10)),
+			Title:       types.StringValue(macro.Title),
+			Active:      types.BoolValue(macro.Active),
+			Position:    types.Int64Value(positionValue(macro.Position)),
+			Restriction: flattenRestriction(macro.Restriction),
+		})
+	}
+	config.Total = types.Int64Value(int64(len(config.Macros)))
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                     = &OAuthClientDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &OAuthClientDataSource{}
+)
+
+func NewOAuthClientDataSource() datasource.DataSource {
+	return &OAuthClientDataSource{}
+}
+
+type OAuthClientDataSource struct {
+	client *Client
+}
+
+type OAuthClientDataSourceModel struct {
+	ID           types.String   `tfsdk:"id"`
+	Identifier   types.String   `tfsdk:"identifier"`
+	Name         types.String   `tfsdk:"name"`
+	Kind         types.String   `tfsdk:"kind"`
+	Description  types.String   `tfsdk:"description"`
+	Company      types.String   `tfsdk:"company"`
+	RedirectURIs []types.String `tfsdk:"redirect_uris"`
+	Global       types.Bool     `tfsdk:"global"`
+}
+
+func (d *OAuthClientDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_oauth_client"
+}
+
+func (d *OAuthClientDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up an existing Zendesk OAuth client by ID, identifier or name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the OAuth client. Exactly one of id, identifier or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"identifier": schema.StringAttribute{
+				Description: "The unique identifier of the OAuth client. Exactly one of id, identifier or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the OAuth client. Exactly one of id, identifier or name must be set. Looking up by name fails when several clients share it.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"kind": schema.StringAttribute{
+				Description: "The kind of OAuth client (e.g., 'public').",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the OAuth client.",
+				Computed:    true,
+			},
+			"company": schema.StringAttribute{
+				Description: "The company name shown to users when they authorize the client.",
+				Computed:    true,
+			},
+			"redirect_uris": schema.ListAttribute{
+				Description: "The redirect URIs registered for the OAuth client.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"global": schema.BoolAttribute{
+				Description: "Whether the OAuth client is a global client.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *OAuthClientDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("identifier"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *OAuthClientDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *OAuthClientDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config OAuthClientDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var client *OAuthClient
+	if !config.ID.IsNull() {
+		id, err := strconv.ParseInt(config.ID.ValueString(), 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Error Parsing OAuth Client ID",
+				fmt.Sprintf("Could not parse OAuth client ID: %v", err),
+			)
+			return
+		}
+
+		client, err = d.client.ReadOAuthClient(ctx, id)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading OAuth Client",
+				fmt.Sprintf("Could not read OAuth client: %v", err),
+			)
+			return
+		}
+
+		if client == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"OAuth Client Not Found",
+				fmt.Sprintf("No OAuth client found with id %q.", config.ID.ValueString()),
+			)
+			return
+		}
+	} else {
+		clients, err := d.client.ListOAuthClients(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Listing OAuth Clients",
+				fmt.Sprintf("Could not list OAuth clients: %v", err),
+			)
+			return
+		}
+
+		if !config.Name.IsNull() {
+			var matched []int64
+			for i := range clients {
+				if clients[i].Name == config.Name.ValueString() {
+					client = &clients[i]
+					matched = append(matched, clients[i].ID)
+				}
+			}
+
+			resp.Diagnostics.Append(checkUniqueName("OAuth Client", "OAuth Clients", config.Name.ValueString(), matched)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		} else {
+			for i := range clients {
+				if clients[i].Identifier == config.Identifier.ValueString() {
+					client = &clients[i]
+					break
+				}
+			}
+		}
+
+		if client == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("identifier"),
+				"OAuth Client Not Found",
+				fmt.Sprintf("No OAuth client found with identifier %q.", config.Identifier.ValueString()),
+			)
+			return
+		}
+	}
+
+	config.ID = types.StringValue(strconv.FormatInt(client.ID, 10))
+	config.Identifier = types.StringValue(client.Identifier)
+	config.Name = types.StringValue(client.Name)
+	config.Kind = types.StringValue(client.Kind)
+	config.Description = types.StringValue(client.Description)
+	config.Company = types.StringValue(client.Company)
+	config.RedirectURIs = make([]types.String, 0, len(client.RedirectURIs))
+	for _, uri := range client.RedirectURIs {
+		config.RedirectURIs = append(config.RedirectURIs, types.StringValue(uri))
+	}
+	config.Global = types.BoolValue(client.Global)
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
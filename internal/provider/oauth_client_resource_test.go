@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const testOAuthClientConfig = `{
+	"name": "Deploy bot", "identifier": "deploy_bot", "kind": "confidential", "description": "Used by CI"
+}`
+
+func TestOAuthClientLifecycle(t *testing.T) {
+	p := newProtocolTest(t, "oauth_client_lifecycle.json")
+
+	state := p.apply("zendesk_oauth_client", testOAuthClientConfig, nil)
+	if got, want := p.attribute("zendesk_oauth_client", state, "id"), tftypes.NewValue(tftypes.String, "1000001"); !got.Equal(want) {
+		t.Errorf("expected id %s, got %s", want, got)
+	}
+	if got, want := p.attribute("zendesk_oauth_client", state, "secret"), tftypes.NewValue(tftypes.String, "REDACTED"); !got.Equal(want) {
+		t.Errorf("expected secret %s, got %s", want, got)
+	}
+
+	state = p.refresh("zendesk_oauth_client", state)
+	p.planUnchanged("zendesk_oauth_client", testOAuthClientConfig, state)
+
+	if state := p.apply("zendesk_oauth_client", "", state); !p.value("zendesk_oauth_client", state).IsNull() {
+		t.Errorf("expected the client to be destroyed, got %s", p.value("zendesk_oauth_client", state))
+	}
+}
+
+func TestOAuthClientReadDeleted(t *testing.T) {
+	p := newProtocolTest(t, "oauth_client_deleted.json")
+
+	state := p.read("zendesk_oauth_client", `{
+		"id": "1000001", "name": "Deploy bot", "identifier": "deploy_bot", "kind": "confidential",
+		"description": "Used by CI", "secret": "s3cr3t"
+	}`)
+	if !p.value("zendesk_oauth_client", state).IsNull() {
+		t.Errorf("expected the deleted client to be removed from state, got %s", p.value("zendesk_oauth_client", state))
+	}
+}
+
+// Zendesk only returns a truncated secret after creation, which the refresh must not store over
+// the full one.
+func TestOAuthClientSecretSurvivesRefresh(t *testing.T) {
+	p := newProtocolTest(t, "oauth_client_refresh.json")
+
+	state := p.read("zendesk_oauth_client", `{
+		"id": "1000001", "name": "Deploy bot", "identifier": "deploy_bot", "kind": "confidential",
+		"description": "Used by CI", "secret": "s3cr3t-full-value"
+	}`)
+	if got, want := p.attribute("zendesk_oauth_client", state, "secret"), tftypes.NewValue(tftypes.String, "s3cr3t-full-value"); !got.Equal(want) {
+		t.Errorf("expected secret %s, got %s", want, got)
+	}
+
+	p.planUnchanged("zendesk_oauth_client", testOAuthClientConfig, state)
+}
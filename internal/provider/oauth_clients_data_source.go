@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &OAuthClientsDataSource{}
+)
+
+func NewOAuthClientsDataSource() datasource.DataSource {
+	return &OAuthClientsDataSource{}
+}
+
+type OAuthClientsDataSource struct {
+	client *Client
+}
+
+type OAuthClientsDataSourceModel struct {
+	Kind      types.String            `tfsdk:"kind"`
+	NameRegex types.String            `tfsdk:"name_regex"`
+	Clients   []OAuthClientsItemModel `tfsdk:"clients"`
+}
+
+type OAuthClientsItemModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Identifier types.String `tfsdk:"identifier"`
+	Kind       types.String `tfsdk:"kind"`
+	Global     types.Bool   `tfsdk:"global"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+}
+
+func (d *OAuthClientsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_oauth_clients"
+}
+
+func (d *OAuthClientsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all Zendesk OAuth clients in the account, sorted by ID.",
+		Attributes: map[string]schema.Attribute{
+			"kind": schema.StringAttribute{
+				Description: "Only return clients of this kind (e.g., 'public' or 'confidential').",
+				Optional:    true,
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Only return clients whose name matches this regular expression.",
+				Optional:    true,
+			},
+			"clients": schema.ListNestedAttribute{
+				Description: "The OAuth clients.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the OAuth client.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the OAuth client.",
+							Computed:    true,
+						},
+						"identifier": schema.StringAttribute{
+							Description: "The unique identifier of the OAuth client.",
+							Computed:    true,
+						},
+						"kind": schema.StringAttribute{
+							Description: "The kind of OAuth client.",
+							Computed:    true,
+						},
+						"global": schema.BoolAttribute{
+							Description: "Whether the OAuth client is a global client.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "When the OAuth client was created.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *OAuthClientsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *OAuthClientsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config OAuthClientsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !config.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(config.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Name Regex",
+				fmt.Sprintf("Could not compile name_regex: %v", err),
+			)
+			return
+		}
+	}
+
+	clients, err := d.client.ListOAuthClients(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing OAuth Clients",
+			fmt.Sprintf("Could not list OAuth clients: %v", err),
+		)
+		return
+	}
+
+	sort.Slice(clients, func(i, j int) bool {
+		return clients[i].ID < clients[j].ID
+	})
+
+	config.Clients = make([]OAuthClientsItemModel, 0, len(clients))
+	for _, client := range clients {
+		if !config.Kind.IsNull() && client.Kind != config.Kind.ValueString() {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(client.Name) {
+			continue
+		}
+
+		config.Clients = append(config.Clients, OAuthClientsItemModel{
+			ID:         types.StringValue(strconv.FormatInt(client.ID, 10)),
+			Name:       types.StringValue(client.Name),
+			Identifier: types.StringValue(client.Identifier),
+			Kind:       types.StringValue(client.Kind),
+			Global:     types.BoolValue(client.Global),
+			CreatedAt:  types.StringValue(client.CreatedAt),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestOAuthTokenUpgradeStateV0(t *testing.T) {
+	ctx := context.Background()
+	r := &OAuthTokenResource{}
+
+	upgrader, ok := r.UpgradeState(ctx)[0]
+	if !ok {
+		t.Fatal("no upgrader for schema version 0")
+	}
+
+	// A state written by version 0, with the scopes in API order and no expiration.
+	rawState := `{"id": "1000001", "client_id": "1000002", "scopes": ["write", "read"], "full_token": "REDACTED", "expires_at": ""}`
+
+	prior, err := tftypes.ValueFromJSON([]byte(rawState), upgrader.PriorSchema.Type().TerraformType(ctx))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	req := resource.UpgradeStateRequest{
+		State: &tfsdk.State{Schema: *upgrader.PriorSchema, Raw: prior},
+	}
+	resp := &resource.UpgradeStateResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+	}
+	upgrader.StateUpgrader(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("upgrade failed: %v", resp.Diagnostics)
+	}
+
+	var state OAuthTokenResourceModel
+	if diags := resp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if state.ID.ValueString() != "1000001" || state.ClientID.ValueString() != "1000002" || state.FullToken.ValueString() != "REDACTED" {
+		t.Errorf("identifying attributes were not preserved: %+v", state)
+	}
+	if !state.ExpiresAt.IsNull() {
+		t.Errorf("expires_at = %s, want null", state.ExpiresAt)
+	}
+
+	// The scopes are a set in version 1, so the order of version 0 must not matter.
+	want := tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "read"),
+		tftypes.NewValue(tftypes.String, "write"),
+	})
+	attributes := map[string]tftypes.Value{}
+	if err := resp.State.Raw.As(&attributes); err != nil {
+		t.Fatal(err)
+	}
+	if scopes := attributes["scopes"]; !scopes.Equal(want) {
+		t.Errorf("scopes = %s, want %s", scopes, want)
+	}
+}
+
+const testOAuthTokenConfig = `{"client_id": "1000001", "scopes": ["read", "write"], "expires_at": "2027-01-01T00:00:00Z"}`
+
+func TestOAuthTokenLifecycle(t *testing.T) {
+	p := newProtocolTest(t, "oauth_token_lifecycle.json")
+
+	state := p.apply("zendesk_oauth_token", testOAuthTokenConfig, nil)
+	if got, want := p.attribute("zendesk_oauth_token", state, "id"), tftypes.NewValue(tftypes.String, "1000002"); !got.Equal(want) {
+		t.Errorf("expected id %s, got %s", want, got)
+	}
+
+	// Zendesk only returns the full token on creation, so the refresh keeps it.
+	state = p.refresh("zendesk_oauth_token", state)
+	if got, want := p.attribute("zendesk_oauth_token", state, "full_token"), tftypes.NewValue(tftypes.String, "REDACTED"); !got.Equal(want) {
+		t.Errorf("expected full_token %s, got %s", want, got)
+	}
+	p.planUnchanged("zendesk_oauth_token", testOAuthTokenConfig, state)
+
+	if state := p.apply("zendesk_oauth_token", "", state); !p.value("zendesk_oauth_token", state).IsNull() {
+		t.Errorf("expected the token to be destroyed, got %s", p.value("zendesk_oauth_token", state))
+	}
+}
+
+func TestOAuthTokenReadDeleted(t *testing.T) {
+	p := newProtocolTest(t, "oauth_token_deleted.json")
+
+	state := p.read("zendesk_oauth_token", `{"id": "1000002", "client_id": "1000001", "scopes": ["read"], "full_token": "t0k3n"}`)
+	if !p.value("zendesk_oauth_token", state).IsNull() {
+		t.Errorf("expected the deleted token to be removed from state, got %s", p.value("zendesk_oauth_token", state))
+	}
+}
@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &OAuthTokensDataSource{}
+)
+
+func NewOAuthTokensDataSource() datasource.DataSource {
+	return &OAuthTokensDataSource{}
+}
+
+type OAuthTokensDataSource struct {
+	client *Client
+}
+
+type OAuthTokensDataSourceModel struct {
+	ClientID types.String           `tfsdk:"client_id"`
+	Tokens   []OAuthTokensItemModel `tfsdk:"tokens"`
+}
+
+type OAuthTokensItemModel struct {
+	ID        types.String   `tfsdk:"id"`
+	ClientID  types.String   `tfsdk:"client_id"`
+	UserID    types.String   `tfsdk:"user_id"`
+	Scopes    []types.String `tfsdk:"scopes"`
+	CreatedAt types.String   `tfsdk:"created_at"`
+	UsedAt    types.String   `tfsdk:"used_at"`
+	ExpiresAt types.String   `tfsdk:"expires_at"`
+}
+
+func (d *OAuthTokensDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_oauth_tokens"
+}
+
+func (d *OAuthTokensDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists Zendesk OAuth tokens, optionally restricted to a single OAuth client. Token values are never exposed.",
+		Attributes: map[string]schema.Attribute{
+			"client_id": schema.StringAttribute{
+				Description: "Only return tokens issued to this OAuth client.",
+				Optional:    true,
+			},
+			"tokens": schema.ListNestedAttribute{
+				Description: "The OAuth tokens, sorted by ID.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the OAuth token.",
+							Computed:    true,
+						},
+						"client_id": schema.StringAttribute{
+							Description: "The ID of the OAuth client the token was issued to.",
+							Computed:    true,
+						},
+						"user_id": schema.StringAttribute{
+							Description: "The ID of the user the token belongs to.",
+							Computed:    true,
+						},
+						"scopes": schema.ListAttribute{
+							Description: "The scopes granted to the token.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "When the token was created.",
+							Computed:    true,
+						},
+						"used_at": schema.StringAttribute{
+							Description: "When the token was last used.",
+							Computed:    true,
+						},
+						"expires_at": schema.StringAttribute{
+							Description: "When the token expires. Empty if the token does not expire.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *OAuthTokensDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *OAuthTokensDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config OAuthTokensDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var clientID int64
+	if !config.ClientID.IsNull() {
+		var err error
+		clientID, err = strconv.ParseInt(config.ClientID.ValueString(), 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("client_id"),
+				"Error Parsing Client ID",
+				fmt.Sprintf("Could not parse client ID: %v", err),
+			)
+			return
+		}
+	}
+
+	tokens, err := d.client.ListOAuthTokens(ctx, clientID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing OAuth Tokens",
+			fmt.Sprintf("Could not list OAuth tokens: %v", err),
+		)
+		return
+	}
+
+	sort.Slice(tokens, func(i, j int) bool {
+		return tokens[i].ID < tokens[j].ID
+	})
+
+	config.Tokens = make([]OAuthTokensItemModel, 0, len(tokens))
+	for _, token := range tokens {
+		// Guard against the filter being ignored by the API.
+		if clientID != 0 && token.ClientID != clientID {
+			continue
+		}
+
+		item := OAuthTokensItemModel{
+			ID:        types.StringValue(strconv.FormatInt(token.ID, 10)),
+			ClientID:  types.StringValue(strconv.FormatInt(token.ClientID, 10)),
+			UserID:    types.StringValue(strconv.FormatInt(token.UserID, 10)),
+			Scopes:    make([]types.String, 0, len(token.Scopes)),
+			CreatedAt: types.StringValue(token.CreatedAt),
+			UsedAt:    types.StringValue(token.UsedAt),
+			ExpiresAt: types.StringValue(token.ExpiresAt),
+		}
+		for _, scope := range token.Scopes {
+			item.Scopes = append(item.Scopes, types.StringValue(scope))
+		}
+
+		config.Tokens = append(config.Tokens, item)
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
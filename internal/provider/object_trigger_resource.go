@@ -0,0 +1,388 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &ObjectTriggerResource{}
+	_ resource.ResourceWithImportState = &ObjectTriggerResource{}
+	_ resource.ResourceWithModifyPlan  = &ObjectTriggerResource{}
+)
+
+func NewObjectTriggerResource() resource.Resource {
+	return &ObjectTriggerResource{}
+}
+
+type ObjectTriggerResource struct {
+	client *Client
+}
+
+type ObjectTriggerResourceModel struct {
+	ID                  types.String         `tfsdk:"id"`
+	ObjectKey           types.String         `tfsdk:"object_key"`
+	Title               types.String         `tfsdk:"title"`
+	Active              types.Bool           `tfsdk:"active"`
+	Description         types.String         `tfsdk:"description"`
+	Position            types.Int64          `tfsdk:"position"`
+	Conditions          *RuleConditionsModel `tfsdk:"conditions"`
+	Actions             []RuleActionModel    `tfsdk:"actions"`
+	DeactivateOnDelete  types.Bool           `tfsdk:"deactivate_on_delete"`
+	IgnoreServerChanges types.Set            `tfsdk:"ignore_server_changes"`
+}
+
+func (r *ObjectTriggerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object_trigger"
+}
+
+func (r *ObjectTriggerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Zendesk custom object trigger.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the object trigger.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"object_key": schema.StringAttribute{
+				Description: "The key of the custom object the trigger fires on.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "The title of the trigger.",
+				Required:    true,
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether the trigger is active. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the trigger.",
+				Optional:    true,
+			},
+			"position": schema.Int64Attribute{
+				Description: "The position of the trigger, which determines the order in which triggers fire.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"conditions":            ruleConditionsAttribute("The conditions the record must meet for the trigger to fire. Condition fields are the custom object's field keys, which are checked at plan time when the provider sets validate_references."),
+			"actions":               ruleActionsAttribute("The actions performed on the record when the trigger fires."),
+			"deactivate_on_delete":  deactivateOnDeleteAttribute(),
+			"ignore_server_changes": ignoreServerChangesAttribute("position"),
+		},
+	}
+}
+
+func (r *ObjectTriggerResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan checks the Liquid placeholders of the actions and the fields referenced by the
+// conditions, when the provider enables these checks.
+func (r *ObjectTriggerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || req.Plan.Raw.IsNull() || !(r.client.validatePlaceholders || r.client.validateReferences) {
+		return
+	}
+
+	var plan ObjectTriggerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.validatePlaceholders {
+		resp.Diagnostics.Append(ruleActionPlaceholderDiagnostics(path.Root("actions"), plan.Actions)...)
+	}
+
+	if r.client.validateReferences && !plan.ObjectKey.IsUnknown() && plan.Conditions != nil {
+		fields, err := r.client.ListCustomObjectFields(ctx, plan.ObjectKey.ValueString())
+		if err != nil {
+			// The object may be created in the same apply, in which case there is nothing to check
+			// against yet.
+			if !isNotFound(err) {
+				resp.Diagnostics.AddAttributeWarning(
+					path.Root("conditions"),
+					"Could Not Validate Object Fields",
+					fmt.Sprintf("Could not list the fields of custom object %q: %v", plan.ObjectKey.ValueString(), err),
+				)
+			}
+			return
+		}
+
+		keys := make(map[string]bool, len(fields))
+		for _, field := range fields {
+			keys[field.Key] = true
+		}
+		resp.Diagnostics.Append(objectFieldDiagnostics(plan.ObjectKey.ValueString(), plan.Conditions, keys)...)
+	}
+}
+
+func (r *ObjectTriggerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ObjectTriggerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	position := rulePosition(plan.Position, types.Int64Null(), plan.IgnoreServerChanges)
+	trigger, err := r.client.CreateObjectTrigger(ctx, plan.ObjectKey.ValueString(), expandObjectTrigger(plan, position))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Object Trigger",
+			fmt.Sprintf("Could not create object trigger: %v", err),
+		)
+		return
+	}
+
+	planned := plan.Position
+	flattenObjectTrigger(trigger, &plan)
+	if position == nil && !planned.IsUnknown() {
+		plan.Position = planned
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ObjectTriggerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ObjectTriggerResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Object Trigger ID",
+			fmt.Sprintf("Could not parse object trigger ID: %v", err),
+		)
+		return
+	}
+
+	trigger, err := r.client.ReadObjectTrigger(ctx, state.ObjectKey.ValueString(), id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Object Trigger",
+			fmt.Sprintf("Could not read object trigger: %v", err),
+		)
+		return
+	}
+
+	if trigger == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	position := state.Position
+	flattenObjectTrigger(trigger, &state)
+	if ignoresServerChanges(state.IgnoreServerChanges, "position") && !position.IsNull() {
+		state.Position = position
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ObjectTriggerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ObjectTriggerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The planned position of an object trigger that does not configure one is the prior state,
+	// which Zendesk may have renumbered since, so only the configured one is sent.
+	var configured, prior types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("position"), &configured)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("position"), &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(plan.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Object Trigger ID",
+			fmt.Sprintf("Could not parse object trigger ID: %v", err),
+		)
+		return
+	}
+
+	position := rulePosition(configured, prior, plan.IgnoreServerChanges)
+	trigger, err := r.client.UpdateObjectTrigger(ctx, plan.ObjectKey.ValueString(), id, expandObjectTrigger(plan, position))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Object Trigger",
+			fmt.Sprintf("Could not update object trigger: %v", err),
+		)
+		return
+	}
+
+	planned := plan.Position
+	flattenObjectTrigger(trigger, &plan)
+	if position == nil && !planned.IsUnknown() {
+		plan.Position = planned
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ObjectTriggerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ObjectTriggerResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Object Trigger ID",
+			fmt.Sprintf("Could not parse object trigger ID: %v", err),
+		)
+		return
+	}
+
+	if deactivateOnDelete(r.client, state.DeactivateOnDelete) {
+		trigger := expandObjectTrigger(state, nil)
+		trigger.Active = false
+
+		_, err = r.client.UpdateObjectTrigger(ctx, state.ObjectKey.ValueString(), id, trigger)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Deactivating Object Trigger",
+				fmt.Sprintf("Could not deactivate object trigger: %v", err),
+			)
+		}
+		return
+	}
+
+	err = r.client.DeleteObjectTrigger(ctx, state.ObjectKey.ValueString(), id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Object Trigger",
+			fmt.Sprintf("Could not delete object trigger: %v", err),
+		)
+		return
+	}
+}
+
+func (r *ObjectTriggerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := splitImportID(req.ID, "object_key", "trigger_id")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("object_key"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
+
+// standardObjectFields are the fields every custom object record has besides its custom fields.
+var standardObjectFields = map[string]bool{
+	"name":        true,
+	"external_id": true,
+	"created_at":  true,
+	"updated_at":  true,
+}
+
+// objectFieldDiagnostics warns about the condition fields that are neither a standard field nor
+// one of keys, the field keys of the custom object. Fields may be qualified, e.g.
+// custom_object.car.custom_fields.color, in which case the last segment is checked. They are
+// warnings because the set of condition fields Zendesk accepts may be larger than the fields of
+// the object.
+func objectFieldDiagnostics(objectKey string, conditions *RuleConditionsModel, keys map[string]bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	check := func(name string, models []RuleConditionModel) {
+		for i, condition := range models {
+			if condition.Field.IsNull() || condition.Field.IsUnknown() {
+				continue
+			}
+
+			field := condition.Field.ValueString()
+			key := field
+			if dot := strings.LastIndex(field, "."); dot >= 0 {
+				key = field[dot+1:]
+			}
+			if keys[key] || standardObjectFields[key] {
+				continue
+			}
+
+			diags.AddAttributeWarning(
+				path.Root("conditions").AtName(name).AtListIndex(i).AtName("field"),
+				"Unknown Object Field",
+				fmt.Sprintf("%q is not a field of custom object %q. Zendesk rejects conditions on fields the object does not have.", field, objectKey),
+			)
+		}
+	}
+	check("all", conditions.All)
+	check("any", conditions.Any)
+
+	return diags
+}
+
+func expandObjectTrigger(model ObjectTriggerResourceModel, position *int64) ObjectTrigger {
+	return ObjectTrigger{
+		Title:       model.Title.ValueString(),
+		Active:      model.Active.ValueBool(),
+		Description: model.Description.ValueString(),
+		Position:    position,
+		Conditions:  expandRuleConditions(model.Conditions),
+		Actions:     expandRuleActions(model.Actions),
+	}
+}
+
+func flattenObjectTrigger(trigger *ObjectTrigger, model *ObjectTriggerResourceModel) {
+	model.ID = types.StringValue(strconv.FormatInt(trigger.ID, 10))
+	model.Title = types.StringValue(trigger.Title)
+	model.Active = types.BoolValue(trigger.Active)
+	if trigger.Description != "" || !model.Description.IsNull() {
+		model.Description = types.StringValue(trigger.Description)
+	}
+	model.Position = types.Int64Value(positionValue(trigger.Position))
+	model.Conditions = flattenRuleConditions(trigger.Conditions, model.Conditions)
+	model.Actions = flattenRuleActions(trigger.Actions, model.Actions)
+}
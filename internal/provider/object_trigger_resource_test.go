@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestObjectFieldDiagnostics(t *testing.T) {
+	condition := func(field string) RuleConditionModel {
+		return RuleConditionModel{Field: types.StringValue(field), Operator: types.StringValue("is")}
+	}
+
+	conditions := &RuleConditionsModel{
+		All: []RuleConditionModel{
+			condition("color"),
+			condition("custom_object.car.custom_fields.model"),
+			condition("name"),
+		},
+		Any: []RuleConditionModel{
+			condition("colour"),
+			{Field: types.StringUnknown()},
+		},
+	}
+
+	diags := objectFieldDiagnostics("car", conditions, map[string]bool{"color": true, "model": true})
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+
+	warnings := diags.Warnings()
+	if len(warnings) != 1 {
+		t.Fatalf("got %d warnings, want 1: %v", len(warnings), warnings)
+	}
+
+	want := path.Root("conditions").AtName("any").AtListIndex(0).AtName("field")
+	if got := warnings[0].(diag.DiagnosticWithPath).Path(); !got.Equal(want) {
+		t.Errorf("warning path = %s, want %s", got, want)
+	}
+}
+
+func TestObjectTriggerPositionRenumberedIgnored(t *testing.T) {
+	p := newProtocolTest(t, "object_trigger_position_renumbered_ignored.json")
+
+	state := p.read("zendesk_object_trigger", `{
+		"id": "1000001", "object_key": "device", "title": "Flag retired devices", "active": true, "position": 3,
+		"conditions": {"all": [{"field": "custom_object.device.custom_fields.status", "operator": "is", "value": "retired"}]},
+		"actions": [{"field": "custom_object.device.custom_fields.flagged", "value": "true"}],
+		"ignore_server_changes": ["position"]
+	}`)
+
+	p.planUnchanged("zendesk_object_trigger", `{
+		"object_key": "device", "title": "Flag retired devices", "position": 3,
+		"conditions": {"all": [{"field": "custom_object.device.custom_fields.status", "operator": "is", "value": "retired"}]},
+		"actions": [{"field": "custom_object.device.custom_fields.flagged", "value": "true"}],
+		"ignore_server_changes": ["position"]
+	}`, state)
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// positionClaims records during a plan which rules are ordered by an order resource, such as
+// zendesk_trigger_order, and which set their own position. Both sides register and check, so
+// whichever is planned second warns about the conflict.
+type positionClaims struct {
+	mu         sync.Mutex
+	ordered    map[string]bool
+	positioned map[string]bool
+}
+
+// claimOrder records that an order resource orders the rule, and reports whether the rule
+// sets its own position.
+func (p *positionClaims) claimOrder(kind, id string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.ordered == nil {
+		p.ordered = map[string]bool{}
+	}
+	p.ordered[kind+"/"+id] = true
+	return p.positioned[kind+"/"+id]
+}
+
+// claimPosition records that the rule sets its own position, and reports whether an order
+// resource orders it.
+func (p *positionClaims) claimPosition(kind, id string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.positioned == nil {
+		p.positioned = map[string]bool{}
+	}
+	p.positioned[kind+"/"+id] = true
+	return p.ordered[kind+"/"+id]
+}
+
+// positionConflictWarning is the warning of positionClaims, on the attribute at attr.
+func positionConflictWarning(attr path.Path, kind, id, orderResource string) diag.Diagnostic {
+	return diag.NewAttributeWarningDiagnostic(
+		attr,
+		"Position Managed Twice",
+		fmt.Sprintf("The %s %s sets its position and is also ordered by %s. Omit position on the %s so that the two do not keep reordering each other.", kind, id, orderResource, kind),
+	)
+}
+
+// duplicateIDDiagnostics reports the IDs of the list at attr already in seen, which maps the IDs
+// of an order to where they are first listed. Orders made of several lists share seen.
+func duplicateIDDiagnostics(attr path.Path, ids []types.String, seen map[string]path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for i, id := range ids {
+		if id.IsUnknown() {
+			continue
+		}
+		if first, ok := seen[id.ValueString()]; ok {
+			diags.AddAttributeError(
+				attr.AtListIndex(i),
+				"Duplicate ID",
+				fmt.Sprintf("ID %s is listed more than once; it is first listed at %s.", id.ValueString(), first),
+			)
+			continue
+		}
+		seen[id.ValueString()] = attr.AtListIndex(i)
+	}
+	return diags
+}
@@ -0,0 +1,242 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                     = &OrganizationDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &OrganizationDataSource{}
+)
+
+func NewOrganizationDataSource() datasource.DataSource {
+	return &OrganizationDataSource{}
+}
+
+type OrganizationDataSource struct {
+	client *Client
+}
+
+// OrganizationModel holds the organization attributes exported by the organization data sources.
+type OrganizationModel struct {
+	ID                 types.String   `tfsdk:"id"`
+	Name               types.String   `tfsdk:"name"`
+	ExternalID         types.String   `tfsdk:"external_id"`
+	DomainNames        []types.String `tfsdk:"domain_names"`
+	Tags               []types.String `tfsdk:"tags"`
+	GroupID            types.String   `tfsdk:"group_id"`
+	SharedTickets      types.Bool     `tfsdk:"shared_tickets"`
+	OrganizationFields types.Map      `tfsdk:"organization_fields"`
+}
+
+// organizationAttributes returns the schema of the exported organization attributes. When
+// lookup is true, id, name and external_id can also be set to search for the organization.
+func organizationAttributes(lookup bool) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the organization.",
+			Optional:    lookup,
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "The name of the organization.",
+			Optional:    lookup,
+			Computed:    true,
+		},
+		"external_id": schema.StringAttribute{
+			Description: "The external ID of the organization.",
+			Optional:    lookup,
+			Computed:    true,
+		},
+		"domain_names": schema.ListAttribute{
+			Description: "The domain names associated with the organization.",
+			Computed:    true,
+			ElementType: types.StringType,
+		},
+		"tags": schema.ListAttribute{
+			Description: "The tags of the organization.",
+			Computed:    true,
+			ElementType: types.StringType,
+		},
+		"group_id": schema.StringAttribute{
+			Description: "The ID of the group new tickets from the organization are assigned to, if any.",
+			Computed:    true,
+		},
+		"shared_tickets": schema.BoolAttribute{
+			Description: "Whether end users in the organization can see each other's tickets.",
+			Computed:    true,
+		},
+		"organization_fields": schema.MapAttribute{
+			Description: "The values of the organization's custom fields, keyed by field key.",
+			Computed:    true,
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func flattenOrganization(ctx context.Context, organization *Organization) (OrganizationModel, diag.Diagnostics) {
+	fields, diags := stringMapValue(ctx, organization.OrganizationFields)
+
+	return OrganizationModel{
+		ID:                 types.StringValue(strconv.FormatInt(organization.ID, 10)),
+		Name:               types.StringValue(organization.Name),
+		ExternalID:         types.StringValue(organization.ExternalID),
+		DomainNames:        stringListValue(organization.DomainNames),
+		Tags:               stringListValue(organization.Tags),
+		GroupID:            optionalIDValue(organization.GroupID),
+		SharedTickets:      types.BoolValue(organization.SharedTickets),
+		OrganizationFields: fields,
+	}, diags
+}
+
+func (d *OrganizationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization"
+}
+
+func (d *OrganizationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a Zendesk organization by ID, external ID, or exact name.",
+		Attributes:  organizationAttributes(true),
+	}
+}
+
+func (d *OrganizationDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("external_id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *OrganizationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *OrganizationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config OrganizationModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		organization *Organization
+		candidates   []Organization
+		attr         path.Path
+		key, value   string
+		err          error
+	)
+
+	switch {
+	case !config.ID.IsNull():
+		id, err := strconv.ParseInt(config.ID.ValueString(), 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Error Parsing Organization ID",
+				fmt.Sprintf("Could not parse organization ID: %v", err),
+			)
+			return
+		}
+
+		organization, err = d.client.ReadOrganization(ctx, id)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Organization",
+				fmt.Sprintf("Could not read organization: %v", err),
+			)
+			return
+		}
+
+		if organization == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Organization Not Found",
+				fmt.Sprintf("No organization found with id %q.", config.ID.ValueString()),
+			)
+			return
+		}
+	case !config.ExternalID.IsNull():
+		attr, key, value = path.Root("external_id"), "external_id", config.ExternalID.ValueString()
+		candidates, err = d.client.SearchOrganizationsByExternalID(ctx, value)
+	default:
+		attr, key, value = path.Root("name"), "name", config.Name.ValueString()
+		candidates, err = d.client.AutocompleteOrganizations(ctx, value)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Searching Organizations",
+			fmt.Sprintf("Could not search organizations: %v", err),
+		)
+		return
+	}
+
+	if organization == nil {
+		// Autocomplete matches on prefixes, so only keep exact matches.
+		var found []Organization
+		for _, o := range candidates {
+			if (key == "name" && strings.EqualFold(o.Name, value)) || (key == "external_id" && o.ExternalID == value) {
+				found = append(found, o)
+			}
+		}
+
+		switch len(found) {
+		case 0:
+			resp.Diagnostics.AddAttributeError(
+				attr,
+				"Organization Not Found",
+				fmt.Sprintf("No organization found with %s %q.", key, value),
+			)
+			return
+		case 1:
+			organization = &found[0]
+		default:
+			ids := make([]string, 0, len(found))
+			for _, o := range found {
+				ids = append(ids, strconv.FormatInt(o.ID, 10))
+			}
+			resp.Diagnostics.AddAttributeError(
+				attr,
+				"Multiple Organizations Found",
+				fmt.Sprintf("Found %d organizations with %s %q (IDs: %s). Look the organization up by id instead.", len(found), key, value, strings.Join(ids, ", ")),
+			)
+			return
+		}
+	}
+
+	state, diags := flattenOrganization(ctx, organization)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,12 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+func NewOrganizationFieldsDataSource() datasource.DataSource {
+	return &KeyedFieldsDataSource{
+		kind: "organization",
+		list: (*Client).ListOrganizationFields,
+	}
+}
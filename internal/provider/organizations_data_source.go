@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// organizationsMaxResults caps how many organizations are fetched, so very large accounts
+// do not turn a refresh into thousands of requests.
+const organizationsMaxResults = 10000
+
+var (
+	_ datasource.DataSource = &OrganizationsDataSource{}
+)
+
+func NewOrganizationsDataSource() datasource.DataSource {
+	return &OrganizationsDataSource{}
+}
+
+type OrganizationsDataSource struct {
+	client *Client
+}
+
+type OrganizationsDataSourceModel struct {
+	Tag           types.String        `tfsdk:"tag"`
+	DomainName    types.String        `tfsdk:"domain_name"`
+	Organizations []OrganizationModel `tfsdk:"organizations"`
+}
+
+func (d *OrganizationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organizations"
+}
+
+func (d *OrganizationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Lists Zendesk organizations, optionally filtered by tag and/or domain name, sorted by name. At most %d organizations are fetched; a warning is emitted when the cap is reached.", organizationsMaxResults),
+		Attributes: map[string]schema.Attribute{
+			"tag": schema.StringAttribute{
+				Description: "Only return organizations with this tag.",
+				Optional:    true,
+			},
+			"domain_name": schema.StringAttribute{
+				Description: "Only return organizations associated with this domain name.",
+				Optional:    true,
+			},
+			"organizations": schema.ListNestedAttribute{
+				Description: "The matching organizations.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: organizationAttributes(false),
+				},
+			},
+		},
+	}
+}
+
+func (d *OrganizationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *OrganizationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config OrganizationsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tags can be searched server-side; domain names are filtered client-side.
+	listPath, key := "/api/v2/organizations.json?page[size]=100", "organizations"
+	if !config.Tag.IsNull() {
+		query := fmt.Sprintf("type:organization tags:%q", config.Tag.ValueString())
+		listPath, key = "/api/v2/search.json?"+url.Values{"query": []string{query}}.Encode(), "results"
+	}
+
+	organizations, truncated, err := d.client.ListOrganizations(ctx, listPath, key, organizationsMaxResults)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Organizations",
+			fmt.Sprintf("Could not list organizations: %v", err),
+		)
+		return
+	}
+
+	if truncated {
+		resp.Diagnostics.AddWarning(
+			"Organizations Result Truncated",
+			fmt.Sprintf("Only the first %d organizations were fetched. Narrow the filters to get a complete result.", organizationsMaxResults),
+		)
+	}
+
+	sort.SliceStable(organizations, func(i, j int) bool {
+		if organizations[i].Name != organizations[j].Name {
+			return organizations[i].Name < organizations[j].Name
+		}
+		return organizations[i].ID < organizations[j].ID
+	})
+
+	config.Organizations = make([]OrganizationModel, 0, len(organizations))
+	for i := range organizations {
+		organization := &organizations[i]
+
+		if !config.Tag.IsNull() && !containsFold(organization.Tags, config.Tag.ValueString()) {
+			continue
+		}
+		if !config.DomainName.IsNull() && !containsFold(organization.DomainNames, config.DomainName.ValueString()) {
+			continue
+		}
+
+		model, diags := flattenOrganization(ctx, organization)
+		resp.Diagnostics.Append(diags...)
+		config.Organizations = append(config.Organizations, model)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -1,158 +1,294 @@
-package provider
-
-import (
-	"context"
-	"os"
-
-	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/path"
-	"github.com/hashicorp/terraform-plugin-framework/provider"
-	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource"
-	"github.com/hashicorp/terraform-plugin-framework/types"
-)
-
-var _ provider.Provider = &ZendeskProvider{}
-
-type ZendeskProvider struct {
-	version string
-}
-
-type ZendeskProviderModel struct {
-	Subdomain types.String `tfsdk:"subdomain"`
-	Email     types.String `tfsdk:"email"`
-	APIToken  types.String `tfsdk:"api_token"`
-}
-
-func New(version string) func() provider.Provider {
-	return func() provider.Provider {
-		return &ZendeskProvider{
-			version: version,
-		}
-	}
-}
-
-func (p *ZendeskProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
-	resp.TypeName = "zendesk"
-	resp.Version = p.version
-}
-
-func (p *ZendeskProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		Description: "Interact with Zendesk.",
-		Attributes: map[string]schema.Attribute{
-			"subdomain": schema.StringAttribute{
-				Description: "The Zendesk subdomain (e.g., company in company.zendesk.com)",
-				Required:    true,
-			},
-			"email": schema.StringAttribute{
-				Description: "The email address associated with the Zendesk account",
-				Required:    true,
-			},
-			"api_token": schema.StringAttribute{
-				Description: "The API token for authentication",
-				Required:    true,
-				Sensitive:   true,
-			},
-		},
-	}
-}
-
-func (p *ZendeskProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
-	var config ZendeskProviderModel
-	diags := req.Config.Get(ctx, &config)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	if config.Subdomain.IsUnknown() {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("subdomain"),
-			"Unknown Zendesk subdomain",
-			"The provider cannot create the Zendesk API client as the subdomain is unknown.",
-		)
-	}
-
-	if config.Email.IsUnknown() {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("email"),
-			"Unknown Zendesk email",
-			"The provider cannot create the Zendesk API client as the email is unknown.",
-		)
-	}
-
-	if config.APIToken.IsUnknown() {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("api_token"),
-			"Unknown Zendesk API token",
-			"The provider cannot create the Zendesk API client as the API token is unknown.",
-		)
-	}
-
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	subdomain := os.Getenv("ZENDESK_SUBDOMAIN")
-	email := os.Getenv("ZENDESK_EMAIL")
-	apiToken := os.Getenv("ZENDESK_API_TOKEN")
-
-	if !config.Subdomain.IsNull() {
-		subdomain = config.Subdomain.ValueString()
-	}
-
-	if !config.Email.IsNull() {
-		email = config.Email.ValueString()
-	}
-
-	if !config.APIToken.IsNull() {
-		apiToken = config.APIToken.ValueString()
-	}
-
-	if subdomain == "" {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("subdomain"),
-			"Missing Zendesk subdomain",
-			"The provider cannot create the Zendesk API client as the subdomain is missing.",
-		)
-	}
-
-	if email == "" {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("email"),
-			"Missing Zendesk email",
-			"The provider cannot create the Zendesk API client as the email is missing.",
-		)
-	}
-
-	if apiToken == "" {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("api_token"),
-			"Missing Zendesk API token",
-			"The provider cannot create the Zendesk API client as the API token is missing.",
-		)
-	}
-
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	// TODO: Create Zendesk client
-	// client := NewClient(subdomain, email, apiToken)
-	// resp.DataSourceData = client
-	// resp.ResourceData = client
-}
-
-func (p *ZendeskProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{
-		// Add data sources here
-	}
-}
-
-func (p *ZendeskProvider) Resources(_ context.Context) []func() resource.Resource {
-	return []func() resource.Resource{
-		NewOAuthClientResource,
-		NewOAuthTokenResource,
-	}
-} 
\ No newline at end of file
+package provider
+
+import (
+	"context"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ provider.Provider              = &ZendeskProvider{}
+	_ provider.ProviderWithFunctions = &ZendeskProvider{}
+)
+
+type ZendeskProvider struct {
+	version string
+}
+
+type ZendeskProviderModel struct {
+	Subdomain            types.String `tfsdk:"subdomain"`
+	Email                types.String `tfsdk:"email"`
+	APIToken             types.String `tfsdk:"api_token"`
+	DeactivateOnDelete   types.Bool   `tfsdk:"deactivate_on_delete"`
+	ValidatePlaceholders types.Bool   `tfsdk:"validate_placeholders"`
+	ValidateReferences   types.Bool   `tfsdk:"validate_references"`
+	MaxRetries           types.Int64  `tfsdk:"max_retries"`
+}
+
+func New(version string) func() provider.Provider {
+	return func() provider.Provider {
+		return &ZendeskProvider{
+			version: version,
+		}
+	}
+}
+
+func (p *ZendeskProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "zendesk"
+	resp.Version = p.version
+}
+
+func (p *ZendeskProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	// The schema is served next to the one of the SDK provider in internal/sdkprovider, which must
+	// be identical. SDK providers cannot set a top-level description, so neither does this one.
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"subdomain": schema.StringAttribute{
+				Description: "The Zendesk subdomain (e.g., company in company.zendesk.com)",
+				Required:    true,
+			},
+			"email": schema.StringAttribute{
+				Description: "The email address associated with the Zendesk account",
+				Required:    true,
+			},
+			"api_token": schema.StringAttribute{
+				Description: "The API token for authentication",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"deactivate_on_delete": schema.BoolAttribute{
+				Description: "Whether destroying triggers, automations, macros and object triggers deactivates them instead of deleting them, keeping their audit history. Resources can override it with their own deactivate_on_delete argument. Defaults to false.",
+				Optional:    true,
+			},
+			"validate_placeholders": schema.BoolAttribute{
+				Description: "Whether to check the Liquid placeholders of notification bodies and macro comments at plan time against the catalog of Zendesk placeholders. Malformed placeholders are errors and unknown ones warnings. Defaults to false.",
+				Optional:    true,
+			},
+			"validate_references": schema.BoolAttribute{
+				Description: "Whether to check at plan time that the fields referenced by the conditions of object triggers exist on their custom object, which costs one API request per object trigger. Unknown fields are warnings. Defaults to false.",
+				Optional:    true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "The number of times a request rejected by the Zendesk rate limit (429 Too Many Requests) is retried, waiting for the delay given by its Retry-After header, before failing. Defaults to 5.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+		},
+	}
+}
+
+func (p *ZendeskProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var config ZendeskProviderModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// When a credential comes from another resource that is not created yet, defer the resources
+	// and data sources of this provider to a later plan instead of failing, if Terraform allows it.
+	if config.Subdomain.IsUnknown() || config.Email.IsUnknown() || config.APIToken.IsUnknown() {
+		if req.ClientCapabilities.DeferralAllowed {
+			resp.Deferred = &provider.Deferred{
+				Reason: provider.DeferredReasonProviderConfigUnknown,
+			}
+			return
+		}
+	}
+
+	if config.Subdomain.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("subdomain"),
+			"Unknown Zendesk subdomain",
+			"The provider cannot create the Zendesk API client as the subdomain is unknown.",
+		)
+	}
+
+	if config.Email.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("email"),
+			"Unknown Zendesk email",
+			"The provider cannot create the Zendesk API client as the email is unknown.",
+		)
+	}
+
+	if config.APIToken.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_token"),
+			"Unknown Zendesk API token",
+			"The provider cannot create the Zendesk API client as the API token is unknown.",
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subdomain := os.Getenv("ZENDESK_SUBDOMAIN")
+	email := os.Getenv("ZENDESK_EMAIL")
+	apiToken := os.Getenv("ZENDESK_API_TOKEN")
+
+	if !config.Subdomain.IsNull() {
+		subdomain = config.Subdomain.ValueString()
+	}
+
+	if !config.Email.IsNull() {
+		email = config.Email.ValueString()
+	}
+
+	if !config.APIToken.IsNull() {
+		apiToken = config.APIToken.ValueString()
+	}
+
+	if subdomain == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("subdomain"),
+			"Missing Zendesk subdomain",
+			"The provider cannot create the Zendesk API client as the subdomain is missing.",
+		)
+	}
+
+	if email == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("email"),
+			"Missing Zendesk email",
+			"The provider cannot create the Zendesk API client as the email is missing.",
+		)
+	}
+
+	if apiToken == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_token"),
+			"Missing Zendesk API token",
+			"The provider cannot create the Zendesk API client as the API token is missing.",
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := NewClientWithOptions(subdomain, email, apiToken, ClientOptions{
+		DeactivateOnDelete:   config.DeactivateOnDelete.ValueBool(),
+		ValidatePlaceholders: config.ValidatePlaceholders.ValueBool(),
+		ValidateReferences:   config.ValidateReferences.ValueBool(),
+		MaxRetries:           config.MaxRetries.ValueInt64Pointer(),
+	})
+	resp.DataSourceData = client
+	resp.ResourceData = client
+}
+
+func (p *ZendeskProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{
+		NewOAuthClientDataSource,
+		NewOAuthClientsDataSource,
+		NewOAuthTokensDataSource,
+		NewCurrentUserDataSource,
+		NewUserDataSource,
+		NewUsersDataSource,
+		NewGroupDataSource,
+		NewGroupsDataSource,
+		NewOrganizationDataSource,
+		NewOrganizationsDataSource,
+		NewTicketFieldDataSource,
+		NewTicketFieldsDataSource,
+		NewTicketFormDataSource,
+		NewBrandDataSource,
+		NewBrandsDataSource,
+		NewTriggerDataSource,
+		NewTriggersDataSource,
+		NewTriggerCategoriesDataSource,
+		NewAutomationsDataSource,
+		NewMacrosDataSource,
+		NewViewsDataSource,
+		NewSLAPoliciesDataSource,
+		NewCustomRolesDataSource,
+		NewSchedulesDataSource,
+		NewWebhooksDataSource,
+		NewCustomStatusesDataSource,
+		NewCustomObjectsDataSource,
+		NewCustomObjectFieldsDataSource,
+		NewCustomObjectRecordsDataSource,
+		NewSearchDataSource,
+		NewAuditLogsDataSource,
+		NewAccountSettingsDataSource,
+		NewHCCategoriesDataSource,
+		NewHCSectionsDataSource,
+		NewHCArticlesDataSource,
+		NewHCUserSegmentsDataSource,
+		NewHCPermissionGroupsDataSource,
+		NewUserFieldsDataSource,
+		NewOrganizationFieldsDataSource,
+		NewRoutingAttributesDataSource,
+		NewQueuesDataSource,
+		NewSharingAgreementsDataSource,
+		NewDynamicContentItemsDataSource,
+		NewAccountDataSource,
+		NewRateLimitDataSource,
+	}
+}
+
+func (p *ZendeskProvider) Resources(_ context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		NewOAuthClientResource,
+		NewOAuthTokenResource,
+		NewCustomObjectRecordsBatchResource,
+		NewObjectTriggerResource,
+		NewTriggerResource,
+		NewTicketFormResource,
+		NewUserResource,
+		NewMacroResource,
+		NewAutomationResource,
+		NewSLAPolicyResource,
+		NewBrandResource,
+		NewCustomRoleResource,
+		NewTicketFieldOptionResource,
+		NewDynamicContentItemResource,
+		NewScheduleResource,
+		NewScheduleHolidayResource,
+		NewTargetResource,
+		NewRoutingAttributeResource,
+		NewRoutingAttributeValueResource,
+		NewHelpCenterCategoryResource,
+		NewHelpCenterSectionResource,
+		NewHelpCenterArticleResource,
+		NewHelpCenterArticleTranslationResource,
+		NewHelpCenterPermissionGroupResource,
+		NewHelpCenterUserSegmentResource,
+		NewContentTagResource,
+		NewAccountSettingResource,
+		NewAPITokenResource,
+		NewDeletionScheduleResource,
+		NewQueueResource,
+		NewAgentStatusResource,
+		NewGroupSLAPolicyResource,
+		NewTriggerOrderResource,
+		NewAutomationOrderResource,
+		NewSLAPolicyOrderResource,
+		NewTicketFormOrderResource,
+		NewBrandAgentResource,
+		NewMacroAttachmentResource,
+		NewUserIdentityResource,
+		NewTicketResource,
+	}
+}
+
+func (p *ZendeskProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewAgentTicketURLFunction,
+		NewAdminObjectURLFunction,
+		NewDCPlaceholderFunction,
+		NewLiquidEscapeFunction,
+	}
+}
@@ -0,0 +1,343 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testAccProtoV6ProviderFactories serves the provider to the acceptance tests, which run against
+// the account given by ZENDESK_SUBDOMAIN, ZENDESK_EMAIL and ZENDESK_API_TOKEN when TF_ACC is set.
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"zendesk": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+func testAccPreCheck(t *testing.T) {
+	t.Helper()
+
+	for _, name := range []string{"ZENDESK_SUBDOMAIN", "ZENDESK_EMAIL", "ZENDESK_API_TOKEN"} {
+		if os.Getenv(name) == "" {
+			t.Fatalf("%s must be set for acceptance tests", name)
+		}
+	}
+}
+
+// testAccProviderConfig returns a provider block for the acceptance test account with extra
+// arguments. The credentials are required arguments, so they are set from the environment.
+func testAccProviderConfig(arguments string) string {
+	return fmt.Sprintf(`
+provider "zendesk" {
+  subdomain = %[1]q
+  email     = %[2]q
+  api_token = %[3]q
+  %[4]s
+}
+`, os.Getenv("ZENDESK_SUBDOMAIN"), os.Getenv("ZENDESK_EMAIL"), os.Getenv("ZENDESK_API_TOKEN"), arguments)
+}
+
+// testAccClient returns a client for the acceptance test account, to check resources outside
+// Terraform.
+func testAccClient() *Client {
+	return NewClient(os.Getenv("ZENDESK_SUBDOMAIN"), os.Getenv("ZENDESK_EMAIL"), os.Getenv("ZENDESK_API_TOKEN"))
+}
+
+// protocolTest drives the provider through its protocol server, as Terraform does, replaying the
+// interactions of a fixture in testdata instead of calling the API.
+type protocolTest struct {
+	t       *testing.T
+	server  tfprotov6.ProviderServer
+	schemas map[string]*tfprotov6.Schema
+}
+
+func newProtocolTest(t *testing.T, fixture string) *protocolTest {
+	t.Helper()
+
+	// The states these tests start from refer to the IDs of the fixture, so the fixture is
+	// always replayed, even when recording. Tests that make no requests pass no fixture.
+	if fixture != "" {
+		fixture = filepath.Join("testdata", fixture)
+	}
+	t.Setenv("ZENDESK_TEST_FIXTURES", fixture)
+	t.Setenv("ZENDESK_TEST_RECORD", "")
+
+	ctx := context.Background()
+	server := providerserver.NewProtocol6(New("test")())()
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema: %v", err)
+	}
+	checkProtocolDiagnostics(t, schemaResp.Diagnostics)
+
+	configureResp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{
+		Config: &tfprotov6.DynamicValue{JSON: []byte(`{"subdomain": "example", "email": "agent@example.com", "api_token": "token"}`)},
+	})
+	if err != nil {
+		t.Fatalf("ConfigureProvider: %v", err)
+	}
+	checkProtocolDiagnostics(t, configureResp.Diagnostics)
+
+	return &protocolTest{t: t, server: server, schemas: schemaResp.ResourceSchemas}
+}
+
+// validate validates the configuration of a resource, given as JSON in which missing attributes
+// are null, and returns the diagnostics.
+func (p *protocolTest) validate(typeName, config string) []*tfprotov6.Diagnostic {
+	p.t.Helper()
+
+	resp, err := p.server.ValidateResourceConfig(context.Background(), &tfprotov6.ValidateResourceConfigRequest{
+		TypeName: typeName,
+		Config:   &tfprotov6.DynamicValue{JSON: []byte(config)},
+	})
+	if err != nil {
+		p.t.Fatalf("ValidateResourceConfig: %v", err)
+	}
+	return resp.Diagnostics
+}
+
+// read refreshes a resource from its state, given as JSON in which missing attributes are null.
+func (p *protocolTest) read(typeName, state string) *tfprotov6.DynamicValue {
+	p.t.Helper()
+
+	return p.refresh(typeName, &tfprotov6.DynamicValue{JSON: []byte(state)})
+}
+
+// refresh refreshes a resource from its state, and returns the new state.
+func (p *protocolTest) refresh(typeName string, state *tfprotov6.DynamicValue) *tfprotov6.DynamicValue {
+	p.t.Helper()
+
+	resp, err := p.server.ReadResource(context.Background(), &tfprotov6.ReadResourceRequest{
+		TypeName:     typeName,
+		CurrentState: state,
+	})
+	if err != nil {
+		p.t.Fatalf("ReadResource: %v", err)
+	}
+	checkProtocolDiagnostics(p.t, resp.Diagnostics)
+
+	return resp.NewState
+}
+
+// plan plans a resource from its prior state and its configuration, given as JSON in which
+// missing attributes are null. A nil prior state plans a create, and an empty configuration a
+// destroy.
+func (p *protocolTest) plan(typeName, config string, prior *tfprotov6.DynamicValue) *tfprotov6.PlanResourceChangeResponse {
+	p.t.Helper()
+
+	resp := p.planResponse(typeName, config, prior)
+	checkProtocolDiagnostics(p.t, resp.Diagnostics)
+
+	return resp
+}
+
+// planDiagnostics plans a resource, as plan does, and returns the diagnostics instead of failing
+// the test on errors.
+func (p *protocolTest) planDiagnostics(typeName, config string, prior *tfprotov6.DynamicValue) []*tfprotov6.Diagnostic {
+	p.t.Helper()
+
+	return p.planResponse(typeName, config, prior).Diagnostics
+}
+
+func (p *protocolTest) planResponse(typeName, config string, prior *tfprotov6.DynamicValue) *tfprotov6.PlanResourceChangeResponse {
+	p.t.Helper()
+
+	if prior == nil {
+		prior = p.null(typeName)
+	}
+	configValue := p.config(typeName, config)
+
+	resp, err := p.server.PlanResourceChange(context.Background(), &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         typeName,
+		PriorState:       prior,
+		ProposedNewState: p.proposedNewState(typeName, prior, configValue),
+		Config:           configValue,
+	})
+	if err != nil {
+		p.t.Fatalf("PlanResourceChange: %v", err)
+	}
+
+	return resp
+}
+
+// planUnchanged plans a resource whose configuration matches its refreshed state, and checks
+// that the plan is empty.
+func (p *protocolTest) planUnchanged(typeName, config string, prior *tfprotov6.DynamicValue) {
+	p.t.Helper()
+
+	resp := p.plan(typeName, config, prior)
+
+	priorValue := p.value(typeName, prior)
+	plannedValue := p.value(typeName, resp.PlannedState)
+	if !plannedValue.Equal(priorValue) {
+		diffs, _ := priorValue.Diff(plannedValue)
+		p.t.Errorf("expected an empty plan, got changes to %v", diffs)
+	}
+	if len(resp.RequiresReplace) > 0 {
+		p.t.Errorf("expected no replacement, got %v", resp.RequiresReplace)
+	}
+}
+
+// apply plans and applies a change to a resource, as plan does, and returns its new state.
+func (p *protocolTest) apply(typeName, config string, prior *tfprotov6.DynamicValue) *tfprotov6.DynamicValue {
+	p.t.Helper()
+
+	resp := p.applyResponse(typeName, config, prior)
+	checkProtocolDiagnostics(p.t, resp.Diagnostics)
+
+	return resp.NewState
+}
+
+// applyDiagnostics applies a change to a resource, as apply does, and returns the diagnostics
+// instead of failing the test on errors.
+func (p *protocolTest) applyDiagnostics(typeName, config string, prior *tfprotov6.DynamicValue) []*tfprotov6.Diagnostic {
+	p.t.Helper()
+
+	return p.applyResponse(typeName, config, prior).Diagnostics
+}
+
+func (p *protocolTest) applyResponse(typeName, config string, prior *tfprotov6.DynamicValue) *tfprotov6.ApplyResourceChangeResponse {
+	p.t.Helper()
+
+	if prior == nil {
+		prior = p.null(typeName)
+	}
+	planned := p.plan(typeName, config, prior)
+
+	resp, err := p.server.ApplyResourceChange(context.Background(), &tfprotov6.ApplyResourceChangeRequest{
+		TypeName:        typeName,
+		PriorState:      prior,
+		PlannedState:    planned.PlannedState,
+		Config:          p.config(typeName, config),
+		PlannedPrivate:  planned.PlannedPrivate,
+		PlannedIdentity: planned.PlannedIdentity,
+	})
+	if err != nil {
+		p.t.Fatalf("ApplyResourceChange: %v", err)
+	}
+
+	return resp
+}
+
+// proposedNewState merges the configuration into the prior state the way Terraform does for
+// top-level attributes: computed attributes left out of the configuration keep their prior
+// value, and all others, and blocks, take the configured one.
+func (p *protocolTest) proposedNewState(typeName string, prior, config *tfprotov6.DynamicValue) *tfprotov6.DynamicValue {
+	p.t.Helper()
+
+	priorValue := p.value(typeName, prior)
+	configValue := p.value(typeName, config)
+	if configValue.IsNull() {
+		return config
+	}
+
+	var priorAttributes, configAttributes map[string]tftypes.Value
+	if !priorValue.IsNull() {
+		if err := priorValue.As(&priorAttributes); err != nil {
+			p.t.Fatalf("failed to decode prior state: %v", err)
+		}
+	}
+	if err := configValue.As(&configAttributes); err != nil {
+		p.t.Fatalf("failed to decode config: %v", err)
+	}
+
+	proposed := map[string]tftypes.Value{}
+	for _, attribute := range p.schemas[typeName].Block.Attributes {
+		proposed[attribute.Name] = configAttributes[attribute.Name]
+		if attribute.Computed && configAttributes[attribute.Name].IsNull() && priorAttributes != nil {
+			proposed[attribute.Name] = priorAttributes[attribute.Name]
+		}
+	}
+	for _, block := range p.schemas[typeName].Block.BlockTypes {
+		proposed[block.TypeName] = configAttributes[block.TypeName]
+	}
+
+	schemaType := p.schemas[typeName].ValueType()
+	value, err := tfprotov6.NewDynamicValue(schemaType, tftypes.NewValue(schemaType, proposed))
+	if err != nil {
+		p.t.Fatalf("failed to encode proposed new state: %v", err)
+	}
+	return &value
+}
+
+// config returns a configuration given as JSON, or a null one when it is empty.
+func (p *protocolTest) config(typeName, config string) *tfprotov6.DynamicValue {
+	if config == "" {
+		return p.null(typeName)
+	}
+	return &tfprotov6.DynamicValue{JSON: []byte(config)}
+}
+
+func (p *protocolTest) null(typeName string) *tfprotov6.DynamicValue {
+	p.t.Helper()
+
+	schemaType := p.schemas[typeName].ValueType()
+	value, err := tfprotov6.NewDynamicValue(schemaType, tftypes.NewValue(schemaType, nil))
+	if err != nil {
+		p.t.Fatalf("failed to encode null %s: %v", typeName, err)
+	}
+	return &value
+}
+
+// attribute returns a top-level attribute of a state or plan of a resource.
+func (p *protocolTest) attribute(typeName string, dynamicValue *tfprotov6.DynamicValue, name string) tftypes.Value {
+	p.t.Helper()
+
+	var attributes map[string]tftypes.Value
+	if err := p.value(typeName, dynamicValue).As(&attributes); err != nil {
+		p.t.Fatalf("failed to decode %s: %v", typeName, err)
+	}
+	return attributes[name]
+}
+
+// value decodes a state or plan of a resource.
+func (p *protocolTest) value(typeName string, dynamicValue *tfprotov6.DynamicValue) tftypes.Value {
+	p.t.Helper()
+
+	schema, ok := p.schemas[typeName]
+	if !ok {
+		p.t.Fatalf("no schema for %s", typeName)
+	}
+
+	value, err := dynamicValue.Unmarshal(schema.ValueType())
+	if err != nil {
+		p.t.Fatalf("failed to decode %s: %v", typeName, err)
+	}
+	return value
+}
+
+// hasProtocolError reports whether diags have an error with summary.
+func hasProtocolError(diags []*tfprotov6.Diagnostic, summary string) bool {
+	for _, d := range diags {
+		if d.Severity == tfprotov6.DiagnosticSeverityError && d.Summary == summary {
+			return true
+		}
+	}
+	return false
+}
+
+func checkProtocolDiagnostics(t *testing.T, diags []*tfprotov6.Diagnostic) {
+	t.Helper()
+
+	for _, d := range diags {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Fatalf("unexpected error: %s: %s", d.Summary, d.Detail)
+		}
+	}
+}
+
+// joinStrings joins string values with commas, for comparing lists of IDs.
+func joinStrings(values []types.String) string {
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		result = append(result, value.ValueString())
+	}
+	return strings.Join(result, ",")
+}
@@ -0,0 +1,351 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &QueueResource{}
+	_ resource.ResourceWithImportState = &QueueResource{}
+)
+
+func NewQueueResource() resource.Resource {
+	return &QueueResource{}
+}
+
+type QueueResource struct {
+	client *Client
+}
+
+type QueueResourceModel struct {
+	ID                types.String          `tfsdk:"id"`
+	Name              types.String          `tfsdk:"name"`
+	Description       types.String          `tfsdk:"description"`
+	Priority          types.Int64           `tfsdk:"priority"`
+	Order             types.Int64           `tfsdk:"order"`
+	Definition        *QueueDefinitionModel `tfsdk:"definition"`
+	PrimaryGroupIDs   []types.String        `tfsdk:"primary_group_ids"`
+	SecondaryGroupIDs []types.String        `tfsdk:"secondary_group_ids"`
+}
+
+type QueueDefinitionModel struct {
+	All []QueueConditionModel `tfsdk:"all"`
+	Any []QueueConditionModel `tfsdk:"any"`
+}
+
+type QueueConditionModel struct {
+	Subject  types.String `tfsdk:"subject"`
+	Operator types.String `tfsdk:"operator"`
+	Value    types.String `tfsdk:"value"`
+}
+
+func (r *QueueResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_queue"
+}
+
+func (r *QueueResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	conditionAttributes := map[string]schema.Attribute{
+		"subject": schema.StringAttribute{
+			Description: "The ticket field to check (e.g., 'priority', 'brand_id', 'custom_fields_123').",
+			Required:    true,
+		},
+		"operator": schema.StringAttribute{
+			Description: "The comparison operator (e.g., 'is', 'is_not', 'includes').",
+			Required:    true,
+		},
+		"value": schema.StringAttribute{
+			Description: "The value to compare against. Use jsonencode() for conditions that take a list of values.",
+			Optional:    true,
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a Zendesk omnichannel routing queue.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the queue, a UUID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the queue.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the queue.",
+				Optional:    true,
+			},
+			"priority": schema.Int64Attribute{
+				Description: "The priority of the work in the queue. Work in higher priority queues is routed first.",
+				Required:    true,
+			},
+			"order": schema.Int64Attribute{
+				Description: "The order in which the queue is evaluated. Work enters the first queue whose definition it matches. Defaults to the last position.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"definition": schema.SingleNestedAttribute{
+				Description: "The conditions a ticket must meet to enter the queue.",
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"all": schema.ListNestedAttribute{
+						Description: "Conditions that must all be met.",
+						Optional:    true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: conditionAttributes,
+						},
+					},
+					"any": schema.ListNestedAttribute{
+						Description: "Conditions of which at least one must be met.",
+						Optional:    true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: conditionAttributes,
+						},
+					},
+				},
+			},
+			"primary_group_ids": schema.SetAttribute{
+				Description: "The IDs of the groups work is routed to first.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"secondary_group_ids": schema.SetAttribute{
+				Description: "The IDs of the groups work is routed to when no primary group agent is available.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *QueueResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *QueueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan QueueResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	queue, diags := expandQueue(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateQueue(ctx, queue)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Queue",
+			fmt.Sprintf("Could not create queue: %v", err),
+		)
+		return
+	}
+
+	flattenQueue(created, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *QueueResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state QueueResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	queue, err := r.client.ReadQueue(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Queue",
+			fmt.Sprintf("Could not read queue: %v", err),
+		)
+		return
+	}
+
+	if queue == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	flattenQueue(queue, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *QueueResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan QueueResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	queue, diags := expandQueue(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateQueue(ctx, plan.ID.ValueString(), queue)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Queue",
+			fmt.Sprintf("Could not update queue: %v", err),
+		)
+		return
+	}
+
+	flattenQueue(updated, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *QueueResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state QueueResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteQueue(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Queue",
+			fmt.Sprintf("Could not delete queue: %v", err),
+		)
+		return
+	}
+}
+
+func (r *QueueResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func expandQueue(model QueueResourceModel) (Queue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	primary, d := expandIDList(model.PrimaryGroupIDs, path.Root("primary_group_ids"))
+	diags.Append(d...)
+	secondary, d := expandIDList(model.SecondaryGroupIDs, path.Root("secondary_group_ids"))
+	diags.Append(d...)
+
+	queue := Queue{
+		Name:              model.Name.ValueString(),
+		Description:       model.Description.ValueString(),
+		Priority:          model.Priority.ValueInt64(),
+		Order:             model.Order.ValueInt64(),
+		PrimaryGroupIDs:   primary,
+		SecondaryGroupIDs: secondary,
+	}
+	if model.Definition != nil {
+		queue.Definition = QueueDefinition{
+			All: expandQueueConditions(model.Definition.All),
+			Any: expandQueueConditions(model.Definition.Any),
+		}
+	} else {
+		queue.Definition = QueueDefinition{All: []QueueCondition{}, Any: []QueueCondition{}}
+	}
+
+	return queue, diags
+}
+
+func expandQueueConditions(models []QueueConditionModel) []QueueCondition {
+	conditions := make([]QueueCondition, 0, len(models))
+	for _, m := range models {
+		condition := QueueCondition{
+			Subject:  m.Subject.ValueString(),
+			Operator: m.Operator.ValueString(),
+		}
+		if !m.Value.IsNull() {
+			condition.Value = expandRuleValue(m.Value.ValueString())
+		}
+		conditions = append(conditions, condition)
+	}
+	return conditions
+}
+
+func flattenQueue(queue *Queue, model *QueueResourceModel) {
+	model.ID = types.StringValue(queue.ID)
+	model.Name = types.StringValue(queue.Name)
+	model.Description = optionalStringValue(queue.Description)
+	model.Priority = types.Int64Value(queue.Priority)
+	model.Order = types.Int64Value(queue.Order)
+	model.PrimaryGroupIDs = idListValue(queue.PrimaryGroups.IDs())
+	model.SecondaryGroupIDs = flattenIDList(queue.SecondaryGroups.IDs(), model.SecondaryGroupIDs)
+
+	prior := model.Definition
+	if prior == nil {
+		prior = &QueueDefinitionModel{}
+	}
+	model.Definition = &QueueDefinitionModel{
+		All: flattenQueueConditions(queue.Definition.All, prior.All),
+		Any: flattenQueueConditions(queue.Definition.Any, prior.Any),
+	}
+}
+
+// flattenQueueConditions is the queue counterpart of flattenRuleConditionList.
+func flattenQueueConditions(conditions []QueueCondition, prior []QueueConditionModel) []QueueConditionModel {
+	if len(conditions) == 0 {
+		if prior != nil {
+			return []QueueConditionModel{}
+		}
+		return nil
+	}
+
+	models := make([]QueueConditionModel, 0, len(conditions))
+	for i, c := range conditions {
+		value := types.StringNull()
+		if i < len(prior) {
+			value = prior[i].Value
+		}
+
+		models = append(models, QueueConditionModel{
+			Subject:  types.StringValue(c.Subject),
+			Operator: types.StringValue(c.Operator),
+			Value:    flattenRuleValue(c.Value, value),
+		})
+	}
+	return models
+}
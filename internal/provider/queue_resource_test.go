@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const testQueueState = `{
+	"id": "01JA4F1E8Q7X2V3C9M5N6B4K2D", "name": "Tier 1", "description": null, "priority": 1, "order": 1,
+	"definition": {"all": [{"subject": "priority", "operator": "is", "value": "urgent"}], "any": null},
+	"primary_group_ids": ["1000001"], "secondary_group_ids": ["1000002"]
+}`
+
+func TestQueueRoundTrip(t *testing.T) {
+	model := QueueResourceModel{
+		Name:        types.StringValue("Tier 1"),
+		Description: types.StringNull(),
+		Priority:    types.Int64Value(1),
+		Order:       types.Int64Unknown(),
+		Definition: &QueueDefinitionModel{
+			All: []QueueConditionModel{
+				{Subject: types.StringValue("priority"), Operator: types.StringValue("is"), Value: types.StringValue("urgent")},
+			},
+			Any: []QueueConditionModel{
+				{Subject: types.StringValue("brand_id"), Operator: types.StringValue("includes"), Value: types.StringValue(`["1000003", "1000004"]`)},
+				{Subject: types.StringValue("via_id"), Operator: types.StringValue("is"), Value: types.StringValue("4")},
+			},
+		},
+		PrimaryGroupIDs:   []types.String{types.StringValue("1000001"), types.StringValue("1000002")},
+		SecondaryGroupIDs: []types.String{types.StringValue("1000005")},
+	}
+
+	queue, diags := expandQueue(model)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	raw, err := json.Marshal(queueWrapper{Queue: queue})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := `{"queue":{"name":"Tier 1","description":"","priority":1,` +
+		`"definition":{"all":[{"subject":"priority","operator":"is","value":"urgent"}],` +
+		`"any":[{"subject":"brand_id","operator":"includes","value":["1000003", "1000004"]},{"subject":"via_id","operator":"is","value":"4"}]},` +
+		`"primary_groups_id":[1000001,1000002],"secondary_groups_id":[1000005]}}`
+	if !equalJSON(want, string(raw)) {
+		t.Fatalf("expected %s, got %s", want, raw)
+	}
+
+	// The API returns the groups as objects, and list values as JSON arrays.
+	var response queueWrapper
+	err = json.Unmarshal([]byte(`{"queue": {
+		"id": "01JA4F1E8Q7X2V3C9M5N6B4K2D", "name": "Tier 1", "description": "", "priority": 1, "order": 3,
+		"definition": {
+			"all": [{"subject": "priority", "operator": "is", "value": "urgent"}],
+			"any": [{"subject": "brand_id", "operator": "includes", "value": ["1000003", "1000004"]}, {"subject": "via_id", "operator": "is", "value": 4}]
+		},
+		"primary_groups": {"count": 2, "groups": [{"id": 1000002, "name": "Billing"}, {"id": 1000001, "name": "Support"}]},
+		"secondary_groups": {"count": 1, "groups": [{"id": 1000005, "name": "Escalations"}]}
+	}}`), &response)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	flattenQueue(&response.Queue, &model)
+	if model.ID.ValueString() != "01JA4F1E8Q7X2V3C9M5N6B4K2D" || model.Order.ValueInt64() != 3 || !model.Description.IsNull() {
+		t.Errorf("unexpected model %+v", model)
+	}
+	if got := sortedStrings(model.PrimaryGroupIDs); got != "1000001,1000002" {
+		t.Errorf("expected primary groups 1000001,1000002, got %s", got)
+	}
+	if got := sortedStrings(model.SecondaryGroupIDs); got != "1000005" {
+		t.Errorf("expected secondary group 1000005, got %s", got)
+	}
+	if got := model.Definition.Any[0].Value.ValueString(); got != `["1000003", "1000004"]` {
+		t.Errorf("expected the configured list value to be kept, got %s", got)
+	}
+	if got := model.Definition.Any[1].Value.ValueString(); got != "4" {
+		t.Errorf("expected the configured value 4 to be kept, got %s", got)
+	}
+}
+
+func TestQueueWithoutSecondaryGroups(t *testing.T) {
+	model := QueueResourceModel{
+		Definition:      &QueueDefinitionModel{},
+		PrimaryGroupIDs: []types.String{types.StringValue("1000001")},
+	}
+
+	queue, diags := expandQueue(model)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	raw, _ := json.Marshal(queue.SecondaryGroupIDs)
+	if string(raw) != "[]" {
+		t.Errorf("expected secondary groups to be sent as an empty list, got %s", raw)
+	}
+
+	flattenQueue(&Queue{ID: "01JA4F1E8Q7X2V3C9M5N6B4K2D"}, &model)
+	if model.SecondaryGroupIDs != nil {
+		t.Errorf("expected unset secondary groups to stay null, got %v", model.SecondaryGroupIDs)
+	}
+}
+
+func TestExpandQueueInvalidGroupID(t *testing.T) {
+	_, diags := expandQueue(QueueResourceModel{
+		PrimaryGroupIDs:   []types.String{types.StringValue("support")},
+		SecondaryGroupIDs: []types.String{types.StringValue("1000002")},
+	})
+	if len(diags) != 1 || diags[0].Summary() != "Invalid ID" {
+		t.Errorf("expected an invalid ID error, got %v", diags)
+	}
+}
+
+func TestQueueValidation(t *testing.T) {
+	p := newProtocolTest(t, "")
+
+	diags := p.validate("zendesk_queue", `{"name": "Tier 1", "priority": 1, "definition": {"all": []}, "primary_group_ids": []}`)
+	if !hasProtocolError(diags, "Invalid Attribute Value") {
+		t.Errorf("expected an error for a queue without primary groups, got %v", diags)
+	}
+}
+
+func TestQueuePriorityUpdate(t *testing.T) {
+	p := newProtocolTest(t, "queue_priority_update.json")
+
+	state := p.config("zendesk_queue", testQueueState)
+	config := `{
+		"name": "Tier 1", "priority": 2,
+		"definition": {"all": [{"subject": "priority", "operator": "is", "value": "urgent"}]},
+		"primary_group_ids": ["1000001"], "secondary_group_ids": ["1000002"]
+	}`
+	if resp := p.plan("zendesk_queue", config, state); len(resp.RequiresReplace) > 0 {
+		t.Fatalf("expected the priority change to be in place, got replacement for %v", resp.RequiresReplace)
+	}
+
+	state = p.apply("zendesk_queue", config, state)
+	if got, want := p.attribute("zendesk_queue", state, "priority"), tftypes.NewValue(tftypes.Number, 2); !got.Equal(want) {
+		t.Errorf("expected priority %s, got %s", want, got)
+	}
+}
+
+func sortedStrings(values []types.String) string {
+	var result []string
+	for _, value := range values {
+		result = append(result, value.ValueString())
+	}
+	sort.Strings(result)
+	return strings.Join(result, ",")
+}
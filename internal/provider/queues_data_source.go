@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &QueuesDataSource{}
+)
+
+func NewQueuesDataSource() datasource.DataSource {
+	return &QueuesDataSource{}
+}
+
+type QueuesDataSource struct {
+	client *Client
+}
+
+type QueuesDataSourceModel struct {
+	Name   types.String      `tfsdk:"name"`
+	Queues []QueuesItemModel `tfsdk:"queues"`
+}
+
+type QueuesItemModel struct {
+	ID                types.String   `tfsdk:"id"`
+	Name              types.String   `tfsdk:"name"`
+	Description       types.String   `tfsdk:"description"`
+	Priority          types.Int64    `tfsdk:"priority"`
+	Order             types.Int64    `tfsdk:"order"`
+	PrimaryGroupIDs   []types.String `tfsdk:"primary_group_ids"`
+	SecondaryGroupIDs []types.String `tfsdk:"secondary_group_ids"`
+	DefinitionJSON    types.String   `tfsdk:"definition_json"`
+}
+
+func (d *QueuesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_queues"
+}
+
+func (d *QueuesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists Zendesk omnichannel routing queues, sorted by order.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Only return the queues with this exact name. An error is returned if none match.",
+				Optional:    true,
+			},
+			"queues": schema.ListNestedAttribute{
+				Description: "The queues.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the queue.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the queue.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the queue.",
+							Computed:    true,
+						},
+						"priority": schema.Int64Attribute{
+							Description: "The priority of the queue.",
+							Computed:    true,
+						},
+						"order": schema.Int64Attribute{
+							Description: "The order in which the queue is evaluated.",
+							Computed:    true,
+						},
+						"primary_group_ids": schema.ListAttribute{
+							Description: "The IDs of the groups work is routed to first.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"secondary_group_ids": schema.ListAttribute{
+							Description: "The IDs of the groups work is routed to when no primary group agent is available.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"definition_json": schema.StringAttribute{
+							Description: "The conditions a ticket must meet to enter the queue, as returned by the API, encoded as JSON.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *QueuesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *QueuesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config QueuesDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	queues, err := d.client.ListQueues(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Queues",
+			fmt.Sprintf("Could not list queues: %v", err),
+		)
+		return
+	}
+
+	sort.SliceStable(queues, func(i, j int) bool {
+		if queues[i].Order != queues[j].Order {
+			return queues[i].Order < queues[j].Order
+		}
+		return queues[i].ID < queues[j].ID
+	})
+
+	config.Queues = make([]QueuesItemModel, 0, len(queues))
+	for _, queue := range queues {
+		if !config.Name.IsNull() && queue.Name != config.Name.ValueString() {
+			continue
+		}
+
+		config.Queues = append(config.Queues, QueuesItemModel{
+			ID:                types.StringValue(queue.ID),
+			Name:              types.StringValue(queue.Name),
+			Description:       types.StringValue(queue.Description),
+			Priority:          types.Int64Value(queue.Priority),
+			Order:             types.Int64Value(queue.Order),
+			PrimaryGroupIDs:   idListValue(queue.PrimaryGroups.IDs()),
+			SecondaryGroupIDs: idListValue(queue.SecondaryGroups.IDs()),
+			DefinitionJSON:    jsonStringValue(queue.Definition),
+		})
+	}
+
+	if !config.Name.IsNull() && len(config.Queues) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Queue Not Found",
+			fmt.Sprintf("No queue found with name %q.", config.Name.ValueString()),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultMaxRetries is the number of times a request rejected by the rate limit is retried
+	// when the provider does not configure max_retries.
+	defaultMaxRetries = 5
+
+	// maxRetryDelay caps the delay between two attempts, whatever Retry-After asks for.
+	maxRetryDelay = 2 * time.Minute
+)
+
+// RateLimit is the rate limit state reported by the headers of a Zendesk response. Values the
+// response did not report are -1.
+type RateLimit struct {
+	Limit        int64
+	Remaining    int64
+	ResetSeconds int64
+}
+
+// rateLimitUsage aggregates the requests of every client of the provider process, so that a
+// single summary can be logged when the process exits.
+type rateLimitUsage struct {
+	mu           sync.Mutex
+	requests     int64
+	retries      int64
+	minRemaining int64
+}
+
+var usage = &rateLimitUsage{minRemaining: -1}
+
+func (u *rateLimitUsage) recordResponse(limit RateLimit) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	u.requests++
+	if limit.Remaining >= 0 && (u.minRemaining < 0 || limit.Remaining < u.minRemaining) {
+		u.minRemaining = limit.Remaining
+	}
+}
+
+func (u *rateLimitUsage) recordRetry() {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	u.retries++
+}
+
+func (u *rateLimitUsage) snapshot() (requests, retries, minRemaining int64) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	return u.requests, u.retries, u.minRemaining
+}
+
+// LogRateLimitSummary logs the requests made by the provider process, the retries performed,
+// and the lowest remaining rate limit observed. It is called once when the provider exits.
+func LogRateLimitSummary() {
+	requests, retries, minRemaining := usage.snapshot()
+	if requests == 0 {
+		return
+	}
+
+	remaining := "unknown"
+	if minRemaining >= 0 {
+		remaining = strconv.FormatInt(minRemaining, 10)
+	}
+
+	log.Printf("[INFO] Zendesk API usage: %d requests, %d retries, minimum rate limit remaining %s", requests, retries, remaining)
+}
+
+// parseRateLimit reads the rate limit headers of a response, preferring the standard
+// ratelimit-* headers over the older X-Rate-Limit ones.
+func parseRateLimit(header http.Header) RateLimit {
+	value := func(names ...string) int64 {
+		for _, name := range names {
+			if parsed, err := strconv.ParseInt(header.Get(name), 10, 64); err == nil {
+				return parsed
+			}
+		}
+		return -1
+	}
+
+	return RateLimit{
+		Limit:        value("Ratelimit-Limit", "X-Rate-Limit"),
+		Remaining:    value("Ratelimit-Remaining", "X-Rate-Limit-Remaining"),
+		ResetSeconds: value("Ratelimit-Reset"),
+	}
+}
+
+// retryAfter returns the delay before retrying a rate limited request. It reads the
+// Retry-After header, in seconds or as an HTTP date, and falls back to an exponential backoff
+// when the header is missing.
+func retryAfter(header http.Header, attempt int) time.Duration {
+	delay := time.Second << attempt
+	if value := header.Get("Retry-After"); value != "" {
+		if seconds, err := strconv.ParseInt(value, 10, 64); err == nil && seconds >= 0 {
+			delay = time.Duration(seconds) * time.Second
+		} else if date, err := http.ParseTime(value); err == nil {
+			delay = time.Until(date)
+		}
+	}
+
+	if delay < 0 {
+		delay = 0
+	}
+	if delay > maxRetryDelay {
+		delay = maxRetryDelay
+	}
+	return delay
+}
+
+// sleepContext pauses for delay, returning early with the error of ctx if it is cancelled.
+func sleepContext(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// rateLimitTransport records the rate limit usage of every response.
+type rateLimitTransport struct {
+	next http.RoundTripper
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	usage.recordResponse(parseRateLimit(resp.Header))
+	return resp, nil
+}
+
+// ReadRateLimit makes a lightweight request and returns the rate limit it reports.
+func (c *Client) ReadRateLimit(ctx context.Context) (*RateLimit, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.baseURL()+"/api/v2/users/me.json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.SetBasicAuth(fmt.Sprintf("%s/token", c.email), c.apiToken)
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rate limit: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("failed to read rate limit: %w", &APIError{StatusCode: resp.StatusCode, Body: string(body)})
+	}
+
+	limit := parseRateLimit(resp.Header)
+	return &limit, nil
+}
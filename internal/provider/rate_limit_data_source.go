@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &RateLimitDataSource{}
+)
+
+func NewRateLimitDataSource() datasource.DataSource {
+	return &RateLimitDataSource{}
+}
+
+type RateLimitDataSource struct {
+	client *Client
+}
+
+type RateLimitDataSourceModel struct {
+	Limit        types.Int64 `tfsdk:"limit"`
+	Remaining    types.Int64 `tfsdk:"remaining"`
+	ResetSeconds types.Int64 `tfsdk:"reset_seconds"`
+	Requests     types.Int64 `tfsdk:"requests"`
+	MinRemaining types.Int64 `tfsdk:"min_remaining"`
+}
+
+func (d *RateLimitDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rate_limit"
+}
+
+func (d *RateLimitDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the current Zendesk API rate limit of the account with a lightweight request, so that configurations can gate heavy modules on the available budget.",
+		Attributes: map[string]schema.Attribute{
+			"limit": schema.Int64Attribute{
+				Description: "The number of requests allowed per minute. Null when not reported.",
+				Computed:    true,
+			},
+			"remaining": schema.Int64Attribute{
+				Description: "The number of requests remaining in the current window. Null when not reported.",
+				Computed:    true,
+			},
+			"reset_seconds": schema.Int64Attribute{
+				Description: "The number of seconds until the current window resets. Null when not reported.",
+				Computed:    true,
+			},
+			"requests": schema.Int64Attribute{
+				Description: "The number of requests the provider made so far in this run, including this one.",
+				Computed:    true,
+			},
+			"min_remaining": schema.Int64Attribute{
+				Description: "The lowest remaining rate limit observed so far in this run. Null when not reported.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *RateLimitDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *RateLimitDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state RateLimitDataSourceModel
+
+	limit, err := d.client.ReadRateLimit(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Rate Limit",
+			fmt.Sprintf("Could not read rate limit: %v", err),
+		)
+		return
+	}
+
+	requests, _, minRemaining := usage.snapshot()
+
+	state.Limit = reportedInt64Value(limit.Limit)
+	state.Remaining = reportedInt64Value(limit.Remaining)
+	state.ResetSeconds = reportedInt64Value(limit.ResetSeconds)
+	state.Requests = types.Int64Value(requests)
+	state.MinRemaining = reportedInt64Value(minRemaining)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// reportedInt64Value maps the -1 used for values a response did not report to null.
+func reportedInt64Value(value int64) types.Int64 {
+	if value < 0 {
+		return types.Int64Null()
+	}
+	return types.Int64Value(value)
+}
@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &RoutingAttributeResource{}
+	_ resource.ResourceWithImportState = &RoutingAttributeResource{}
+)
+
+func NewRoutingAttributeResource() resource.Resource {
+	return &RoutingAttributeResource{}
+}
+
+type RoutingAttributeResource struct {
+	client *Client
+}
+
+type RoutingAttributeResourceModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+func (r *RoutingAttributeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_routing_attribute"
+}
+
+func (r *RoutingAttributeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Zendesk skill-based routing attribute, such as 'Language' or 'Product'.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the routing attribute, a UUID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the routing attribute.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (r *RoutingAttributeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RoutingAttributeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RoutingAttributeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attribute, err := r.client.CreateRoutingAttribute(ctx, RoutingAttribute{Name: plan.Name.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Routing Attribute",
+			fmt.Sprintf("Could not create routing attribute: %v", err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(attribute.ID)
+	plan.Name = types.StringValue(attribute.Name)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *RoutingAttributeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RoutingAttributeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attribute, err := r.client.ReadRoutingAttribute(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Routing Attribute",
+			fmt.Sprintf("Could not read routing attribute: %v", err),
+		)
+		return
+	}
+
+	if attribute == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Name = types.StringValue(attribute.Name)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *RoutingAttributeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan RoutingAttributeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attribute, err := r.client.UpdateRoutingAttribute(ctx, plan.ID.ValueString(), RoutingAttribute{Name: plan.Name.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Routing Attribute",
+			fmt.Sprintf("Could not update routing attribute: %v", err),
+		)
+		return
+	}
+
+	plan.Name = types.StringValue(attribute.Name)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *RoutingAttributeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RoutingAttributeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteRoutingAttribute(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Routing Attribute",
+			fmt.Sprintf("Could not delete routing attribute: %v", err),
+		)
+		return
+	}
+}
+
+func (r *RoutingAttributeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
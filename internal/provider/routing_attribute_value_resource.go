@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &RoutingAttributeValueResource{}
+	_ resource.ResourceWithImportState = &RoutingAttributeValueResource{}
+)
+
+func NewRoutingAttributeValueResource() resource.Resource {
+	return &RoutingAttributeValueResource{}
+}
+
+type RoutingAttributeValueResource struct {
+	client *Client
+}
+
+type RoutingAttributeValueResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	AttributeID types.String `tfsdk:"attribute_id"`
+	Name        types.String `tfsdk:"name"`
+}
+
+func (r *RoutingAttributeValueResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_routing_attribute_value"
+}
+
+func (r *RoutingAttributeValueResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a value of a Zendesk skill-based routing attribute, such as 'Portuguese' for 'Language'.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the value, a UUID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"attribute_id": schema.StringAttribute{
+				Description: "The ID of the routing attribute the value belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the value.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (r *RoutingAttributeValueResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RoutingAttributeValueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RoutingAttributeValueResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	value, err := r.client.CreateRoutingAttributeValue(ctx, plan.AttributeID.ValueString(), RoutingAttributeValue{Name: plan.Name.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Routing Attribute Value",
+			fmt.Sprintf("Could not create routing attribute value: %v", err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(value.ID)
+	plan.Name = types.StringValue(value.Name)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *RoutingAttributeValueResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RoutingAttributeValueResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	value, err := r.client.ReadRoutingAttributeValue(ctx, state.AttributeID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Routing Attribute Value",
+			fmt.Sprintf("Could not read routing attribute value: %v", err),
+		)
+		return
+	}
+
+	if value == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Name = types.StringValue(value.Name)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *RoutingAttributeValueResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan RoutingAttributeValueResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	value, err := r.client.UpdateRoutingAttributeValue(ctx, plan.AttributeID.ValueString(), plan.ID.ValueString(), RoutingAttributeValue{Name: plan.Name.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Routing Attribute Value",
+			fmt.Sprintf("Could not update routing attribute value: %v", err),
+		)
+		return
+	}
+
+	plan.Name = types.StringValue(value.Name)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *RoutingAttributeValueResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RoutingAttributeValueResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteRoutingAttributeValue(ctx, state.AttributeID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Routing Attribute Value",
+			fmt.Sprintf("Could not delete routing attribute value: %v", err),
+		)
+		return
+	}
+}
+
+func (r *RoutingAttributeValueResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := splitImportID(req.ID, "attribute_id", "value_id")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("attribute_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const testRoutingAttributeValueState = `{
+	"id": "1b3e9f40-7326-11ee-9e4b-6d5c4b3a2f1e", "attribute_id": "15821cba-7326-11ee-9e4b-2d3c4e5f6a7b",
+	"name": "Portuguese"
+}`
+
+func TestRoutingAttributeValueRename(t *testing.T) {
+	p := newProtocolTest(t, "routing_attribute_value_rename.json")
+
+	state := p.read("zendesk_routing_attribute_value", testRoutingAttributeValueState)
+
+	config := `{"attribute_id": "15821cba-7326-11ee-9e4b-2d3c4e5f6a7b", "name": "Portuguese (Portugal)"}`
+	if resp := p.plan("zendesk_routing_attribute_value", config, state); len(resp.RequiresReplace) > 0 {
+		t.Fatalf("expected the rename to be in place, got replacement for %v", resp.RequiresReplace)
+	}
+
+	state = p.apply("zendesk_routing_attribute_value", config, state)
+	if got, want := p.attribute("zendesk_routing_attribute_value", state, "name"), tftypes.NewValue(tftypes.String, "Portuguese (Portugal)"); !got.Equal(want) {
+		t.Errorf("expected name %s, got %s", want, got)
+	}
+}
+
+// Values created under one attribute each get their own ID and keep the attribute's ID.
+func TestRoutingAttributeValueCreateTwo(t *testing.T) {
+	p := newProtocolTest(t, "routing_attribute_value_create_two.json")
+
+	attribute := p.apply("zendesk_routing_attribute", `{"name": "Language"}`, nil)
+	attributeID := p.attribute("zendesk_routing_attribute", attribute, "id")
+	if want := tftypes.NewValue(tftypes.String, "15821cba-7326-11ee-9e4b-2d3c4e5f6a7b"); !attributeID.Equal(want) {
+		t.Fatalf("expected attribute id %s, got %s", want, attributeID)
+	}
+
+	var ids []tftypes.Value
+	for _, name := range []string{"Portuguese", "Spanish"} {
+		state := p.apply("zendesk_routing_attribute_value", `{"attribute_id": "15821cba-7326-11ee-9e4b-2d3c4e5f6a7b", "name": "`+name+`"}`, nil)
+		if got := p.attribute("zendesk_routing_attribute_value", state, "attribute_id"); !got.Equal(attributeID) {
+			t.Errorf("expected %s attribute_id %s, got %s", name, attributeID, got)
+		}
+		ids = append(ids, p.attribute("zendesk_routing_attribute_value", state, "id"))
+	}
+
+	if want := tftypes.NewValue(tftypes.String, "1b3e9f40-7326-11ee-9e4b-6d5c4b3a2f1e"); !ids[0].Equal(want) {
+		t.Errorf("expected Portuguese id %s, got %s", want, ids[0])
+	}
+	if want := tftypes.NewValue(tftypes.String, "1b3ea3a0-7326-11ee-9e4b-7e6d5c4b3a2f"); !ids[1].Equal(want) {
+		t.Errorf("expected Spanish id %s, got %s", want, ids[1])
+	}
+}
+
+func TestRoutingAttributeValueMoveReplaces(t *testing.T) {
+	p := newProtocolTest(t, "")
+
+	resp := p.plan("zendesk_routing_attribute_value", `{"attribute_id": "2a7f1c00-7326-11ee-9e4b-0a1b2c3d4e5f", "name": "Portuguese"}`,
+		p.config("zendesk_routing_attribute_value", testRoutingAttributeValueState))
+	if len(resp.RequiresReplace) != 1 || !resp.RequiresReplace[0].Equal(tftypes.NewAttributePath().WithAttributeName("attribute_id")) {
+		t.Errorf("expected attribute_id to require replacement, got %v", resp.RequiresReplace)
+	}
+}
+
+// Zendesk answers 404 both when the value was deleted and when its attribute was, which deletes
+// its values too. Either way the value is gone from state, and destroying it succeeds.
+func TestRoutingAttributeValueReadDeleted(t *testing.T) {
+	p := newProtocolTest(t, "routing_attribute_value_deleted.json")
+
+	for _, level := range []string{"value", "attribute"} {
+		state := p.read("zendesk_routing_attribute_value", testRoutingAttributeValueState)
+		if !p.value("zendesk_routing_attribute_value", state).IsNull() {
+			t.Errorf("expected the value to be removed when its %s is deleted, got %s", level, p.value("zendesk_routing_attribute_value", state))
+		}
+	}
+
+	state := p.config("zendesk_routing_attribute_value", testRoutingAttributeValueState)
+	if state := p.apply("zendesk_routing_attribute_value", "", state); !p.value("zendesk_routing_attribute_value", state).IsNull() {
+		t.Errorf("expected the value to be destroyed, got %s", p.value("zendesk_routing_attribute_value", state))
+	}
+}
+
+func TestRoutingAttributeValueImport(t *testing.T) {
+	p := newProtocolTest(t, "")
+
+	resp, err := p.server.ImportResourceState(context.Background(), &tfprotov6.ImportResourceStateRequest{
+		TypeName: "zendesk_routing_attribute_value",
+		ID:       "15821cba-7326-11ee-9e4b-2d3c4e5f6a7b/1b3e9f40-7326-11ee-9e4b-6d5c4b3a2f1e",
+	})
+	if err != nil {
+		t.Fatalf("ImportResourceState: %v", err)
+	}
+	checkProtocolDiagnostics(t, resp.Diagnostics)
+	if len(resp.ImportedResources) != 1 {
+		t.Fatalf("expected 1 imported resource, got %d", len(resp.ImportedResources))
+	}
+
+	state := resp.ImportedResources[0].State
+	if got, want := p.attribute("zendesk_routing_attribute_value", state, "attribute_id"), tftypes.NewValue(tftypes.String, "15821cba-7326-11ee-9e4b-2d3c4e5f6a7b"); !got.Equal(want) {
+		t.Errorf("expected attribute_id %s, got %s", want, got)
+	}
+	if got, want := p.attribute("zendesk_routing_attribute_value", state, "id"), tftypes.NewValue(tftypes.String, "1b3e9f40-7326-11ee-9e4b-6d5c4b3a2f1e"); !got.Equal(want) {
+		t.Errorf("expected id %s, got %s", want, got)
+	}
+
+	resp, err = p.server.ImportResourceState(context.Background(), &tfprotov6.ImportResourceStateRequest{
+		TypeName: "zendesk_routing_attribute_value",
+		ID:       "1b3e9f40-7326-11ee-9e4b-6d5c4b3a2f1e",
+	})
+	if err != nil {
+		t.Fatalf("ImportResourceState: %v", err)
+	}
+	if !hasProtocolError(resp.Diagnostics, "Invalid Import ID") {
+		t.Errorf("expected an invalid import ID error, got %v", resp.Diagnostics)
+	}
+}
@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &RoutingAttributesDataSource{}
+)
+
+func NewRoutingAttributesDataSource() datasource.DataSource {
+	return &RoutingAttributesDataSource{}
+}
+
+type RoutingAttributesDataSource struct {
+	client *Client
+}
+
+type RoutingAttributesDataSourceModel struct {
+	Name       types.String            `tfsdk:"name"`
+	Attributes *RoutingAttributesModel `tfsdk:"attributes"`
+}
+
+type RoutingAttributesModel struct {
+	All    []RoutingAttributeModel          `tfsdk:"all"`
+	ByName map[string]RoutingAttributeModel `tfsdk:"by_name"`
+}
+
+type RoutingAttributeModel struct {
+	ID     types.String                `tfsdk:"id"`
+	Name   types.String                `tfsdk:"name"`
+	Values RoutingAttributeValuesModel `tfsdk:"values"`
+}
+
+type RoutingAttributeValuesModel struct {
+	All    []RoutingAttributeValueModel          `tfsdk:"all"`
+	ByName map[string]RoutingAttributeValueModel `tfsdk:"by_name"`
+}
+
+type RoutingAttributeValueModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+func (d *RoutingAttributesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_routing_attributes"
+}
+
+func (d *RoutingAttributesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	valueAttributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the attribute value.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "The name of the attribute value.",
+			Computed:    true,
+		},
+	}
+
+	attributeAttributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the attribute.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "The name of the attribute.",
+			Computed:    true,
+		},
+		"values": schema.SingleNestedAttribute{
+			Description: "The values of the attribute.",
+			Computed:    true,
+			Attributes: map[string]schema.Attribute{
+				"all": schema.ListNestedAttribute{
+					Description: "The values, sorted by name.",
+					Computed:    true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: valueAttributes,
+					},
+				},
+				"by_name": schema.MapNestedAttribute{
+					Description: "The values, keyed by name.",
+					Computed:    true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: valueAttributes,
+					},
+				},
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Lists the skill-based routing attributes of Zendesk, with their values.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Only return the attribute with this exact name.",
+				Optional:    true,
+			},
+			"attributes": schema.SingleNestedAttribute{
+				Description: "The routing attributes.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"all": schema.ListNestedAttribute{
+						Description: "The attributes, sorted by name.",
+						Computed:    true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: attributeAttributes,
+						},
+					},
+					"by_name": schema.MapNestedAttribute{
+						Description: "The attributes, keyed by name.",
+						Computed:    true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: attributeAttributes,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RoutingAttributesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *RoutingAttributesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config RoutingAttributesDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attributes, err := d.client.ListRoutingAttributes(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Routing Attributes",
+			fmt.Sprintf("Could not list routing attributes: %v", err),
+		)
+		return
+	}
+
+	sort.SliceStable(attributes, func(i, j int) bool {
+		return attributes[i].Name < attributes[j].Name
+	})
+
+	config.Attributes = &RoutingAttributesModel{
+		All:    make([]RoutingAttributeModel, 0, len(attributes)),
+		ByName: make(map[string]RoutingAttributeModel, len(attributes)),
+	}
+	for _, attribute := range attributes {
+		if !config.Name.IsNull() && attribute.Name != config.Name.ValueString() {
+			continue
+		}
+
+		// Values are only fetched for the attributes that are returned, one request each.
+		values, err := d.client.ListRoutingAttributeValues(ctx, attribute.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Listing Routing Attribute Values",
+				fmt.Sprintf("Could not list values of routing attribute %q: %v", attribute.Name, err),
+			)
+			return
+		}
+
+		sort.SliceStable(values, func(i, j int) bool {
+			return values[i].Name < values[j].Name
+		})
+
+		model := RoutingAttributeModel{
+			ID:   types.StringValue(attribute.ID),
+			Name: types.StringValue(attribute.Name),
+			Values: RoutingAttributeValuesModel{
+				All:    make([]RoutingAttributeValueModel, 0, len(values)),
+				ByName: make(map[string]RoutingAttributeValueModel, len(values)),
+			},
+		}
+		for _, value := range values {
+			valueModel := RoutingAttributeValueModel{
+				ID:   types.StringValue(value.ID),
+				Name: types.StringValue(value.Name),
+			}
+			model.Values.All = append(model.Values.All, valueModel)
+			model.Values.ByName[value.Name] = valueModel
+		}
+
+		config.Attributes.All = append(config.Attributes.All, model)
+		config.Attributes.ByName[attribute.Name] = model
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
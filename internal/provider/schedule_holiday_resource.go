@@ -0,0 +1,306 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &ScheduleHolidayResource{}
+	_ resource.ResourceWithImportState = &ScheduleHolidayResource{}
+	_ resource.ResourceWithModifyPlan  = &ScheduleHolidayResource{}
+)
+
+const holidayDateLayout = "2006-01-02"
+
+var holidayDatePattern = regexp.MustCompile(`^[0-9]{4}-[0-9]{2}-[0-9]{2}$`)
+
+func NewScheduleHolidayResource() resource.Resource {
+	return &ScheduleHolidayResource{}
+}
+
+type ScheduleHolidayResource struct {
+	client *Client
+}
+
+type ScheduleHolidayResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	ScheduleID types.String `tfsdk:"schedule_id"`
+	Name       types.String `tfsdk:"name"`
+	StartDate  types.String `tfsdk:"start_date"`
+	EndDate    types.String `tfsdk:"end_date"`
+}
+
+func (r *ScheduleHolidayResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schedule_holiday"
+}
+
+func (r *ScheduleHolidayResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	dateValidators := []validator.String{
+		stringvalidator.RegexMatches(holidayDatePattern, "must be a date in the format YYYY-MM-DD"),
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a holiday of a Zendesk business hours schedule.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the holiday.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"schedule_id": schema.StringAttribute{
+				Description: "The ID of the schedule the holiday belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the holiday.",
+				Required:    true,
+			},
+			"start_date": schema.StringAttribute{
+				Description: "The first day of the holiday, as YYYY-MM-DD.",
+				Required:    true,
+				Validators:  dateValidators,
+			},
+			"end_date": schema.StringAttribute{
+				Description: "The last day of the holiday, as YYYY-MM-DD. Use the start date for a single-day holiday.",
+				Required:    true,
+				Validators:  dateValidators,
+			},
+		},
+	}
+}
+
+func (r *ScheduleHolidayResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan checks that the dates exist and are in order, which the format validators cannot.
+func (r *ScheduleHolidayResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ScheduleHolidayResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.StartDate.IsUnknown() || plan.EndDate.IsUnknown() {
+		return
+	}
+
+	start, err := time.Parse(holidayDateLayout, plan.StartDate.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("start_date"), "Invalid Holiday Date", fmt.Sprintf("%q is not a valid date.", plan.StartDate.ValueString()))
+		return
+	}
+	end, err := time.Parse(holidayDateLayout, plan.EndDate.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("end_date"), "Invalid Holiday Date", fmt.Sprintf("%q is not a valid date.", plan.EndDate.ValueString()))
+		return
+	}
+
+	if end.Before(start) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("end_date"),
+			"Invalid Holiday Date",
+			fmt.Sprintf("The end date %s is before the start date %s.", plan.EndDate.ValueString(), plan.StartDate.ValueString()),
+		)
+	}
+}
+
+func (r *ScheduleHolidayResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ScheduleHolidayResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scheduleID, err := strconv.ParseInt(plan.ScheduleID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("schedule_id"),
+			"Error Parsing Schedule ID",
+			fmt.Sprintf("Could not parse schedule ID: %v", err),
+		)
+		return
+	}
+
+	holiday, err := r.client.CreateScheduleHoliday(ctx, scheduleID, expandScheduleHoliday(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Schedule Holiday",
+			fmt.Sprintf("Could not create schedule holiday: %v", err),
+		)
+		return
+	}
+
+	flattenScheduleHoliday(holiday, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ScheduleHolidayResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ScheduleHolidayResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scheduleID, id, err := parseScheduleHolidayIDs(state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Schedule Holiday ID",
+			fmt.Sprintf("Could not parse schedule holiday ID: %v", err),
+		)
+		return
+	}
+
+	holiday, err := r.client.ReadScheduleHoliday(ctx, scheduleID, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Schedule Holiday",
+			fmt.Sprintf("Could not read schedule holiday: %v", err),
+		)
+		return
+	}
+
+	if holiday == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	flattenScheduleHoliday(holiday, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ScheduleHolidayResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ScheduleHolidayResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scheduleID, id, err := parseScheduleHolidayIDs(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Schedule Holiday ID",
+			fmt.Sprintf("Could not parse schedule holiday ID: %v", err),
+		)
+		return
+	}
+
+	holiday, err := r.client.UpdateScheduleHoliday(ctx, scheduleID, id, expandScheduleHoliday(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Schedule Holiday",
+			fmt.Sprintf("Could not update schedule holiday: %v", err),
+		)
+		return
+	}
+
+	flattenScheduleHoliday(holiday, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ScheduleHolidayResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ScheduleHolidayResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scheduleID, id, err := parseScheduleHolidayIDs(state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Schedule Holiday ID",
+			fmt.Sprintf("Could not parse schedule holiday ID: %v", err),
+		)
+		return
+	}
+
+	err = r.client.DeleteScheduleHoliday(ctx, scheduleID, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Schedule Holiday",
+			fmt.Sprintf("Could not delete schedule holiday: %v", err),
+		)
+		return
+	}
+}
+
+func (r *ScheduleHolidayResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := splitImportID(req.ID, "schedule_id", "holiday_id")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("schedule_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
+
+func parseScheduleHolidayIDs(model ScheduleHolidayResourceModel) (int64, int64, error) {
+	scheduleID, err := strconv.ParseInt(model.ScheduleID.ValueString(), 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	id, err := strconv.ParseInt(model.ID.ValueString(), 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return scheduleID, id, nil
+}
+
+func expandScheduleHoliday(model ScheduleHolidayResourceModel) ScheduleHoliday {
+	return ScheduleHoliday{
+		Name:      model.Name.ValueString(),
+		StartDate: model.StartDate.ValueString(),
+		EndDate:   model.EndDate.ValueString(),
+	}
+}
+
+func flattenScheduleHoliday(holiday *ScheduleHoliday, model *ScheduleHolidayResourceModel) {
+	model.ID = types.StringValue(strconv.FormatInt(holiday.ID, 10))
+	model.Name = types.StringValue(holiday.Name)
+	model.StartDate = types.StringValue(holiday.StartDate)
+	model.EndDate = types.StringValue(holiday.EndDate)
+}
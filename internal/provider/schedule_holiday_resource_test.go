@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestScheduleHolidayDateValidation(t *testing.T) {
+	p := newProtocolTest(t, "")
+
+	tests := []struct {
+		name      string
+		startDate string
+		endDate   string
+		valid     bool
+		summary   string
+	}{
+		{name: "single day", startDate: "2026-12-25", endDate: "2026-12-25", valid: true},
+		{name: "range", startDate: "2026-12-24", endDate: "2026-12-26", valid: true},
+		{name: "slashes", startDate: "2026/12/25", endDate: "2026-12-25", summary: "Invalid Attribute Value Match"},
+		{name: "time", startDate: "2026-12-25", endDate: "2026-12-25T00:00:00Z", summary: "Invalid Attribute Value Match"},
+		{name: "no such day", startDate: "2026-02-30", endDate: "2026-03-01", summary: "Invalid Holiday Date"},
+		{name: "end before start", startDate: "2026-12-26", endDate: "2026-12-24", summary: "Invalid Holiday Date"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := `{"schedule_id": "1000001", "name": "Christmas", "start_date": "` + test.startDate + `", "end_date": "` + test.endDate + `"}`
+
+			diags := p.validate("zendesk_schedule_holiday", config)
+			if len(diags) == 0 {
+				diags = p.planDiagnostics("zendesk_schedule_holiday", config, nil)
+			}
+
+			if test.valid && len(diags) > 0 {
+				t.Errorf("unexpected diagnostics: %s: %s", diags[0].Summary, diags[0].Detail)
+			}
+			if !test.valid && !hasProtocolError(diags, test.summary) {
+				t.Errorf("expected a %q error, got %v", test.summary, diags)
+			}
+		})
+	}
+}
+
+// Two holidays on one schedule are created separately, and each keeps its own ID and dates.
+func TestScheduleHolidayCreateTwo(t *testing.T) {
+	p := newProtocolTest(t, "schedule_holiday_create_two.json")
+
+	tests := []struct {
+		name      string
+		startDate string
+		endDate   string
+		id        string
+	}{
+		{name: "Christmas", startDate: "2026-12-24", endDate: "2026-12-26", id: "1000002"},
+		{name: "New Year", startDate: "2027-01-01", endDate: "2027-01-01", id: "1000003"},
+	}
+
+	for _, test := range tests {
+		config := `{"schedule_id": "1000001", "name": "` + test.name + `", "start_date": "` + test.startDate + `", "end_date": "` + test.endDate + `"}`
+		state := p.apply("zendesk_schedule_holiday", config, nil)
+
+		for attribute, want := range map[string]string{
+			"id":          test.id,
+			"schedule_id": "1000001",
+			"name":        test.name,
+			"start_date":  test.startDate,
+			"end_date":    test.endDate,
+		} {
+			if got := p.attribute("zendesk_schedule_holiday", state, attribute); !got.Equal(tftypes.NewValue(tftypes.String, want)) {
+				t.Errorf("%s: expected %s %q, got %s", test.name, attribute, want, got)
+			}
+		}
+	}
+}
+
+// A holiday whose schedule was destroyed first is gone with it, which does not fail the destroy.
+func TestScheduleHolidayDeleteScheduleGone(t *testing.T) {
+	p := newProtocolTest(t, "schedule_holiday_delete_schedule_gone.json")
+
+	state := &tfprotov6.DynamicValue{JSON: []byte(`{
+		"id": "1000002", "schedule_id": "1000001", "name": "Christmas", "start_date": "2026-12-25", "end_date": "2026-12-25"
+	}`)}
+	if state := p.apply("zendesk_schedule_holiday", "", state); !p.value("zendesk_schedule_holiday", state).IsNull() {
+		t.Errorf("expected the holiday to be destroyed, got %s", p.value("zendesk_schedule_holiday", state))
+	}
+}
+
+func TestScheduleHolidayImport(t *testing.T) {
+	p := newProtocolTest(t, "")
+
+	resp, err := p.server.ImportResourceState(context.Background(), &tfprotov6.ImportResourceStateRequest{
+		TypeName: "zendesk_schedule_holiday",
+		ID:       "1000001/1000002",
+	})
+	if err != nil {
+		t.Fatalf("ImportResourceState: %v", err)
+	}
+	checkProtocolDiagnostics(t, resp.Diagnostics)
+	if len(resp.ImportedResources) != 1 {
+		t.Fatalf("expected 1 imported resource, got %d", len(resp.ImportedResources))
+	}
+
+	state := resp.ImportedResources[0].State
+	if got, want := p.attribute("zendesk_schedule_holiday", state, "schedule_id"), tftypes.NewValue(tftypes.String, "1000001"); !got.Equal(want) {
+		t.Errorf("expected schedule_id %s, got %s", want, got)
+	}
+	if got, want := p.attribute("zendesk_schedule_holiday", state, "id"), tftypes.NewValue(tftypes.String, "1000002"); !got.Equal(want) {
+		t.Errorf("expected id %s, got %s", want, got)
+	}
+
+	resp, err = p.server.ImportResourceState(context.Background(), &tfprotov6.ImportResourceStateRequest{
+		TypeName: "zendesk_schedule_holiday",
+		ID:       "1000002",
+	})
+	if err != nil {
+		t.Fatalf("ImportResourceState: %v", err)
+	}
+	if !hasProtocolError(resp.Diagnostics, "Invalid Import ID") {
+		t.Errorf("expected an invalid import ID error, got %v", resp.Diagnostics)
+	}
+}
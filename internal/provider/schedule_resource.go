@@ -0,0 +1,365 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &ScheduleResource{}
+	_ resource.ResourceWithImportState = &ScheduleResource{}
+	_ resource.ResourceWithModifyPlan  = &ScheduleResource{}
+)
+
+func NewScheduleResource() resource.Resource {
+	return &ScheduleResource{}
+}
+
+type ScheduleResource struct {
+	client *Client
+}
+
+type ScheduleResourceModel struct {
+	ID        types.String            `tfsdk:"id"`
+	Name      types.String            `tfsdk:"name"`
+	TimeZone  types.String            `tfsdk:"time_zone"`
+	Intervals []ScheduleIntervalModel `tfsdk:"intervals"`
+}
+
+func (r *ScheduleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schedule"
+}
+
+func (r *ScheduleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Zendesk business hours schedule.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the schedule.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the schedule.",
+				Required:    true,
+			},
+			"time_zone": schema.StringAttribute{
+				Description: "The time zone of the schedule (e.g., 'Lisbon' or 'Eastern Time (US & Canada)').",
+				Required:    true,
+			},
+			"intervals": schema.SetNestedAttribute{
+				Description: "The business hours, by day of the week. Zendesk merges adjacent intervals, so they should not touch.",
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"day": schema.StringAttribute{
+							Description: "The day of the week (e.g., 'monday').",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(scheduleDays...),
+							},
+						},
+						"start_time": schema.StringAttribute{
+							Description: "The start of the interval, as HH:MM.",
+							Required:    true,
+						},
+						"end_time": schema.StringAttribute{
+							Description: "The end of the interval, as HH:MM. Use '24:00' for an interval ending at midnight.",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ScheduleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan validates the intervals at plan time, as their times are only checked when
+// converted to minutes since Sunday 00:00.
+func (r *ScheduleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var intervals types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("intervals"), &intervals)...)
+	if resp.Diagnostics.HasError() || intervals.IsUnknown() || intervals.IsNull() {
+		return
+	}
+
+	var models []ScheduleIntervalModel
+	resp.Diagnostics.Append(intervals.ElementsAs(ctx, &models, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, model := range models {
+		if model.Day.IsUnknown() || model.StartTime.IsUnknown() || model.EndTime.IsUnknown() {
+			return
+		}
+	}
+
+	if _, err := expandScheduleIntervals(models); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("intervals"), "Invalid Schedule Intervals", err.Error())
+	}
+}
+
+func (r *ScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ScheduleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	intervals, err := expandScheduleIntervals(plan.Intervals)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("intervals"), "Invalid Schedule Intervals", err.Error())
+		return
+	}
+
+	schedule, err := r.client.CreateSchedule(ctx, Schedule{
+		Name:     plan.Name.ValueString(),
+		TimeZone: plan.TimeZone.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Schedule",
+			fmt.Sprintf("Could not create schedule: %v", err),
+		)
+		return
+	}
+
+	// Save the schedule before replacing its default intervals, so that it is not leaked if
+	// that fails.
+	plan.ID = types.StringValue(strconv.FormatInt(schedule.ID, 10))
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedule.Intervals, err = r.client.UpdateScheduleWorkweek(ctx, schedule.ID, intervals)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Schedule",
+			fmt.Sprintf("Could not set the intervals of schedule %d: %v", schedule.ID, err),
+		)
+		return
+	}
+
+	flattenSchedule(schedule, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ScheduleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Schedule ID",
+			fmt.Sprintf("Could not parse schedule ID: %v", err),
+		)
+		return
+	}
+
+	schedule, err := r.client.ReadSchedule(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Schedule",
+			fmt.Sprintf("Could not read schedule: %v", err),
+		)
+		return
+	}
+
+	if schedule == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	flattenSchedule(schedule, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ScheduleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(plan.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Schedule ID",
+			fmt.Sprintf("Could not parse schedule ID: %v", err),
+		)
+		return
+	}
+
+	intervals, err := expandScheduleIntervals(plan.Intervals)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("intervals"), "Invalid Schedule Intervals", err.Error())
+		return
+	}
+
+	schedule, err := r.client.UpdateSchedule(ctx, id, Schedule{
+		Name:     plan.Name.ValueString(),
+		TimeZone: plan.TimeZone.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Schedule",
+			fmt.Sprintf("Could not update schedule: %v", err),
+		)
+		return
+	}
+
+	// The intervals can only be changed through the workweek endpoint.
+	current, err := expandScheduleIntervals(state.Intervals)
+	if err != nil || !equalScheduleIntervals(current, intervals) {
+		schedule.Intervals, err = r.client.UpdateScheduleWorkweek(ctx, id, intervals)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Schedule",
+				fmt.Sprintf("Could not update the intervals of schedule %d: %v", id, err),
+			)
+			return
+		}
+	}
+
+	flattenSchedule(schedule, &plan)
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ScheduleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Schedule ID",
+			fmt.Sprintf("Could not parse schedule ID: %v", err),
+		)
+		return
+	}
+
+	err = r.client.DeleteSchedule(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Schedule",
+			fmt.Sprintf("Could not delete schedule: %v", err),
+		)
+		return
+	}
+}
+
+func (r *ScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// expandScheduleIntervals converts the intervals to minutes since Sunday 00:00, sorted by start
+// time, and rejects overlapping intervals.
+func expandScheduleIntervals(models []ScheduleIntervalModel) ([]ScheduleInterval, error) {
+	intervals := make([]ScheduleInterval, 0, len(models))
+	for _, model := range models {
+		interval, err := scheduleDayInterval(model.Day.ValueString(), model.StartTime.ValueString(), model.EndTime.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		intervals = append(intervals, interval)
+	}
+
+	sort.Slice(intervals, func(i, j int) bool {
+		return intervals[i].StartTime < intervals[j].StartTime
+	})
+
+	for i := 1; i < len(intervals); i++ {
+		if intervals[i].StartTime < intervals[i-1].EndTime {
+			day, start, end := scheduleIntervalDay(intervals[i])
+			return nil, fmt.Errorf("the interval on %s from %s to %s overlaps another interval", day, start, end)
+		}
+	}
+
+	return intervals, nil
+}
+
+func equalScheduleIntervals(a, b []ScheduleInterval) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func flattenSchedule(schedule *Schedule, model *ScheduleResourceModel) {
+	model.ID = types.StringValue(strconv.FormatInt(schedule.ID, 10))
+	model.Name = types.StringValue(schedule.Name)
+	model.TimeZone = types.StringValue(schedule.TimeZone)
+
+	model.Intervals = make([]ScheduleIntervalModel, 0, len(schedule.Intervals))
+	for _, interval := range schedule.Intervals {
+		day, start, end := scheduleIntervalDay(interval)
+		model.Intervals = append(model.Intervals, ScheduleIntervalModel{
+			Day:       types.StringValue(day),
+			StartTime: types.StringValue(start),
+			EndTime:   types.StringValue(end),
+		})
+	}
+}
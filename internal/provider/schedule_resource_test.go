@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testScheduleWorkweek is Monday to Friday, 09:00 to 17:00.
+func testScheduleWorkweek(friday string) string {
+	var intervals []string
+	for _, day := range []string{"monday", "tuesday", "wednesday", "thursday"} {
+		intervals = append(intervals, `{"day": "`+day+`", "start_time": "09:00", "end_time": "17:00"}`)
+	}
+	intervals = append(intervals, `{"day": "friday", "start_time": "09:00", "end_time": "`+friday+`"}`)
+	return "[" + strings.Join(intervals, ", ") + "]"
+}
+
+func TestScheduleIntervalsRoundTrip(t *testing.T) {
+	var models []ScheduleIntervalModel
+	for _, day := range []string{"friday", "monday", "wednesday", "tuesday", "thursday"} {
+		models = append(models, ScheduleIntervalModel{
+			Day:       types.StringValue(day),
+			StartTime: types.StringValue("09:00"),
+			EndTime:   types.StringValue("17:00"),
+		})
+	}
+
+	intervals, err := expandScheduleIntervals(models)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Monday 09:00 is 1 day and 9 hours after Sunday 00:00, and the intervals are sorted.
+	want := []ScheduleInterval{
+		{StartTime: 1980, EndTime: 2460},
+		{StartTime: 3420, EndTime: 3900},
+		{StartTime: 4860, EndTime: 5340},
+		{StartTime: 6300, EndTime: 6780},
+		{StartTime: 7740, EndTime: 8220},
+	}
+	if !equalScheduleIntervals(intervals, want) {
+		t.Fatalf("expected %v, got %v", want, intervals)
+	}
+
+	var model ScheduleResourceModel
+	flattenSchedule(&Schedule{ID: 1, Name: "Lisbon office", TimeZone: "Lisbon", Intervals: intervals}, &model)
+	for i, day := range []string{"monday", "tuesday", "wednesday", "thursday", "friday"} {
+		got := model.Intervals[i]
+		if got.Day.ValueString() != day || got.StartTime.ValueString() != "09:00" || got.EndTime.ValueString() != "17:00" {
+			t.Errorf("interval %d: expected %s 09:00-17:00, got %s %s-%s", i, day, got.Day, got.StartTime, got.EndTime)
+		}
+	}
+}
+
+func TestScheduleIntervalMidnight(t *testing.T) {
+	interval, err := scheduleDayInterval("saturday", "20:00", "24:00")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if interval != (ScheduleInterval{StartTime: 9840, EndTime: 10080}) {
+		t.Fatalf("unexpected interval %v", interval)
+	}
+
+	if day, start, end := scheduleIntervalDay(interval); day != "saturday" || start != "20:00" || end != "24:00" {
+		t.Errorf("expected saturday 20:00-24:00, got %s %s-%s", day, start, end)
+	}
+}
+
+func TestExpandScheduleIntervalsInvalid(t *testing.T) {
+	tests := map[string][]ScheduleIntervalModel{
+		"overlapping": {
+			{Day: types.StringValue("monday"), StartTime: types.StringValue("09:00"), EndTime: types.StringValue("13:00")},
+			{Day: types.StringValue("monday"), StartTime: types.StringValue("12:00"), EndTime: types.StringValue("17:00")},
+		},
+		"end before start": {
+			{Day: types.StringValue("monday"), StartTime: types.StringValue("17:00"), EndTime: types.StringValue("09:00")},
+		},
+		"malformed time": {
+			{Day: types.StringValue("monday"), StartTime: types.StringValue("9:00"), EndTime: types.StringValue("17:00")},
+		},
+		"after midnight": {
+			{Day: types.StringValue("monday"), StartTime: types.StringValue("09:00"), EndTime: types.StringValue("24:30")},
+		},
+	}
+
+	for name, models := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := expandScheduleIntervals(models); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+// Changing the intervals of a Monday to Friday schedule updates the schedule and then its
+// workweek, which is the only endpoint that changes intervals.
+func TestScheduleWorkweekUpdate(t *testing.T) {
+	p := newProtocolTest(t, "schedule_workweek_update.json")
+
+	state := p.read("zendesk_schedule", `{
+		"id": "1000001", "name": "Lisbon office", "time_zone": "Lisbon",
+		"intervals": `+testScheduleWorkweek("17:00")+`
+	}`)
+	p.planUnchanged("zendesk_schedule", `{
+		"name": "Lisbon office", "time_zone": "Lisbon",
+		"intervals": `+testScheduleWorkweek("17:00")+`
+	}`, state)
+
+	p.apply("zendesk_schedule", `{
+		"name": "Lisbon office", "time_zone": "Lisbon",
+		"intervals": `+testScheduleWorkweek("13:00")+`
+	}`, state)
+}
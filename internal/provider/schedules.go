@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+)
+
+const minutesPerDay = 24 * 60
+
+// scheduleDays are the days of the week in the order used by the API, whose intervals are
+// expressed in minutes since Sunday 00:00.
+var scheduleDays = []string{"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"}
+
+// scheduleIntervalDay converts an interval in minutes since Sunday 00:00 into a day of the
+// week and "HH:MM" start and end times. An interval ending at midnight ends at "24:00".
+func scheduleIntervalDay(interval ScheduleInterval) (day, start, end string) {
+	index := interval.StartTime / minutesPerDay
+	if index < 0 || index >= int64(len(scheduleDays)) {
+		index = 0
+	}
+	offset := index * minutesPerDay
+
+	return scheduleDays[index], formatScheduleTime(interval.StartTime - offset), formatScheduleTime(interval.EndTime - offset)
+}
+
+// scheduleDayInterval is the inverse of scheduleIntervalDay.
+func scheduleDayInterval(day, start, end string) (ScheduleInterval, error) {
+	index := -1
+	for i, d := range scheduleDays {
+		if strings.EqualFold(d, day) {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return ScheduleInterval{}, fmt.Errorf("invalid day %q, expected one of: %s", day, strings.Join(scheduleDays, ", "))
+	}
+
+	startMinutes, err := parseScheduleTime(start)
+	if err != nil {
+		return ScheduleInterval{}, err
+	}
+	endMinutes, err := parseScheduleTime(end)
+	if err != nil {
+		return ScheduleInterval{}, err
+	}
+	if endMinutes <= startMinutes {
+		return ScheduleInterval{}, fmt.Errorf("end time %q must be after start time %q", end, start)
+	}
+
+	offset := int64(index) * minutesPerDay
+	return ScheduleInterval{StartTime: offset + startMinutes, EndTime: offset + endMinutes}, nil
+}
+
+func formatScheduleTime(minutes int64) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+func parseScheduleTime(value string) (int64, error) {
+	var hours, minutes int64
+	if _, err := fmt.Sscanf(value, "%d:%d", &hours, &minutes); err != nil || len(value) != 5 {
+		return 0, fmt.Errorf("invalid time %q, expected HH:MM", value)
+	}
+	if hours < 0 || minutes < 0 || minutes > 59 || hours*60+minutes > minutesPerDay {
+		return 0, fmt.Errorf("invalid time %q, expected a time between 00:00 and 24:00", value)
+	}
+
+	return hours*60 + minutes, nil
+}
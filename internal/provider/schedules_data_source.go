@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &SchedulesDataSource{}
+)
+
+func NewSchedulesDataSource() datasource.DataSource {
+	return &SchedulesDataSource{}
+}
+
+type SchedulesDataSource struct {
+	client *Client
+}
+
+type SchedulesDataSourceModel struct {
+	Name      types.String         `tfsdk:"name"`
+	Schedules []SchedulesItemModel `tfsdk:"schedules"`
+}
+
+type SchedulesItemModel struct {
+	ID            types.String               `tfsdk:"id"`
+	Name          types.String               `tfsdk:"name"`
+	TimeZone      types.String               `tfsdk:"time_zone"`
+	RawIntervals  []ScheduleRawIntervalModel `tfsdk:"raw_intervals"`
+	Intervals     []ScheduleIntervalModel    `tfsdk:"intervals"`
+	HolidaysCount types.Int64                `tfsdk:"holidays_count"`
+}
+
+type ScheduleRawIntervalModel struct {
+	StartTime types.Int64 `tfsdk:"start_time"`
+	EndTime   types.Int64 `tfsdk:"end_time"`
+}
+
+type ScheduleIntervalModel struct {
+	Day       types.String `tfsdk:"day"`
+	StartTime types.String `tfsdk:"start_time"`
+	EndTime   types.String `tfsdk:"end_time"`
+}
+
+func (d *SchedulesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schedules"
+}
+
+func (d *SchedulesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists Zendesk business hours schedules, sorted by name.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Only return the schedules with this exact name. An error is returned if none match.",
+				Optional:    true,
+			},
+			"schedules": schema.ListNestedAttribute{
+				Description: "The schedules.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the schedule.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the schedule.",
+							Computed:    true,
+						},
+						"time_zone": schema.StringAttribute{
+							Description: "The time zone of the schedule.",
+							Computed:    true,
+						},
+						"raw_intervals": schema.ListNestedAttribute{
+							Description: "The business hours, as returned by the API.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"start_time": schema.Int64Attribute{
+										Description: "The start of the interval, in minutes since Sunday 00:00.",
+										Computed:    true,
+									},
+									"end_time": schema.Int64Attribute{
+										Description: "The end of the interval, in minutes since Sunday 00:00.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"intervals": schema.ListNestedAttribute{
+							Description: "The business hours, by day of the week.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"day": schema.StringAttribute{
+										Description: "The day of the week (e.g., 'monday').",
+										Computed:    true,
+									},
+									"start_time": schema.StringAttribute{
+										Description: "The start of the interval, as HH:MM.",
+										Computed:    true,
+									},
+									"end_time": schema.StringAttribute{
+										Description: "The end of the interval, as HH:MM.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"holidays_count": schema.Int64Attribute{
+							Description: "The number of holidays of the schedule.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SchedulesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SchedulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config SchedulesDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedules, err := d.client.ListSchedules(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Schedules",
+			fmt.Sprintf("Could not list schedules: %v", err),
+		)
+		return
+	}
+
+	sort.SliceStable(schedules, func(i, j int) bool {
+		if schedules[i].Name != schedules[j].Name {
+			return schedules[i].Name < schedules[j].Name
+		}
+		return schedules[i].ID < schedules[j].ID
+	})
+
+	config.Schedules = make([]SchedulesItemModel, 0, len(schedules))
+	for _, schedule := range schedules {
+		if !config.Name.IsNull() && schedule.Name != config.Name.ValueString() {
+			continue
+		}
+
+		holidays, err := d.client.ListScheduleHolidays(ctx, schedule.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Listing Schedule Holidays",
+				fmt.Sprintf("Could not list holidays of schedule %d: %v", schedule.ID, err),
+			)
+			return
+		}
+
+		rawIntervals := make([]ScheduleRawIntervalModel, 0, len(schedule.Intervals))
+		intervals := make([]ScheduleIntervalModel, 0, len(schedule.Intervals))
+		for _, interval := range schedule.Intervals {
+			rawIntervals = append(rawIntervals, ScheduleRawIntervalModel{
+				StartTime: types.Int64Value(interval.StartTime),
+				EndTime:   types.Int64Value(interval.EndTime),
+			})
+
+			day, start, end := scheduleIntervalDay(interval)
+			intervals = append(intervals, ScheduleIntervalModel{
+				Day:       types.StringValue(day),
+				StartTime: types.StringValue(start),
+				EndTime:   types.StringValue(end),
+			})
+		}
+
+		config.Schedules = append(config.Schedules, SchedulesItemModel{
+			ID:            types.StringValue(strconv.FormatInt(schedule.ID, 10)),
+			Name:          types.StringValue(schedule.Name),
+			TimeZone:      types.StringValue(schedule.TimeZone),
+			RawIntervals:  rawIntervals,
+			Intervals:     intervals,
+			HolidaysCount: types.Int64Value(int64(len(holidays))),
+		})
+	}
+
+	if !config.Name.IsNull() && len(config.Schedules) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Schedule Not Found",
+			fmt.Sprintf("No schedule found with name %q.", config.Name.ValueString()),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
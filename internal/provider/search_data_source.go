@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &SearchDataSource{}
+)
+
+func NewSearchDataSource() datasource.DataSource {
+	return &SearchDataSource{}
+}
+
+type SearchDataSource struct {
+	client *Client
+}
+
+type SearchDataSourceModel struct {
+	Query      types.String        `tfsdk:"query"`
+	Type       types.String        `tfsdk:"type"`
+	Limit      types.Int64         `tfsdk:"limit"`
+	TotalCount types.Int64         `tfsdk:"total_count"`
+	Results    []SearchResultModel `tfsdk:"results"`
+}
+
+type SearchResultModel struct {
+	ID      types.String `tfsdk:"id"`
+	Type    types.String `tfsdk:"type"`
+	Name    types.String `tfsdk:"name"`
+	URL     types.String `tfsdk:"url"`
+	RawJSON types.String `tfsdk:"raw_json"`
+}
+
+func (d *SearchDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_search"
+}
+
+func (d *SearchDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Runs a query against the Zendesk search API. The API returns at most %d results per query.", searchResultsCeiling),
+		Attributes: map[string]schema.Attribute{
+			"query": schema.StringAttribute{
+				Description: "The search query (e.g., 'type:user tags:vip').",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Only return results of this type, added to the query as 'type:<type>'.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("ticket", "user", "organization", "group"),
+				},
+			},
+			"limit": schema.Int64Attribute{
+				Description: fmt.Sprintf("The maximum number of results to return. Defaults to %d.", searchResultsCeiling),
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, searchResultsCeiling),
+				},
+			},
+			"total_count": schema.Int64Attribute{
+				Description: "The total number of matches reported by the API, which may exceed the number of results returned.",
+				Computed:    true,
+			},
+			"results": schema.ListNestedAttribute{
+				Description: "The search results, in the order returned by the API.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the result.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of the result (e.g., 'ticket', 'user').",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name, subject or title of the result.",
+							Computed:    true,
+						},
+						"url": schema.StringAttribute{
+							Description: "The API URL of the result.",
+							Computed:    true,
+						},
+						"raw_json": schema.StringAttribute{
+							Description: "The full result, as returned by the API, encoded as JSON.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SearchDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SearchDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config SearchDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := config.Query.ValueString()
+	if !config.Type.IsNull() {
+		query = fmt.Sprintf("type:%s %s", config.Type.ValueString(), query)
+	}
+
+	limit := searchResultsCeiling
+	if !config.Limit.IsNull() {
+		limit = int(config.Limit.ValueInt64())
+	}
+
+	results, count, err := d.client.Search(ctx, query, limit)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Searching",
+			fmt.Sprintf("Could not run search query %q: %v", query, err),
+		)
+		return
+	}
+
+	if count > searchResultsCeiling && len(results) == searchResultsCeiling {
+		resp.Diagnostics.AddWarning(
+			"Search Result Ceiling Reached",
+			fmt.Sprintf("The query matched %d results, but the search API only returns the first %d. Narrow the query to get a complete result.", count, searchResultsCeiling),
+		)
+	}
+
+	config.TotalCount = types.Int64Value(count)
+	config.Results = make([]SearchResultModel, 0, len(results))
+	for _, result := range results {
+		name := result.Name
+		if name == "" {
+			name = result.Subject
+		}
+		if name == "" {
+			name = result.Title
+		}
+
+		var raw bytes.Buffer
+		if err := json.Compact(&raw, result.Raw); err != nil {
+			raw.Reset()
+			raw.Write(result.Raw)
+		}
+
+		config.Results = append(config.Results, SearchResultModel{
+			ID:      types.StringValue(strconv.FormatInt(result.ID, 10)),
+			Type:    types.StringValue(result.ResultType),
+			Name:    types.StringValue(name),
+			URL:     types.StringValue(result.URL),
+			RawJSON: types.StringValue(raw.String()),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &SharingAgreementsDataSource{}
+)
+
+func NewSharingAgreementsDataSource() datasource.DataSource {
+	return &SharingAgreementsDataSource{}
+}
+
+type SharingAgreementsDataSource struct {
+	client *Client
+}
+
+type SharingAgreementsDataSourceModel struct {
+	Status     types.String                 `tfsdk:"status"`
+	Agreements []SharingAgreementsItemModel `tfsdk:"agreements"`
+}
+
+type SharingAgreementsItemModel struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Type            types.String `tfsdk:"type"`
+	Status          types.String `tfsdk:"status"`
+	RemoteSubdomain types.String `tfsdk:"remote_subdomain"`
+}
+
+func (d *SharingAgreementsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sharing_agreements"
+}
+
+func (d *SharingAgreementsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists Zendesk ticket sharing agreements, sorted by name.",
+		Attributes: map[string]schema.Attribute{
+			"status": schema.StringAttribute{
+				Description: "Only return agreements with this status.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("accepted", "declined", "pending", "inactive"),
+				},
+			},
+			"agreements": schema.ListNestedAttribute{
+				Description: "The sharing agreements.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the sharing agreement.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the sharing agreement.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The direction of the agreement, 'inbound' or 'outbound'.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The status of the agreement.",
+							Computed:    true,
+						},
+						"remote_subdomain": schema.StringAttribute{
+							Description: "The subdomain of the partner Zendesk instance.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SharingAgreementsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SharingAgreementsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config SharingAgreementsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	agreements, err := d.client.ListSharingAgreements(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Sharing Agreements",
+			fmt.Sprintf("Could not list sharing agreements: %v", err),
+		)
+		return
+	}
+
+	sort.SliceStable(agreements, func(i, j int) bool {
+		if agreements[i].Name != agreements[j].Name {
+			return agreements[i].Name < agreements[j].Name
+		}
+		return agreements[i].ID < agreements[j].ID
+	})
+
+	config.Agreements = make([]SharingAgreementsItemModel, 0, len(agreements))
+	for _, agreement := range agreements {
+		if !config.Status.IsNull() && agreement.Status != config.Status.ValueString() {
+			continue
+		}
+
+		config.Agreements = append(config.Agreements, SharingAgreementsItemModel{
+			ID:              types.StringValue(strconv.FormatInt(agreement.ID, 10)),
+			Name:            types.StringValue(agreement.Name),
+			Type:            types.StringValue(agreement.Type),
+			Status:          types.StringValue(agreement.Status),
+			RemoteSubdomain: types.StringValue(agreement.RemoteSubdomain),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
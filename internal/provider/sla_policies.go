@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SLAPolicyMetricModel is a target of an SLA or group SLA policy. Both resources share it so
+// that configurations can be copied between them.
+type SLAPolicyMetricModel struct {
+	Priority      types.String `tfsdk:"priority"`
+	Metric        types.String `tfsdk:"metric"`
+	Target        types.Int64  `tfsdk:"target"`
+	BusinessHours types.Bool   `tfsdk:"business_hours"`
+}
+
+func slaPolicyMetricsAttribute(metricDescription string) schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		Description: "The targets of the policy, one per priority and metric.",
+		Required:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"priority": schema.StringAttribute{
+					Description: "The ticket priority the target applies to.",
+					Required:    true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("low", "normal", "high", "urgent"),
+					},
+				},
+				"metric": schema.StringAttribute{
+					Description: metricDescription,
+					Required:    true,
+				},
+				"target": schema.Int64Attribute{
+					Description: "The target, in minutes.",
+					Required:    true,
+					Validators: []validator.Int64{
+						int64validator.AtLeast(1),
+					},
+				},
+				"business_hours": schema.BoolAttribute{
+					Description: "Whether the target is measured in business hours. Defaults to false.",
+					Optional:    true,
+					Computed:    true,
+					Default:     booldefault.StaticBool(false),
+				},
+			},
+		},
+	}
+}
+
+func expandSLAPolicyMetrics(models []SLAPolicyMetricModel) []SLAPolicyMetric {
+	metrics := make([]SLAPolicyMetric, 0, len(models))
+	for _, metric := range models {
+		metrics = append(metrics, SLAPolicyMetric{
+			Priority:      metric.Priority.ValueString(),
+			Metric:        metric.Metric.ValueString(),
+			Target:        metric.Target.ValueInt64(),
+			BusinessHours: metric.BusinessHours.ValueBool(),
+		})
+	}
+	return metrics
+}
+
+// flattenSLAPolicyMetrics maps the targets back to the model. policy_metrics is a set, so the
+// order Zendesk returns the targets in does not matter.
+func flattenSLAPolicyMetrics(metrics []SLAPolicyMetric) []SLAPolicyMetricModel {
+	models := make([]SLAPolicyMetricModel, 0, len(metrics))
+	for _, metric := range metrics {
+		models = append(models, SLAPolicyMetricModel{
+			Priority:      types.StringValue(metric.Priority),
+			Metric:        types.StringValue(metric.Metric),
+			Target:        types.Int64Value(metric.Target),
+			BusinessHours: types.BoolValue(metric.BusinessHours),
+		})
+	}
+	return models
+}
@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &SLAPoliciesDataSource{}
+)
+
+func NewSLAPoliciesDataSource() datasource.DataSource {
+	return &SLAPoliciesDataSource{}
+}
+
+type SLAPoliciesDataSource struct {
+	client *Client
+}
+
+type SLAPoliciesDataSourceModel struct {
+	Title    types.String           `tfsdk:"title"`
+	Policies []SLAPoliciesItemModel `tfsdk:"policies"`
+}
+
+type SLAPoliciesItemModel struct {
+	ID            types.String           `tfsdk:"id"`
+	Title         types.String           `tfsdk:"title"`
+	Position      types.Int64            `tfsdk:"position"`
+	FilterJSON    types.String           `tfsdk:"filter_json"`
+	PolicyMetrics []SLAPolicyMetricModel `tfsdk:"policy_metrics"`
+}
+
+func (d *SLAPoliciesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sla_policies"
+}
+
+func (d *SLAPoliciesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists Zendesk SLA policies, ordered by position.",
+		Attributes: map[string]schema.Attribute{
+			"title": schema.StringAttribute{
+				Description: "Only return the policies with this exact title. An error is returned if none match.",
+				Optional:    true,
+			},
+			"policies": schema.ListNestedAttribute{
+				Description: "The SLA policies.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the SLA policy.",
+							Computed:    true,
+						},
+						"title": schema.StringAttribute{
+							Description: "The title of the SLA policy.",
+							Computed:    true,
+						},
+						"position": schema.Int64Attribute{
+							Description: "The position of the SLA policy.",
+							Computed:    true,
+						},
+						"filter_json": schema.StringAttribute{
+							Description: "The conditions a ticket must meet for the policy to apply, as returned by the API, encoded as JSON.",
+							Computed:    true,
+						},
+						"policy_metrics": schema.ListNestedAttribute{
+							Description: "The targets of the policy.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"priority": schema.StringAttribute{
+										Description: "The ticket priority the target applies to.",
+										Computed:    true,
+									},
+									"metric": schema.StringAttribute{
+										Description: "The metric (e.g., 'first_reply_time').",
+										Computed:    true,
+									},
+									"target": schema.Int64Attribute{
+										Description: "The target, in minutes.",
+										Computed:    true,
+									},
+									"business_hours": schema.BoolAttribute{
+										Description: "Whether the target is measured in business hours.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SLAPoliciesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SLAPoliciesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config SLAPoliciesDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policies, err := d.client.ListSLAPolicies(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing SLA Policies",
+			fmt.Sprintf("Could not list SLA policies: %v", err),
+		)
+		return
+	}
+
+	sort.SliceStable(policies, func(i, j int) bool {
+		if positionValue(policies[i].Position) != positionValue(policies[j].Position) {
+			return positionValue(policies[i].Position) < positionValue(policies[j].Position)
+		}
+		return policies[i].ID < policies[j].ID
+	})
+
+	config.Policies = make([]SLAPoliciesItemModel, 0, len(policies))
+	for _, policy := range policies {
+		if !config.Title.IsNull() && policy.Title != config.Title.ValueString() {
+			continue
+		}
+
+		config.Policies = append(config.Policies, SLAPoliciesItemModel{
+			ID:            types.StringValue(strconv.FormatInt(policy.ID, 10)),
+			Title:         types.StringValue(policy.Title),
+			Position:      types.Int64Value(positionValue(policy.Position)),
+			FilterJSON:    jsonStringValue(policy.Filter),
+			PolicyMetrics: flattenSLAPolicyMetrics(policy.PolicyMetrics),
+		})
+	}
+
+	if !config.Title.IsNull() && len(config.Policies) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("title"),
+			"SLA Policy Not Found",
+			fmt.Sprintf("No SLA policy found with title %q.", config.Title.ValueString()),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
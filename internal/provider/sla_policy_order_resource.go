@@ -0,0 +1,263 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &SLAPolicyOrderResource{}
+	_ resource.ResourceWithImportState = &SLAPolicyOrderResource{}
+	_ resource.ResourceWithModifyPlan  = &SLAPolicyOrderResource{}
+)
+
+// slaPolicyOrderID is the fixed ID of the SLA policy order, which is a singleton.
+const slaPolicyOrderID = "sla_policy_order"
+
+func NewSLAPolicyOrderResource() resource.Resource {
+	return &SLAPolicyOrderResource{}
+}
+
+type SLAPolicyOrderResource struct {
+	client *Client
+}
+
+type SLAPolicyOrderResourceModel struct {
+	ID        types.String   `tfsdk:"id"`
+	PolicyIDs []types.String `tfsdk:"policy_ids"`
+}
+
+func (r *SLAPolicyOrderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sla_policy_order"
+}
+
+func (r *SLAPolicyOrderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the order in which SLA policies are matched, applied in a single request. The order must list every SLA policy of the account. Policies ordered here should not set position.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: fmt.Sprintf("Always %q, as the account has a single SLA policy order.", slaPolicyOrderID),
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy_ids": schema.ListAttribute{
+				Description: "The IDs of all the SLA policies, in the order they are matched. The first policy whose filter matches a ticket applies.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *SLAPolicyOrderResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan rejects policies listed twice, and warns about policies that also set their own
+// position.
+func (r *SLAPolicyOrderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan SLAPolicyOrderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attr := path.Root("policy_ids")
+	resp.Diagnostics.Append(duplicateIDDiagnostics(attr, plan.PolicyIDs, map[string]path.Path{})...)
+
+	if r.client == nil {
+		return
+	}
+	for i, id := range plan.PolicyIDs {
+		if !id.IsUnknown() && r.client.positionClaims.claimOrder("SLA policy", id.ValueString()) {
+			resp.Diagnostics.Append(positionConflictWarning(attr.AtListIndex(i), "SLA policy", id.ValueString(), "zendesk_sla_policy_order"))
+		}
+	}
+}
+
+func (r *SLAPolicyOrderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan SLAPolicyOrderResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(slaPolicyOrderID)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *SLAPolicyOrderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SLAPolicyOrderResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policies, err := r.client.ListSLAPolicies(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SLA Policy Order",
+			fmt.Sprintf("Could not list SLA policies: %v", err),
+		)
+		return
+	}
+
+	flattenSLAPolicyOrder(policies, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *SLAPolicyOrderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan SLAPolicyOrderResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(slaPolicyOrderID)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete leaves the SLA policies in their current order.
+func (r *SLAPolicyOrderResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// ImportState reads the order of the SLA policies.
+func (r *SLAPolicyOrderResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), slaPolicyOrderID)...)
+}
+
+// apply checks the order against the policies of the account, as the API rejects an order that
+// does not list all of them, and then reorders them.
+func (r *SLAPolicyOrderResource) apply(ctx context.Context, model SLAPolicyOrderResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ids, d := expandIDList(model.PolicyIDs, path.Root("policy_ids"))
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	policies, err := r.client.ListSLAPolicies(ctx)
+	if err != nil {
+		diags.AddError(
+			"Error Ordering SLA Policies",
+			fmt.Sprintf("Could not list SLA policies: %v", err),
+		)
+		return diags
+	}
+
+	listed := make(map[int64]bool, len(ids))
+	for _, id := range ids {
+		listed[id] = true
+	}
+
+	existing := make(map[int64]bool, len(policies))
+	var missing []string
+	for _, policy := range policies {
+		existing[policy.ID] = true
+		if !listed[policy.ID] {
+			missing = append(missing, fmt.Sprintf("%d (%s)", policy.ID, policy.Title))
+		}
+	}
+
+	for i, id := range ids {
+		if !existing[id] {
+			diags.AddAttributeError(
+				path.Root("policy_ids").AtListIndex(i),
+				"SLA Policy Not Found",
+				fmt.Sprintf("No SLA policy found with ID %d, at index %d.", id, i),
+			)
+		}
+	}
+	if len(missing) > 0 {
+		diags.AddAttributeError(
+			path.Root("policy_ids"),
+			"Incomplete SLA Policy Order",
+			fmt.Sprintf("Zendesk can only reorder all the SLA policies at once, but these are not listed: %s.", strings.Join(missing, ", ")),
+		)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := r.client.ReorderSLAPolicies(ctx, ids); err != nil {
+		diags.AddError(
+			"Error Ordering SLA Policies",
+			fmt.Sprintf("Could not reorder SLA policies: %v", err),
+		)
+	}
+	return diags
+}
+
+// flattenSLAPolicyOrder rebuilds the order of the SLA policies from their positions. As the
+// order lists all the policies, a policy created outside Terraform shows as a diff.
+func flattenSLAPolicyOrder(policies []SLAPolicy, model *SLAPolicyOrderResourceModel) {
+	sort.SliceStable(policies, func(i, j int) bool {
+		if positionValue(policies[i].Position) != positionValue(policies[j].Position) {
+			return positionValue(policies[i].Position) < positionValue(policies[j].Position)
+		}
+		return policies[i].ID < policies[j].ID
+	})
+
+	ids := make([]types.String, 0, len(policies))
+	for _, policy := range policies {
+		ids = append(ids, types.StringValue(strconv.FormatInt(policy.ID, 10)))
+	}
+
+	model.ID = types.StringValue(slaPolicyOrderID)
+	model.PolicyIDs = ids
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+const testSLAPolicyOrderState = `{"id": "sla_policy_order", "policy_ids": ["1000001", "1000003"]}`
+
+func TestFlattenSLAPolicyOrder(t *testing.T) {
+	var model SLAPolicyOrderResourceModel
+	flattenSLAPolicyOrder([]SLAPolicy{
+		{ID: 1000003, Position: int64Pointer(2)},
+		{ID: 1000002, Position: int64Pointer(1)},
+		{ID: 1000001, Position: int64Pointer(2)},
+	}, &model)
+
+	if model.ID.ValueString() != slaPolicyOrderID {
+		t.Errorf("expected ID %q, got %s", slaPolicyOrderID, model.ID)
+	}
+	if got := joinStrings(model.PolicyIDs); got != "1000002,1000001,1000003" {
+		t.Errorf("expected the policies sorted by position, then ID, got %s", got)
+	}
+}
+
+func TestSLAPolicyOrderValidation(t *testing.T) {
+	p := newProtocolTest(t, "")
+
+	if diags := p.validate("zendesk_sla_policy_order", `{"policy_ids": []}`); !hasProtocolError(diags, "Invalid Attribute Value") {
+		t.Errorf("expected an error for an empty order, got %v", diags)
+	}
+
+	diags := p.planDiagnostics("zendesk_sla_policy_order", `{"policy_ids": ["1000001", "1000002", "1000001"]}`, nil)
+	if !hasProtocolError(diags, "Duplicate ID") {
+		t.Errorf("expected an error for a policy listed twice, got %v", diags)
+	}
+}
+
+// Inserting a policy in the middle of the order reorders all the policies with a single
+// request, which the fixture checks by failing on any other request.
+func TestSLAPolicyOrderInsert(t *testing.T) {
+	p := newProtocolTest(t, "sla_policy_order_insert.json")
+
+	state := p.config("zendesk_sla_policy_order", testSLAPolicyOrderState)
+	state = p.apply("zendesk_sla_policy_order", `{"policy_ids": ["1000001", "1000002", "1000003"]}`, state)
+
+	state = p.refresh("zendesk_sla_policy_order", state)
+	p.planUnchanged("zendesk_sla_policy_order", `{"policy_ids": ["1000001", "1000002", "1000003"]}`, state)
+}
+
+func TestSLAPolicyOrderIncomplete(t *testing.T) {
+	p := newProtocolTest(t, "sla_policy_order_incomplete.json")
+
+	state := p.config("zendesk_sla_policy_order", testSLAPolicyOrderState)
+	diags := p.applyDiagnostics("zendesk_sla_policy_order", `{"policy_ids": ["1000001", "1000003", "1000009"]}`, state)
+
+	if !hasProtocolError(diags, "Incomplete SLA Policy Order") {
+		t.Fatalf("expected an error for the missing policy, got %v", diags)
+	}
+	for _, d := range diags {
+		if d.Summary == "Incomplete SLA Policy Order" && !strings.Contains(d.Detail, "1000002 (VIP customers)") {
+			t.Errorf("expected the error to name the missing policy, got %q", d.Detail)
+		}
+	}
+	if !hasProtocolError(diags, "SLA Policy Not Found") {
+		t.Errorf("expected an error for the unknown policy, got %v", diags)
+	}
+}
@@ -0,0 +1,275 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &SLAPolicyResource{}
+	_ resource.ResourceWithImportState = &SLAPolicyResource{}
+	_ resource.ResourceWithModifyPlan  = &SLAPolicyResource{}
+)
+
+func NewSLAPolicyResource() resource.Resource {
+	return &SLAPolicyResource{}
+}
+
+type SLAPolicyResource struct {
+	client *Client
+}
+
+type SLAPolicyResourceModel struct {
+	ID            types.String           `tfsdk:"id"`
+	Title         types.String           `tfsdk:"title"`
+	Description   types.String           `tfsdk:"description"`
+	Position      types.Int64            `tfsdk:"position"`
+	Filter        *RuleConditionsModel   `tfsdk:"filter"`
+	PolicyMetrics []SLAPolicyMetricModel `tfsdk:"policy_metrics"`
+}
+
+func (r *SLAPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sla_policy"
+}
+
+func (r *SLAPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Zendesk SLA policy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the SLA policy.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "The title of the SLA policy.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the SLA policy.",
+				Optional:    true,
+			},
+			"position": schema.Int64Attribute{
+				Description: "The position of the SLA policy, which determines the order in which policies are matched.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"filter":         ruleConditionsAttribute("The conditions a ticket must meet for the policy to apply."),
+			"policy_metrics": slaPolicyMetricsAttribute("The metric (e.g., 'first_reply_time', 'next_reply_time')."),
+		},
+	}
+}
+
+func (r *SLAPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan warns about a position also managed by zendesk_sla_policy_order.
+func (r *SLAPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var id types.String
+	var position types.Int64
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("position"), &position)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !position.IsNull() && !id.IsUnknown() && r.client.positionClaims.claimPosition("SLA policy", id.ValueString()) {
+		resp.Diagnostics.Append(positionConflictWarning(path.Root("position"), "SLA policy", id.ValueString(), "zendesk_sla_policy_order"))
+	}
+}
+
+func (r *SLAPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan SLAPolicyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	position := expandOptionalInt64(plan.Position)
+	policy, err := r.client.CreateSLAPolicy(ctx, expandSLAPolicy(plan, position))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating SLA Policy",
+			fmt.Sprintf("Could not create SLA policy: %v", err),
+		)
+		return
+	}
+
+	planned := plan.Position
+	flattenSLAPolicy(policy, &plan)
+	if position == nil && !planned.IsUnknown() {
+		plan.Position = planned
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *SLAPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SLAPolicyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing SLA Policy ID",
+			fmt.Sprintf("Could not parse SLA policy ID: %v", err),
+		)
+		return
+	}
+
+	policy, err := r.client.ReadSLAPolicy(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SLA Policy",
+			fmt.Sprintf("Could not read SLA policy: %v", err),
+		)
+		return
+	}
+
+	if policy == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	flattenSLAPolicy(policy, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *SLAPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan SLAPolicyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The planned position of a policy that does not configure one is the prior state, which
+	// zendesk_sla_policy_order may have changed since, so only the configured position is sent.
+	var configured types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("position"), &configured)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(plan.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing SLA Policy ID",
+			fmt.Sprintf("Could not parse SLA policy ID: %v", err),
+		)
+		return
+	}
+
+	position := expandOptionalInt64(configured)
+	policy, err := r.client.UpdateSLAPolicy(ctx, id, expandSLAPolicy(plan, position))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating SLA Policy",
+			fmt.Sprintf("Could not update SLA policy: %v", err),
+		)
+		return
+	}
+
+	planned := plan.Position
+	flattenSLAPolicy(policy, &plan)
+	if position == nil && !planned.IsUnknown() {
+		plan.Position = planned
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *SLAPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state SLAPolicyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing SLA Policy ID",
+			fmt.Sprintf("Could not parse SLA policy ID: %v", err),
+		)
+		return
+	}
+
+	err = r.client.DeleteSLAPolicy(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting SLA Policy",
+			fmt.Sprintf("Could not delete SLA policy: %v", err),
+		)
+		return
+	}
+}
+
+func (r *SLAPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// expandSLAPolicy builds the request body of an SLA policy. A nil position leaves the position
+// of the policy unchanged.
+func expandSLAPolicy(model SLAPolicyResourceModel, position *int64) SLAPolicy {
+	return SLAPolicy{
+		Title:         model.Title.ValueString(),
+		Description:   model.Description.ValueString(),
+		Position:      position,
+		Filter:        expandRuleConditions(model.Filter),
+		PolicyMetrics: expandSLAPolicyMetrics(model.PolicyMetrics),
+	}
+}
+
+func flattenSLAPolicy(policy *SLAPolicy, model *SLAPolicyResourceModel) {
+	model.ID = types.StringValue(strconv.FormatInt(policy.ID, 10))
+	model.Title = types.StringValue(policy.Title)
+	if policy.Description != "" || !model.Description.IsNull() {
+		model.Description = types.StringValue(policy.Description)
+	}
+	model.Position = types.Int64Value(positionValue(policy.Position))
+	model.Filter = flattenRuleConditions(policy.Filter, model.Filter)
+	model.PolicyMetrics = flattenSLAPolicyMetrics(policy.PolicyMetrics)
+}
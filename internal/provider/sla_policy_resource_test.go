@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const testSLAPolicyMetrics = `[
+	{"priority": "urgent", "metric": "first_reply_time", "target": 30},
+	{"priority": "urgent", "metric": "next_reply_time", "target": 60},
+	{"priority": "high", "metric": "first_reply_time", "target": 120, "business_hours": true},
+	{"priority": "high", "metric": "next_reply_time", "target": 240, "business_hours": true}
+]`
+
+func TestSLAPolicyMetricsRoundTrip(t *testing.T) {
+	models := []SLAPolicyMetricModel{
+		{Priority: types.StringValue("urgent"), Metric: types.StringValue("first_reply_time"), Target: types.Int64Value(30), BusinessHours: types.BoolValue(false)},
+		{Priority: types.StringValue("high"), Metric: types.StringValue("next_reply_time"), Target: types.Int64Value(240), BusinessHours: types.BoolValue(true)},
+	}
+
+	metrics := expandSLAPolicyMetrics(models)
+	if len(metrics) != 2 || metrics[1] != (SLAPolicyMetric{Priority: "high", Metric: "next_reply_time", Target: 240, BusinessHours: true}) {
+		t.Fatalf("unexpected metrics %+v", metrics)
+	}
+
+	flattened := flattenSLAPolicyMetrics(metrics)
+	if len(flattened) != len(models) {
+		t.Fatalf("expected %d metrics, got %d", len(models), len(flattened))
+	}
+	for i := range models {
+		if flattened[i] != models[i] {
+			t.Errorf("metric %d: expected %+v, got %+v", i, models[i], flattened[i])
+		}
+	}
+}
+
+// Zendesk returns the targets in its own order, which policy_metrics, a set, ignores.
+func TestSLAPolicyMetricsReordered(t *testing.T) {
+	p := newProtocolTest(t, "sla_policy_metrics_reordered.json")
+
+	state := p.read("zendesk_sla_policy", `{
+		"id": "1000001", "title": "Urgent and high", "position": 1,
+		"filter": {"all": [{"field": "type", "operator": "is", "value": "incident"}]},
+		"policy_metrics": [
+			{"priority": "urgent", "metric": "first_reply_time", "target": 30, "business_hours": false},
+			{"priority": "urgent", "metric": "next_reply_time", "target": 60, "business_hours": false},
+			{"priority": "high", "metric": "first_reply_time", "target": 120, "business_hours": true},
+			{"priority": "high", "metric": "next_reply_time", "target": 240, "business_hours": true}
+		]
+	}`)
+
+	p.planUnchanged("zendesk_sla_policy", `{
+		"title": "Urgent and high",
+		"filter": {"all": [{"field": "type", "operator": "is", "value": "incident"}]},
+		"policy_metrics": `+testSLAPolicyMetrics+`
+	}`, state)
+}
+
+func TestSLAPolicyMetricsValidation(t *testing.T) {
+	p := newProtocolTest(t, "")
+
+	if diags := p.validate("zendesk_sla_policy", `{"title": "Urgent", "filter": {"all": []}, "policy_metrics": `+testSLAPolicyMetrics+`}`); len(diags) > 0 {
+		t.Errorf("unexpected diagnostics: %v", diags[0])
+	}
+
+	for name, metric := range map[string]string{
+		"zero target":      `{"priority": "urgent", "metric": "first_reply_time", "target": 0}`,
+		"negative target":  `{"priority": "urgent", "metric": "first_reply_time", "target": -30}`,
+		"unknown priority": `{"priority": "critical", "metric": "first_reply_time", "target": 30}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			diags := p.validate("zendesk_sla_policy", `{"title": "Urgent", "policy_metrics": [`+metric+`]}`)
+			if !hasProtocolError(diags, "Invalid Attribute Value") && !hasProtocolError(diags, "Invalid Attribute Value Match") {
+				t.Errorf("expected a validation error, got %v", diags)
+			}
+		})
+	}
+}
+
+// The planned position of a policy without a configured one comes from the prior state and is
+// not sent, so that it does not undo an order set by zendesk_sla_policy_order. The fixture fails
+// the test if the request body has a position.
+func TestSLAPolicyUpdateWithoutPosition(t *testing.T) {
+	p := newProtocolTest(t, "sla_policy_update_without_position.json")
+
+	state := p.read("zendesk_sla_policy", `{
+		"id": "1000001", "title": "Incidents", "position": 1,
+		"filter": {"all": [{"field": "type", "operator": "is", "value": "incident"}]},
+		"policy_metrics": [{"priority": "urgent", "metric": "first_reply_time", "target": 30, "business_hours": false}]
+	}`)
+
+	p.apply("zendesk_sla_policy", `{
+		"title": "Incidents SLA",
+		"filter": {"all": [{"field": "type", "operator": "is", "value": "incident"}]},
+		"policy_metrics": [{"priority": "urgent", "metric": "first_reply_time", "target": 30}]
+	}`, state)
+}
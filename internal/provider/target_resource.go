@@ -0,0 +1,516 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                 = &TargetResource{}
+	_ resource.ResourceWithImportState  = &TargetResource{}
+	_ resource.ResourceWithModifyPlan   = &TargetResource{}
+	_ resource.ResourceWithUpgradeState = &TargetResource{}
+)
+
+// targetTypeAttributes lists, per target type, the type-specific attributes that may be set and
+// whether each is required.
+var targetTypeAttributes = map[string]map[string]bool{
+	"http_target": {
+		"target_url":   true,
+		"method":       false,
+		"content_type": false,
+		"username":     false,
+		"password":     false,
+	},
+	"url_target_v2": {
+		"target_url":   true,
+		"method":       false,
+		"content_type": false,
+		"attribute":    false,
+		"username":     false,
+		"password":     false,
+	},
+	"email_target": {
+		"email":   true,
+		"subject": true,
+	},
+}
+
+func NewTargetResource() resource.Resource {
+	return &TargetResource{}
+}
+
+type TargetResource struct {
+	client *Client
+}
+
+type TargetResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Type              types.String `tfsdk:"type"`
+	Title             types.String `tfsdk:"title"`
+	Active            types.Bool   `tfsdk:"active"`
+	TargetURL         types.String `tfsdk:"target_url"`
+	Method            types.String `tfsdk:"method"`
+	ContentType       types.String `tfsdk:"content_type"`
+	Attribute         types.String `tfsdk:"attribute"`
+	Username          types.String `tfsdk:"username"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+	Email             types.String `tfsdk:"email"`
+	Subject           types.String `tfsdk:"subject"`
+}
+
+// typeSpecificValues returns the type-specific attributes of the model by name.
+func (m TargetResourceModel) typeSpecificValues() map[string]types.String {
+	return map[string]types.String{
+		"target_url":   m.TargetURL,
+		"method":       m.Method,
+		"content_type": m.ContentType,
+		"attribute":    m.Attribute,
+		"username":     m.Username,
+		"password":     m.Password,
+		"email":        m.Email,
+		"subject":      m.Subject,
+	}
+}
+
+func (r *TargetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_target"
+}
+
+func (r *TargetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a legacy Zendesk notification target. New integrations should use webhooks instead.",
+		Version:     1,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the target.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of the target: 'http_target', 'url_target_v2' or 'email_target'.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("http_target", "url_target_v2", "email_target"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "The title of the target.",
+				Required:    true,
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether the target is active. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"target_url": schema.StringAttribute{
+				Description: "The URL of an HTTP or URL target.",
+				Optional:    true,
+			},
+			"method": schema.StringAttribute{
+				Description: "The HTTP method of an HTTP or URL target.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("get", "post", "put", "patch", "delete"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"content_type": schema.StringAttribute{
+				Description: "The content type of an HTTP or URL target.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("application/json", "application/xml", "application/x-www-form-urlencoded"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"attribute": schema.StringAttribute{
+				Description: "The name of the parameter a URL target sends the message in.",
+				Optional:    true,
+			},
+			"username": schema.StringAttribute{
+				Description: "The username for basic authentication of an HTTP or URL target.",
+				Optional:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "The password for basic authentication of an HTTP or URL target. Write-only: it is sent to Zendesk but never stored in state. Change password_wo_version to send a new password.",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Description: "The version of the password. Changing it updates the target with the configured password.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("password")),
+				},
+			},
+			"email": schema.StringAttribute{
+				Description: "The recipient of an email target.",
+				Optional:    true,
+			},
+			"subject": schema.StringAttribute{
+				Description: "The subject of the emails of an email target.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *TargetResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan checks that the configuration only sets the attributes of the chosen target type,
+// and all of its required ones.
+func (r *TargetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config TargetResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Type.IsUnknown() {
+		return
+	}
+
+	targetType := config.Type.ValueString()
+	allowed, ok := targetTypeAttributes[targetType]
+	if !ok {
+		return
+	}
+
+	values := config.typeSpecificValues()
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		required, isAllowed := allowed[name]
+		switch {
+		case !isAllowed && !values[name].IsNull():
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid Target Attribute",
+				fmt.Sprintf("%q cannot be set on targets of type %q.", name, targetType),
+			)
+		case required && values[name].IsNull():
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Missing Target Attribute",
+				fmt.Sprintf("%q is required for targets of type %q.", name, targetType),
+			)
+		}
+	}
+}
+
+func (r *TargetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TargetResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The password is write-only, so it is only in the configuration.
+	var password types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	target, err := r.client.CreateTarget(ctx, expandTarget(plan, password))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Target",
+			fmt.Sprintf("Could not create target: %v", err),
+		)
+		return
+	}
+
+	flattenTarget(target, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *TargetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TargetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Target ID",
+			fmt.Sprintf("Could not parse target ID: %v", err),
+		)
+		return
+	}
+
+	target, err := r.client.ReadTarget(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Target",
+			fmt.Sprintf("Could not read target: %v", err),
+		)
+		return
+	}
+
+	if target == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	flattenTarget(target, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *TargetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TargetResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(plan.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Target ID",
+			fmt.Sprintf("Could not parse target ID: %v", err),
+		)
+		return
+	}
+
+	var password types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	target, err := r.client.UpdateTarget(ctx, id, expandTarget(plan, password))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Target",
+			fmt.Sprintf("Could not update target: %v", err),
+		)
+		return
+	}
+
+	flattenTarget(target, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *TargetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TargetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Target ID",
+			fmt.Sprintf("Could not parse target ID: %v", err),
+		)
+		return
+	}
+
+	err = r.client.DeleteTarget(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Target",
+			fmt.Sprintf("Could not delete target: %v", err),
+		)
+		return
+	}
+}
+
+func (r *TargetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// expandTarget builds the request body of a target, with the password read from the
+// configuration.
+func expandTarget(model TargetResourceModel, password types.String) Target {
+	target := Target{
+		Type:        model.Type.ValueString(),
+		Title:       model.Title.ValueString(),
+		Active:      model.Active.ValueBool(),
+		Method:      model.Method.ValueString(),
+		ContentType: model.ContentType.ValueString(),
+		Attribute:   model.Attribute.ValueString(),
+		Username:    model.Username.ValueString(),
+		Password:    password.ValueString(),
+		Email:       model.Email.ValueString(),
+		Subject:     model.Subject.ValueString(),
+	}
+
+	if target.Type == "url_target_v2" {
+		target.URL = model.TargetURL.ValueString()
+	} else {
+		target.TargetURL = model.TargetURL.ValueString()
+	}
+
+	return target
+}
+
+// flattenTarget refreshes the model from target. The password is never returned, and is write-only
+// anyway, so it is left null.
+func flattenTarget(target *Target, model *TargetResourceModel) {
+	model.ID = types.StringValue(strconv.FormatInt(target.ID, 10))
+	model.Type = types.StringValue(target.Type)
+	model.Title = types.StringValue(target.Title)
+	model.Active = types.BoolValue(target.Active)
+	model.Method = optionalStringValue(target.Method)
+	model.ContentType = optionalStringValue(target.ContentType)
+	model.Attribute = optionalStringValue(target.Attribute)
+	model.Username = optionalStringValue(target.Username)
+	model.Email = optionalStringValue(target.Email)
+	model.Subject = optionalStringValue(target.Subject)
+
+	if target.URL != "" {
+		model.TargetURL = types.StringValue(target.URL)
+	} else {
+		model.TargetURL = optionalStringValue(target.TargetURL)
+	}
+}
+
+// targetResourceModelV0 is the state of schema version 0, which stored the password.
+type targetResourceModelV0 struct {
+	ID          types.String `tfsdk:"id"`
+	Type        types.String `tfsdk:"type"`
+	Title       types.String `tfsdk:"title"`
+	Active      types.Bool   `tfsdk:"active"`
+	TargetURL   types.String `tfsdk:"target_url"`
+	Method      types.String `tfsdk:"method"`
+	ContentType types.String `tfsdk:"content_type"`
+	Attribute   types.String `tfsdk:"attribute"`
+	Username    types.String `tfsdk:"username"`
+	Password    types.String `tfsdk:"password"`
+	Email       types.String `tfsdk:"email"`
+	Subject     types.String `tfsdk:"subject"`
+}
+
+func (r *TargetResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	optional := func() schema.StringAttribute {
+		return schema.StringAttribute{Optional: true}
+	}
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed: true,
+					},
+					"type": schema.StringAttribute{
+						Required: true,
+					},
+					"title": schema.StringAttribute{
+						Required: true,
+					},
+					"active": schema.BoolAttribute{
+						Optional: true,
+						Computed: true,
+					},
+					"target_url": optional(),
+					"method": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+					},
+					"content_type": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+					},
+					"attribute": optional(),
+					"username":  optional(),
+					"password": schema.StringAttribute{
+						Optional:  true,
+						Sensitive: true,
+					},
+					"email":   optional(),
+					"subject": optional(),
+				},
+			},
+			StateUpgrader: upgradeTargetStateV0,
+		},
+	}
+}
+
+// upgradeTargetStateV0 drops the password from the state. It stays set in Zendesk.
+func upgradeTargetStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior targetResourceModelV0
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := TargetResourceModel{
+		ID:                prior.ID,
+		Type:              prior.Type,
+		Title:             prior.Title,
+		Active:            prior.Active,
+		TargetURL:         prior.TargetURL,
+		Method:            prior.Method,
+		ContentType:       prior.ContentType,
+		Attribute:         prior.Attribute,
+		Username:          prior.Username,
+		Password:          types.StringNull(),
+		PasswordWOVersion: types.Int64Null(),
+		Email:             prior.Email,
+		Subject:           prior.Subject,
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
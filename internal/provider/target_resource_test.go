@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func testTargetConfig(password string, version int) string {
+	return fmt.Sprintf(`{
+		"type": "http_target", "title": "Status page", "target_url": "https://status.example.com/hooks/zendesk",
+		"method": "post", "content_type": "application/json", "username": "zendesk",
+		"password": %q, "password_wo_version": %d
+	}`, password, version)
+}
+
+// The password is sent on create, and again when its version changes, but never stored in
+// state. The fixture fails the test if a request body does not have the configured password.
+func TestTargetPasswordWriteOnly(t *testing.T) {
+	p := newProtocolTest(t, "target_password_write_only.json")
+
+	state := p.apply("zendesk_target", testTargetConfig("REDACTED", 1), nil)
+	if got := p.attribute("zendesk_target", state, "password"); !got.IsNull() {
+		t.Errorf("expected no password in state, got %s", got)
+	}
+
+	state = p.apply("zendesk_target", testTargetConfig("REDACTED-ROTATED", 2), state)
+	if got := p.attribute("zendesk_target", state, "password"); !got.IsNull() {
+		t.Errorf("expected no password in state, got %s", got)
+	}
+	if got, want := p.attribute("zendesk_target", state, "password_wo_version"), tftypes.NewValue(tftypes.Number, 2); !got.Equal(want) {
+		t.Errorf("expected password_wo_version %s, got %s", want, got)
+	}
+}
+
+func TestTargetPasswordVersionRequiresPassword(t *testing.T) {
+	p := newProtocolTest(t, "")
+
+	diags := p.validate("zendesk_target", `{"type": "http_target", "title": "Status page", "target_url": "https://status.example.com", "password_wo_version": 1}`)
+	if !hasProtocolError(diags, "Invalid Attribute Combination") {
+		t.Errorf("expected an error for a password version without a password, got %v", diags)
+	}
+}
+
+func TestTargetUpgradeStateV0(t *testing.T) {
+	p := newProtocolTest(t, "")
+
+	resp, err := p.server.UpgradeResourceState(context.Background(), &tfprotov6.UpgradeResourceStateRequest{
+		TypeName: "zendesk_target",
+		Version:  0,
+		RawState: &tfprotov6.RawState{JSON: []byte(`{
+			"id": "1000001", "type": "http_target", "title": "Status page", "active": true,
+			"target_url": "https://status.example.com/hooks/zendesk", "method": "post", "content_type": "application/json",
+			"attribute": null, "username": "zendesk", "password": "REDACTED", "email": null, "subject": null
+		}`)},
+	})
+	if err != nil {
+		t.Fatalf("UpgradeResourceState: %v", err)
+	}
+	checkProtocolDiagnostics(t, resp.Diagnostics)
+
+	if got := p.attribute("zendesk_target", resp.UpgradedState, "password"); !got.IsNull() {
+		t.Errorf("expected the password to be dropped, got %s", got)
+	}
+	if got, want := p.attribute("zendesk_target", resp.UpgradedState, "username"), tftypes.NewValue(tftypes.String, "zendesk"); !got.Equal(want) {
+		t.Errorf("expected username %s, got %s", want, got)
+	}
+}
@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                     = &TicketFieldDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &TicketFieldDataSource{}
+)
+
+func NewTicketFieldDataSource() datasource.DataSource {
+	return &TicketFieldDataSource{}
+}
+
+type TicketFieldDataSource struct {
+	client *Client
+}
+
+type TicketFieldDataSourceModel struct {
+	ID                 types.String             `tfsdk:"id"`
+	Title              types.String             `tfsdk:"title"`
+	Type               types.String             `tfsdk:"type"`
+	Active             types.Bool               `tfsdk:"active"`
+	Tag                types.String             `tfsdk:"tag"`
+	CustomFieldOptions []CustomFieldOptionModel `tfsdk:"custom_field_options"`
+}
+
+func (d *TicketFieldDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ticket_field"
+}
+
+func (d *TicketFieldDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a Zendesk ticket field by ID or exact title.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the ticket field. Exactly one of id or title must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"title": schema.StringAttribute{
+				Description: "The exact title of the ticket field. Exactly one of id or title must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of the ticket field (e.g., 'tagger', 'text', 'checkbox').",
+				Computed:    true,
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether the ticket field is active.",
+				Computed:    true,
+			},
+			"tag": schema.StringAttribute{
+				Description: "The tag added to tickets when a checkbox field is checked.",
+				Computed:    true,
+			},
+			"custom_field_options": customFieldOptionsAttribute(),
+		},
+	}
+}
+
+func (d *TicketFieldDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("title"),
+		),
+	}
+}
+
+func (d *TicketFieldDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TicketFieldDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config TicketFieldDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var field *Field
+	if !config.ID.IsNull() {
+		id, err := strconv.ParseInt(config.ID.ValueString(), 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Error Parsing Ticket Field ID",
+				fmt.Sprintf("Could not parse ticket field ID: %v", err),
+			)
+			return
+		}
+
+		field, err = d.client.ReadTicketField(ctx, id)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Ticket Field",
+				fmt.Sprintf("Could not read ticket field: %v", err),
+			)
+			return
+		}
+
+		if field == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Ticket Field Not Found",
+				fmt.Sprintf("No ticket field found with id %q.", config.ID.ValueString()),
+			)
+			return
+		}
+	} else {
+		fields, err := d.client.ListTicketFields(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Listing Ticket Fields",
+				fmt.Sprintf("Could not list ticket fields: %v", err),
+			)
+			return
+		}
+
+		var found []Field
+		for _, f := range fields {
+			if f.Title == config.Title.ValueString() {
+				found = append(found, f)
+			}
+		}
+
+		switch len(found) {
+		case 0:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("title"),
+				"Ticket Field Not Found",
+				fmt.Sprintf("No ticket field found with title %q.", config.Title.ValueString()),
+			)
+			return
+		case 1:
+			field = &found[0]
+		default:
+			ids := make([]string, 0, len(found))
+			for _, f := range found {
+				ids = append(ids, strconv.FormatInt(f.ID, 10))
+			}
+			resp.Diagnostics.AddAttributeError(
+				path.Root("title"),
+				"Multiple Ticket Fields Found",
+				fmt.Sprintf("Found %d ticket fields with title %q (IDs: %s). Look the field up by id instead.", len(found), config.Title.ValueString(), strings.Join(ids, ", ")),
+			)
+			return
+		}
+	}
+
+	config.ID = types.StringValue(strconv.FormatInt(field.ID, 10))
+	config.Title = types.StringValue(field.Title)
+	config.Type = types.StringValue(field.Type)
+	config.Active = types.BoolValue(field.Active)
+	config.Tag = types.StringValue(field.Tag)
+	config.CustomFieldOptions = flattenCustomFieldOptions(field.CustomFieldOptions)
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
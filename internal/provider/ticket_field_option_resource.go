@@ -0,0 +1,263 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &TicketFieldOptionResource{}
+	_ resource.ResourceWithImportState = &TicketFieldOptionResource{}
+)
+
+func NewTicketFieldOptionResource() resource.Resource {
+	return &TicketFieldOptionResource{}
+}
+
+type TicketFieldOptionResource struct {
+	client *Client
+}
+
+type TicketFieldOptionResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	FieldID types.String `tfsdk:"field_id"`
+	Name    types.String `tfsdk:"name"`
+	Value   types.String `tfsdk:"value"`
+}
+
+func (r *TicketFieldOptionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ticket_field_option"
+}
+
+func (r *TicketFieldOptionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a single option of a dropdown or multiselect ticket field, so that the options of a field can be owned by different configurations.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the option.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"field_id": schema.StringAttribute{
+				Description: "The ID of the ticket field the option belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the option shown to agents and end users. Use '::' to nest options, e.g. 'Hardware::Laptop'.",
+				Required:    true,
+			},
+			"value": schema.StringAttribute{
+				Description: "The value of the option, which is also the tag added to tickets.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (r *TicketFieldOptionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TicketFieldOptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TicketFieldOptionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fieldID, err := strconv.ParseInt(plan.FieldID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("field_id"),
+			"Error Parsing Ticket Field ID",
+			fmt.Sprintf("Could not parse ticket field ID: %v", err),
+		)
+		return
+	}
+
+	option, err := r.client.SaveTicketFieldOption(ctx, fieldID, CustomFieldOption{
+		Name:  plan.Name.ValueString(),
+		Value: plan.Value.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Ticket Field Option",
+			fmt.Sprintf("Could not create ticket field option: %v", err),
+		)
+		return
+	}
+
+	flattenTicketFieldOption(option, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *TicketFieldOptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TicketFieldOptionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fieldID, id, err := parseTicketFieldOptionIDs(state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Ticket Field Option ID",
+			fmt.Sprintf("Could not parse ticket field option ID: %v", err),
+		)
+		return
+	}
+
+	option, err := r.client.ReadTicketFieldOption(ctx, fieldID, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Ticket Field Option",
+			fmt.Sprintf("Could not read ticket field option: %v", err),
+		)
+		return
+	}
+
+	if option == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	flattenTicketFieldOption(option, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *TicketFieldOptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TicketFieldOptionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fieldID, id, err := parseTicketFieldOptionIDs(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Ticket Field Option ID",
+			fmt.Sprintf("Could not parse ticket field option ID: %v", err),
+		)
+		return
+	}
+
+	option, err := r.client.SaveTicketFieldOption(ctx, fieldID, CustomFieldOption{
+		ID:    id,
+		Name:  plan.Name.ValueString(),
+		Value: plan.Value.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Ticket Field Option",
+			fmt.Sprintf("Could not update ticket field option: %v", err),
+		)
+		return
+	}
+
+	flattenTicketFieldOption(option, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *TicketFieldOptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TicketFieldOptionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fieldID, id, err := parseTicketFieldOptionIDs(state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Ticket Field Option ID",
+			fmt.Sprintf("Could not parse ticket field option ID: %v", err),
+		)
+		return
+	}
+
+	err = r.client.DeleteTicketFieldOption(ctx, fieldID, id)
+	if err != nil {
+		// Zendesk refuses to delete an option that would leave a field without options or that
+		// is used by business rules, explaining why in a validation error.
+		if messages := validationMessages(err); messages != nil {
+			resp.Diagnostics.AddError(
+				"Error Deleting Ticket Field Option",
+				fmt.Sprintf("Zendesk refused to delete option %q: %s", state.Value.ValueString(), strings.Join(messages, " ")),
+			)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Deleting Ticket Field Option",
+			fmt.Sprintf("Could not delete ticket field option: %v", err),
+		)
+		return
+	}
+}
+
+func (r *TicketFieldOptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := splitImportID(req.ID, "field_id", "option_id")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("field_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
+
+func parseTicketFieldOptionIDs(model TicketFieldOptionResourceModel) (int64, int64, error) {
+	fieldID, err := strconv.ParseInt(model.FieldID.ValueString(), 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	id, err := strconv.ParseInt(model.ID.ValueString(), 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return fieldID, id, nil
+}
+
+func flattenTicketFieldOption(option *CustomFieldOption, model *TicketFieldOptionResourceModel) {
+	model.ID = types.StringValue(strconv.FormatInt(option.ID, 10))
+	model.Name = types.StringValue(option.Name)
+	model.Value = types.StringValue(option.Value)
+}
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &TicketFieldsDataSource{}
+)
+
+func NewTicketFieldsDataSource() datasource.DataSource {
+	return &TicketFieldsDataSource{}
+}
+
+type TicketFieldsDataSource struct {
+	client *Client
+}
+
+type TicketFieldsDataSourceModel struct {
+	Type         types.String            `tfsdk:"type"`
+	Active       types.Bool              `tfsdk:"active"`
+	TicketFields []TicketFieldsItemModel `tfsdk:"ticket_fields"`
+}
+
+type TicketFieldsItemModel struct {
+	ID                 types.String             `tfsdk:"id"`
+	Type               types.String             `tfsdk:"type"`
+	Title              types.String             `tfsdk:"title"`
+	Active             types.Bool               `tfsdk:"active"`
+	System             types.Bool               `tfsdk:"system"`
+	CreatedAt          types.String             `tfsdk:"created_at"`
+	CustomFieldOptions []CustomFieldOptionModel `tfsdk:"custom_field_options"`
+}
+
+func (d *TicketFieldsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ticket_fields"
+}
+
+func (d *TicketFieldsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all Zendesk ticket fields, including the options of dropdown fields, sorted by ID.",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Description: "Only return fields of this type (e.g., 'tagger').",
+				Optional:    true,
+			},
+			"active": schema.BoolAttribute{
+				Description: "Only return active (true) or inactive (false) fields.",
+				Optional:    true,
+			},
+			"ticket_fields": schema.ListNestedAttribute{
+				Description: "The ticket fields.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the ticket field.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of the ticket field.",
+							Computed:    true,
+						},
+						"title": schema.StringAttribute{
+							Description: "The title of the ticket field.",
+							Computed:    true,
+						},
+						"active": schema.BoolAttribute{
+							Description: "Whether the ticket field is active.",
+							Computed:    true,
+						},
+						"system": schema.BoolAttribute{
+							Description: "Whether the field is a built-in system field rather than a custom field.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "When the ticket field was created.",
+							Computed:    true,
+						},
+						"custom_field_options": customFieldOptionsAttribute(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TicketFieldsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TicketFieldsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config TicketFieldsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fields, err := d.client.ListTicketFields(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Ticket Fields",
+			fmt.Sprintf("Could not list ticket fields: %v", err),
+		)
+		return
+	}
+
+	fields = filterFields(fields, config.Type, config.Active)
+
+	config.TicketFields = make([]TicketFieldsItemModel, 0, len(fields))
+	for _, field := range fields {
+		config.TicketFields = append(config.TicketFields, TicketFieldsItemModel{
+			ID:                 types.StringValue(strconv.FormatInt(field.ID, 10)),
+			Type:               types.StringValue(field.Type),
+			Title:              types.StringValue(field.Title),
+			Active:             types.BoolValue(field.Active),
+			System:             types.BoolValue(!field.Removable),
+			CreatedAt:          types.StringValue(field.CreatedAt),
+			CustomFieldOptions: flattenCustomFieldOptions(field.CustomFieldOptions),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                     = &TicketFormDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &TicketFormDataSource{}
+)
+
+func NewTicketFormDataSource() datasource.DataSource {
+	return &TicketFormDataSource{}
+}
+
+type TicketFormDataSource struct {
+	client *Client
+}
+
+type TicketFormDataSourceModel struct {
+	ID             types.String   `tfsdk:"id"`
+	Name           types.String   `tfsdk:"name"`
+	DisplayName    types.String   `tfsdk:"display_name"`
+	Active         types.Bool     `tfsdk:"active"`
+	Default        types.Bool     `tfsdk:"default"`
+	Position       types.Int64    `tfsdk:"position"`
+	EndUserVisible types.Bool     `tfsdk:"end_user_visible"`
+	TicketFieldIDs []types.String `tfsdk:"ticket_field_ids"`
+}
+
+func (d *TicketFormDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ticket_form"
+}
+
+func (d *TicketFormDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a Zendesk ticket form by ID or exact name. Inactive forms are returned too, with a warning.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the ticket form. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The exact name of the ticket form. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"display_name": schema.StringAttribute{
+				Description: "The name of the form shown to end users.",
+				Computed:    true,
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether the ticket form is active.",
+				Computed:    true,
+			},
+			"default": schema.BoolAttribute{
+				Description: "Whether the ticket form is the default form.",
+				Computed:    true,
+			},
+			"position": schema.Int64Attribute{
+				Description: "The position of the ticket form.",
+				Computed:    true,
+			},
+			"end_user_visible": schema.BoolAttribute{
+				Description: "Whether end users can see the ticket form.",
+				Computed:    true,
+			},
+			"ticket_field_ids": schema.ListAttribute{
+				Description: "The IDs of the ticket fields on the form, in display order.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (d *TicketFormDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *TicketFormDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TicketFormDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config TicketFormDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var form *TicketForm
+	if !config.ID.IsNull() {
+		id, err := strconv.ParseInt(config.ID.ValueString(), 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Error Parsing Ticket Form ID",
+				fmt.Sprintf("Could not parse ticket form ID: %v", err),
+			)
+			return
+		}
+
+		form, err = d.client.ReadTicketForm(ctx, id)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Ticket Form",
+				fmt.Sprintf("Could not read ticket form: %v", err),
+			)
+			return
+		}
+
+		if form == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Ticket Form Not Found",
+				fmt.Sprintf("No ticket form found with id %q.", config.ID.ValueString()),
+			)
+			return
+		}
+	} else {
+		forms, err := d.client.ListTicketForms(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Listing Ticket Forms",
+				fmt.Sprintf("Could not list ticket forms: %v", err),
+			)
+			return
+		}
+
+		var found []TicketForm
+		for _, f := range forms {
+			if f.Name == config.Name.ValueString() {
+				found = append(found, f)
+			}
+		}
+
+		switch len(found) {
+		case 0:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Ticket Form Not Found",
+				fmt.Sprintf("No ticket form found with name %q.", config.Name.ValueString()),
+			)
+			return
+		case 1:
+			form = &found[0]
+		default:
+			ids := make([]string, 0, len(found))
+			for _, f := range found {
+				ids = append(ids, strconv.FormatInt(f.ID, 10))
+			}
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Multiple Ticket Forms Found",
+				fmt.Sprintf("Found %d ticket forms with name %q (IDs: %s). Look the form up by id instead.", len(found), config.Name.ValueString(), strings.Join(ids, ", ")),
+			)
+			return
+		}
+	}
+
+	if !form.Active {
+		resp.Diagnostics.AddWarning(
+			"Ticket Form Is Inactive",
+			fmt.Sprintf("The ticket form %q (%d) is inactive.", form.Name, form.ID),
+		)
+	}
+
+	config.ID = types.StringValue(strconv.FormatInt(form.ID, 10))
+	config.Name = types.StringValue(form.Name)
+	config.DisplayName = types.StringValue(form.DisplayName)
+	config.Active = types.BoolValue(form.Active)
+	config.Default = types.BoolValue(form.Default)
+	config.Position = types.Int64Value(positionValue(form.Position))
+	config.EndUserVisible = types.BoolValue(form.EndUserVisible)
+	config.TicketFieldIDs = idListValue(form.TicketFieldIDs)
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
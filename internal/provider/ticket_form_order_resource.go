@@ -0,0 +1,271 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &TicketFormOrderResource{}
+	_ resource.ResourceWithImportState = &TicketFormOrderResource{}
+	_ resource.ResourceWithModifyPlan  = &TicketFormOrderResource{}
+)
+
+// ticketFormOrderID is the fixed ID of the ticket form order, which is a singleton.
+const ticketFormOrderID = "ticket_form_order"
+
+func NewTicketFormOrderResource() resource.Resource {
+	return &TicketFormOrderResource{}
+}
+
+type TicketFormOrderResource struct {
+	client *Client
+}
+
+type TicketFormOrderResourceModel struct {
+	ID      types.String   `tfsdk:"id"`
+	FormIDs []types.String `tfsdk:"form_ids"`
+}
+
+func (r *TicketFormOrderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ticket_form_order"
+}
+
+func (r *TicketFormOrderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the order of the ticket forms in the form dropdown, applied in a single request. The order must list every active ticket form. Forms ordered here should not set position.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: fmt.Sprintf("Always %q, as the account has a single ticket form order.", ticketFormOrderID),
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"form_ids": schema.ListAttribute{
+				Description: "The IDs of the ticket forms, in the order they are shown. Every active form must be listed; inactive forms may be.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *TicketFormOrderResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan rejects forms listed twice, and warns about forms that also set their own position.
+func (r *TicketFormOrderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan TicketFormOrderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attr := path.Root("form_ids")
+	resp.Diagnostics.Append(duplicateIDDiagnostics(attr, plan.FormIDs, map[string]path.Path{})...)
+
+	if r.client == nil {
+		return
+	}
+	for i, id := range plan.FormIDs {
+		if !id.IsUnknown() && r.client.positionClaims.claimOrder("ticket form", id.ValueString()) {
+			resp.Diagnostics.Append(positionConflictWarning(attr.AtListIndex(i), "ticket form", id.ValueString(), "zendesk_ticket_form_order"))
+		}
+	}
+}
+
+func (r *TicketFormOrderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TicketFormOrderResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(ticketFormOrderID)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *TicketFormOrderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TicketFormOrderResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	forms, err := r.client.ListTicketForms(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Ticket Form Order",
+			fmt.Sprintf("Could not list ticket forms: %v", err),
+		)
+		return
+	}
+
+	flattenTicketFormOrder(forms, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *TicketFormOrderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TicketFormOrderResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(ticketFormOrderID)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete leaves the ticket forms in their current order.
+func (r *TicketFormOrderResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// ImportState reads the order of the active ticket forms.
+func (r *TicketFormOrderResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), ticketFormOrderID)...)
+}
+
+// apply checks that the order lists every active form and only existing ones, and then reorders
+// them.
+func (r *TicketFormOrderResource) apply(ctx context.Context, model TicketFormOrderResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ids, d := expandIDList(model.FormIDs, path.Root("form_ids"))
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	forms, err := r.client.ListTicketForms(ctx)
+	if err != nil {
+		diags.AddError(
+			"Error Ordering Ticket Forms",
+			fmt.Sprintf("Could not list ticket forms: %v", err),
+		)
+		return diags
+	}
+
+	listed := make(map[int64]bool, len(ids))
+	for _, id := range ids {
+		listed[id] = true
+	}
+
+	existing := make(map[int64]bool, len(forms))
+	var missing []string
+	for _, form := range forms {
+		existing[form.ID] = true
+		if form.Active && !listed[form.ID] {
+			missing = append(missing, fmt.Sprintf("%d (%s)", form.ID, form.Name))
+		}
+	}
+
+	for i, id := range ids {
+		if !existing[id] {
+			diags.AddAttributeError(
+				path.Root("form_ids").AtListIndex(i),
+				"Ticket Form Not Found",
+				fmt.Sprintf("No ticket form found with ID %d, at index %d.", id, i),
+			)
+		}
+	}
+	if len(missing) > 0 {
+		diags.AddAttributeError(
+			path.Root("form_ids"),
+			"Incomplete Ticket Form Order",
+			fmt.Sprintf("Every active ticket form must be ordered, but these are not listed: %s.", strings.Join(missing, ", ")),
+		)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := r.client.ReorderTicketForms(ctx, ids); err != nil {
+		diags.AddError(
+			"Error Ordering Ticket Forms",
+			fmt.Sprintf("Could not reorder ticket forms: %v", err),
+		)
+	}
+	return diags
+}
+
+// flattenTicketFormOrder rebuilds the order of the ticket forms from their positions. It lists
+// the active forms and the inactive ones the state lists, so a form created outside Terraform
+// shows as a diff.
+func flattenTicketFormOrder(forms []TicketForm, model *TicketFormOrderResourceModel) {
+	listed := map[string]bool{}
+	for _, id := range model.FormIDs {
+		listed[id.ValueString()] = true
+	}
+
+	sort.SliceStable(forms, func(i, j int) bool {
+		if positionValue(forms[i].Position) != positionValue(forms[j].Position) {
+			return positionValue(forms[i].Position) < positionValue(forms[j].Position)
+		}
+		return forms[i].ID < forms[j].ID
+	})
+
+	ids := make([]types.String, 0, len(forms))
+	for _, form := range forms {
+		id := strconv.FormatInt(form.ID, 10)
+		if form.Active || listed[id] {
+			ids = append(ids, types.StringValue(id))
+		}
+	}
+
+	model.ID = types.StringValue(ticketFormOrderID)
+	model.FormIDs = ids
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestFlattenTicketFormOrder(t *testing.T) {
+	forms := []TicketForm{
+		{ID: 1000004, Position: int64Pointer(4), Active: false},
+		{ID: 1000003, Position: int64Pointer(1), Active: true},
+		{ID: 1000002, Position: int64Pointer(3), Active: false},
+		{ID: 1000001, Position: int64Pointer(2), Active: true},
+	}
+
+	var model TicketFormOrderResourceModel
+	flattenTicketFormOrder(forms, &model)
+	if model.ID.ValueString() != ticketFormOrderID {
+		t.Errorf("expected ID %q, got %s", ticketFormOrderID, model.ID)
+	}
+	if got := joinStrings(model.FormIDs); got != "1000003,1000001" {
+		t.Errorf("expected the active forms sorted by position, got %s", got)
+	}
+
+	// Inactive forms stay in the order when the state lists them.
+	model.FormIDs = []types.String{types.StringValue("1000001"), types.StringValue("1000002"), types.StringValue("1000003")}
+	flattenTicketFormOrder(forms, &model)
+	if got := joinStrings(model.FormIDs); got != "1000003,1000001,1000002" {
+		t.Errorf("expected the listed inactive form to be kept, got %s", got)
+	}
+}
+
+// The order is a singleton: any import ID imports it, and Read rebuilds it from the positions
+// of the forms, leaving out the inactive ones.
+func TestTicketFormOrderFlip(t *testing.T) {
+	p := newProtocolTest(t, "ticket_form_order_flip.json")
+
+	resp, err := p.server.ImportResourceState(context.Background(), &tfprotov6.ImportResourceStateRequest{
+		TypeName: "zendesk_ticket_form_order",
+		ID:       "order",
+	})
+	if err != nil {
+		t.Fatalf("ImportResourceState: %v", err)
+	}
+	checkProtocolDiagnostics(t, resp.Diagnostics)
+	if len(resp.ImportedResources) != 1 {
+		t.Fatalf("expected 1 imported resource, got %d", len(resp.ImportedResources))
+	}
+
+	state := p.refresh("zendesk_ticket_form_order", resp.ImportedResources[0].State)
+	if got, want := p.attribute("zendesk_ticket_form_order", state, "id"), tftypes.NewValue(tftypes.String, ticketFormOrderID); !got.Equal(want) {
+		t.Errorf("expected id %s, got %s", want, got)
+	}
+	p.planUnchanged("zendesk_ticket_form_order", `{"form_ids": ["1000001", "1000002"]}`, state)
+
+	state = p.apply("zendesk_ticket_form_order", `{"form_ids": ["1000002", "1000001"]}`, state)
+	state = p.refresh("zendesk_ticket_form_order", state)
+	p.planUnchanged("zendesk_ticket_form_order", `{"form_ids": ["1000002", "1000001"]}`, state)
+}
+
+func TestTicketFormOrderIncomplete(t *testing.T) {
+	p := newProtocolTest(t, "ticket_form_order_incomplete.json")
+
+	diags := p.applyDiagnostics("zendesk_ticket_form_order", `{"form_ids": ["1000001"]}`, nil)
+	if !hasProtocolError(diags, "Incomplete Ticket Form Order") {
+		t.Fatalf("expected an error for the missing active form, got %v", diags)
+	}
+	for _, d := range diags {
+		if d.Summary == "Incomplete Ticket Form Order" && (!strings.Contains(d.Detail, "1000002 (Returns)") || strings.Contains(d.Detail, "1000003")) {
+			t.Errorf("expected the error to name only the missing active form, got %q", d.Detail)
+		}
+	}
+}
+
+func TestTicketFormOrderValidation(t *testing.T) {
+	p := newProtocolTest(t, "")
+
+	if diags := p.validate("zendesk_ticket_form_order", `{"form_ids": []}`); !hasProtocolError(diags, "Invalid Attribute Value") {
+		t.Errorf("expected an error for an empty order, got %v", diags)
+	}
+
+	diags := p.planDiagnostics("zendesk_ticket_form_order", `{"form_ids": ["1000001", "1000001"]}`, nil)
+	if !hasProtocolError(diags, "Duplicate ID") {
+		t.Errorf("expected an error for a form listed twice, got %v", diags)
+	}
+}
@@ -0,0 +1,353 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &TicketFormResource{}
+	_ resource.ResourceWithImportState = &TicketFormResource{}
+	_ resource.ResourceWithModifyPlan  = &TicketFormResource{}
+)
+
+func NewTicketFormResource() resource.Resource {
+	return &TicketFormResource{}
+}
+
+type TicketFormResource struct {
+	client *Client
+}
+
+type TicketFormResourceModel struct {
+	ID                 types.String   `tfsdk:"id"`
+	Name               types.String   `tfsdk:"name"`
+	DisplayName        types.String   `tfsdk:"display_name"`
+	Position           types.Int64    `tfsdk:"position"`
+	Active             types.Bool     `tfsdk:"active"`
+	Default            types.Bool     `tfsdk:"default"`
+	EndUserVisible     types.Bool     `tfsdk:"end_user_visible"`
+	InAllBrands        types.Bool     `tfsdk:"in_all_brands"`
+	RestrictedBrandIDs []types.String `tfsdk:"restricted_brand_ids"`
+	TicketFieldIDs     []types.String `tfsdk:"ticket_field_ids"`
+}
+
+func (r *TicketFormResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ticket_form"
+}
+
+func (r *TicketFormResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Zendesk ticket form.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the ticket form.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the ticket form, shown to agents.",
+				Required:    true,
+			},
+			"display_name": schema.StringAttribute{
+				Description: "The name of the ticket form shown to end users. Zendesk uses the name when not set.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"position": schema.Int64Attribute{
+				Description: "The position of the ticket form in the list of forms.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether the ticket form is active. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"default": schema.BoolAttribute{
+				Description: "Whether the ticket form is the default form of the account. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"end_user_visible": schema.BoolAttribute{
+				Description: "Whether end users can select the ticket form. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"in_all_brands": schema.BoolAttribute{
+				Description: "Whether the ticket form is available in all brands. Defaults to false, in which case restricted_brand_ids lists the brands it is available in.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"restricted_brand_ids": schema.SetAttribute{
+				Description: "The IDs of the brands the ticket form is available in when in_all_brands is false.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"ticket_field_ids": schema.ListAttribute{
+				Description: "The IDs of the ticket fields of the form, in the order they are displayed.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *TicketFormResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan warns about a position also managed by zendesk_ticket_form_order.
+func (r *TicketFormResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var id types.String
+	var position types.Int64
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("position"), &position)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !position.IsNull() && !id.IsUnknown() && r.client.positionClaims.claimPosition("ticket form", id.ValueString()) {
+		resp.Diagnostics.Append(positionConflictWarning(path.Root("position"), "ticket form", id.ValueString(), "zendesk_ticket_form_order"))
+	}
+}
+
+func (r *TicketFormResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TicketFormResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	position := expandOptionalInt64(plan.Position)
+	form, diags := expandTicketForm(plan, position)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateTicketForm(ctx, form)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Ticket Form",
+			fmt.Sprintf("Could not create ticket form: %v", err),
+		)
+		return
+	}
+
+	planned := plan.Position
+	flattenTicketForm(created, &plan)
+	if position == nil && !planned.IsUnknown() {
+		plan.Position = planned
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *TicketFormResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TicketFormResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Ticket Form ID",
+			fmt.Sprintf("Could not parse ticket form ID: %v", err),
+		)
+		return
+	}
+
+	form, err := r.client.ReadTicketForm(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Ticket Form",
+			fmt.Sprintf("Could not read ticket form: %v", err),
+		)
+		return
+	}
+
+	if form == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	flattenTicketForm(form, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *TicketFormResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TicketFormResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The planned position of a form that does not configure one is the prior state, which
+	// zendesk_ticket_form_order may have changed since, so only the configured position is sent.
+	var configured types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("position"), &configured)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(plan.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Ticket Form ID",
+			fmt.Sprintf("Could not parse ticket form ID: %v", err),
+		)
+		return
+	}
+
+	position := expandOptionalInt64(configured)
+	form, diags := expandTicketForm(plan, position)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateTicketForm(ctx, id, form)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Ticket Form",
+			fmt.Sprintf("Could not update ticket form: %v", err),
+		)
+		return
+	}
+
+	planned := plan.Position
+	flattenTicketForm(updated, &plan)
+	if position == nil && !planned.IsUnknown() {
+		plan.Position = planned
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *TicketFormResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TicketFormResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Ticket Form ID",
+			fmt.Sprintf("Could not parse ticket form ID: %v", err),
+		)
+		return
+	}
+
+	err = r.client.DeleteTicketForm(ctx, id)
+	if err != nil {
+		// Zendesk refuses to delete the default form with a 422 whose raw body is hard to read.
+		if messages := validationMessages(err); messages != nil {
+			detail := strings.Join(messages, " ")
+			if state.Default.ValueBool() {
+				detail += " The default ticket form cannot be deleted: make another form the default first."
+			}
+			resp.Diagnostics.AddError("Error Deleting Ticket Form", detail)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Deleting Ticket Form",
+			fmt.Sprintf("Could not delete ticket form: %v", err),
+		)
+		return
+	}
+}
+
+func (r *TicketFormResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// expandTicketForm builds the request body of a ticket form. A nil position leaves the position
+// of the form unchanged.
+func expandTicketForm(model TicketFormResourceModel, position *int64) (TicketForm, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	brandIDs, d := expandIDList(model.RestrictedBrandIDs, path.Root("restricted_brand_ids"))
+	diags.Append(d...)
+	fieldIDs, d := expandIDList(model.TicketFieldIDs, path.Root("ticket_field_ids"))
+	diags.Append(d...)
+
+	return TicketForm{
+		Name:               model.Name.ValueString(),
+		DisplayName:        model.DisplayName.ValueString(),
+		Position:           position,
+		Active:             model.Active.ValueBool(),
+		Default:            model.Default.ValueBool(),
+		EndUserVisible:     model.EndUserVisible.ValueBool(),
+		InAllBrands:        model.InAllBrands.ValueBool(),
+		RestrictedBrandIDs: brandIDs,
+		TicketFieldIDs:     fieldIDs,
+	}, diags
+}
+
+func flattenTicketForm(form *TicketForm, model *TicketFormResourceModel) {
+	model.ID = types.StringValue(strconv.FormatInt(form.ID, 10))
+	model.Name = types.StringValue(form.Name)
+	model.DisplayName = types.StringValue(form.DisplayName)
+	model.Position = types.Int64Value(positionValue(form.Position))
+	model.Active = types.BoolValue(form.Active)
+	model.Default = types.BoolValue(form.Default)
+	model.EndUserVisible = types.BoolValue(form.EndUserVisible)
+	model.InAllBrands = types.BoolValue(form.InAllBrands)
+	model.RestrictedBrandIDs = flattenIDList(form.RestrictedBrandIDs, model.RestrictedBrandIDs)
+	model.TicketFieldIDs = flattenIDList(form.TicketFieldIDs, model.TicketFieldIDs)
+}
@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// The planned position of a form without a configured one comes from the prior state and is
+// not sent, so that it does not undo an order set by zendesk_ticket_form_order. The fixture
+// fails the test if the request body has a position.
+func TestTicketFormUpdateWithoutPosition(t *testing.T) {
+	p := newProtocolTest(t, "ticket_form_update_without_position.json")
+
+	state := p.read("zendesk_ticket_form", `{
+		"id": "1000002", "name": "Returns", "display_name": "Returns", "position": 3, "active": true,
+		"default": false, "end_user_visible": true, "in_all_brands": true, "ticket_field_ids": ["1000010"]
+	}`)
+
+	state = p.apply("zendesk_ticket_form", `{
+		"name": "Returns and refunds", "display_name": "Returns", "end_user_visible": true, "in_all_brands": true,
+		"ticket_field_ids": ["1000010"]
+	}`, state)
+	if got, want := p.attribute("zendesk_ticket_form", state, "position"), tftypes.NewValue(tftypes.Number, 2); !got.Equal(want) {
+		t.Errorf("expected the planned position %s until the next refresh, got %s", want, got)
+	}
+}
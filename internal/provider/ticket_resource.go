@@ -0,0 +1,451 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &TicketResource{}
+	_ resource.ResourceWithImportState = &TicketResource{}
+)
+
+func NewTicketResource() resource.Resource {
+	return &TicketResource{}
+}
+
+type TicketResource struct {
+	client *Client
+}
+
+type TicketResourceModel struct {
+	ID           types.String          `tfsdk:"id"`
+	Subject      types.String          `tfsdk:"subject"`
+	Comment      *TicketCommentModel   `tfsdk:"comment"`
+	RequesterID  types.String          `tfsdk:"requester_id"`
+	Requester    *TicketRequesterModel `tfsdk:"requester"`
+	AssigneeID   types.String          `tfsdk:"assignee_id"`
+	GroupID      types.String          `tfsdk:"group_id"`
+	TicketFormID types.String          `tfsdk:"ticket_form_id"`
+	Priority     types.String          `tfsdk:"priority"`
+	Type         types.String          `tfsdk:"type"`
+	Status       types.String          `tfsdk:"status"`
+	Tags         []types.String        `tfsdk:"tags"`
+	CustomFields types.Map             `tfsdk:"custom_fields"`
+}
+
+type TicketCommentModel struct {
+	Body     types.String `tfsdk:"body"`
+	HTMLBody types.String `tfsdk:"html_body"`
+	Public   types.Bool   `tfsdk:"public"`
+}
+
+type TicketRequesterModel struct {
+	Name  types.String `tfsdk:"name"`
+	Email types.String `tfsdk:"email"`
+}
+
+func (r *TicketResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ticket"
+}
+
+func (r *TicketResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// Attributes Zendesk or the business rules of the account fill in when they are not
+	// configured, such as the group a trigger routes the ticket to.
+	serverDefault := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Description: description,
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Creates a Zendesk ticket. Intended for seeding demo environments and testing business rules, not for managing production tickets. Destroying the resource soft-deletes the ticket.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the ticket.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subject": schema.StringAttribute{
+				Description: "The subject of the ticket.",
+				Required:    true,
+			},
+			"comment": schema.SingleNestedAttribute{
+				Description: "The first comment of the ticket. It is only posted on creation: changes to it are recorded without adding a comment, and it is not read back.",
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"body": schema.StringAttribute{
+						Description: "The plain text of the comment. Exactly one of body and html_body must be set.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("html_body")),
+						},
+					},
+					"html_body": schema.StringAttribute{
+						Description: "The HTML of the comment.",
+						Optional:    true,
+					},
+					"public": schema.BoolAttribute{
+						Description: "Whether the comment is visible to the requester. Defaults to true.",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(true),
+					},
+				},
+			},
+			"requester_id": schema.StringAttribute{
+				Description: "The ID of the requester. Defaults to the requester created from the requester block, or to the authenticated user.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("requester")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"requester": schema.SingleNestedAttribute{
+				Description: "A requester to create along with the ticket, or to match by email when the user already exists. Only used on creation, so changing it replaces the ticket.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "The name of the requester.",
+						Optional:    true,
+					},
+					"email": schema.StringAttribute{
+						Description: "The email address of the requester.",
+						Required:    true,
+					},
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
+			"assignee_id":    serverDefault("The ID of the agent assigned to the ticket."),
+			"group_id":       serverDefault("The ID of the group assigned to the ticket."),
+			"ticket_form_id": serverDefault("The ID of the ticket form. Defaults to the default form of the account."),
+			"priority": schema.StringAttribute{
+				Description: "The priority of the ticket: urgent, high, normal or low. Defaults to the priority business rules set, if any.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("urgent", "high", "normal", "low"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of the ticket: problem, incident, question or task. Defaults to the type business rules set, if any.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("problem", "incident", "question", "task"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the ticket: new, open, pending, hold, solved or closed. Defaults to new, or open when the ticket is assigned.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("new", "open", "pending", "hold", "solved", "closed"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tags": schema.SetAttribute{
+				Description: "The tags of the ticket. Tags that business rules add are read back on the next refresh, so the following plan removes them unless they are listed here.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"custom_fields": schema.MapAttribute{
+				Description: "Values of custom ticket fields, keyed by field ID. Only the fields set here are managed; values are strings.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *TicketResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TicketResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TicketResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ticket, diags := expandTicket(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ticket.Comment = &TicketComment{
+		Body:     plan.Comment.Body.ValueString(),
+		HTMLBody: plan.Comment.HTMLBody.ValueString(),
+		Public:   plan.Comment.Public.ValueBool(),
+	}
+	if plan.Requester != nil {
+		ticket.Requester = &TicketRequester{
+			Name:  plan.Requester.Name.ValueString(),
+			Email: plan.Requester.Email.ValueString(),
+		}
+	}
+
+	created, err := r.client.CreateTicket(ctx, ticket)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Ticket",
+			fmt.Sprintf("Could not create ticket: %v", err),
+		)
+		return
+	}
+
+	planned := plan
+	resp.Diagnostics.Append(flattenTicket(ctx, created, &plan)...)
+	keepConfiguredTicketValues(planned, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *TicketResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TicketResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Ticket ID",
+			fmt.Sprintf("Could not parse ticket ID: %v", err),
+		)
+		return
+	}
+
+	ticket, err := r.client.ReadTicket(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Ticket",
+			fmt.Sprintf("Could not read ticket: %v", err),
+		)
+		return
+	}
+
+	// Deleted tickets are not found.
+	if ticket == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(flattenTicket(ctx, ticket, &state)...)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update changes the ticket without posting a comment, so changes to comment are only recorded.
+func (r *TicketResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TicketResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(plan.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Ticket ID",
+			fmt.Sprintf("Could not parse ticket ID: %v", err),
+		)
+		return
+	}
+
+	ticket, diags := expandTicket(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateTicket(ctx, id, ticket)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Ticket",
+			fmt.Sprintf("Could not update ticket: %v", err),
+		)
+		return
+	}
+
+	planned := plan
+	resp.Diagnostics.Append(flattenTicket(ctx, updated, &plan)...)
+	keepConfiguredTicketValues(planned, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *TicketResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TicketResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Ticket ID",
+			fmt.Sprintf("Could not parse ticket ID: %v", err),
+		)
+		return
+	}
+
+	err = r.client.DeleteTicket(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Ticket",
+			fmt.Sprintf("Could not delete ticket: %v", err),
+		)
+		return
+	}
+}
+
+func (r *TicketResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// expandTicket builds the request body of a ticket, leaving out the comment and the requester
+// block, which are only sent on creation.
+func expandTicket(ctx context.Context, model TicketResourceModel) (Ticket, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	ticket := Ticket{
+		Subject:  model.Subject.ValueString(),
+		Priority: model.Priority.ValueString(),
+		Type:     model.Type.ValueString(),
+		Status:   model.Status.ValueString(),
+	}
+
+	var d diag.Diagnostics
+	ticket.RequesterID, d = expandOptionalID(model.RequesterID, path.Root("requester_id"))
+	diags.Append(d...)
+	ticket.AssigneeID, d = expandOptionalID(model.AssigneeID, path.Root("assignee_id"))
+	diags.Append(d...)
+	ticket.GroupID, d = expandOptionalID(model.GroupID, path.Root("group_id"))
+	diags.Append(d...)
+	ticket.TicketFormID, d = expandOptionalID(model.TicketFormID, path.Root("ticket_form_id"))
+	diags.Append(d...)
+
+	if model.Tags != nil {
+		ticket.Tags = expandStringList(model.Tags)
+	}
+
+	if !model.CustomFields.IsNull() {
+		fields := map[string]string{}
+		diags.Append(model.CustomFields.ElementsAs(ctx, &fields, false)...)
+
+		for key, value := range fields {
+			id, err := strconv.ParseInt(key, 10, 64)
+			if err != nil {
+				diags.AddAttributeError(path.Root("custom_fields").AtMapKey(key), "Invalid Ticket Field ID", fmt.Sprintf("Could not parse ticket field ID %q: %v", key, err))
+				continue
+			}
+			ticket.CustomFields = append(ticket.CustomFields, TicketCustomField{ID: id, Value: value})
+		}
+	}
+
+	return ticket, diags
+}
+
+// flattenTicket updates the model from the ticket. The comment and the requester block are kept
+// as configured, since they are only used on creation.
+func flattenTicket(ctx context.Context, ticket *Ticket, model *TicketResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.ID = types.StringValue(strconv.FormatInt(ticket.ID, 10))
+	model.Subject = types.StringValue(ticket.Subject)
+	model.RequesterID = optionalIDValue(ticket.RequesterID)
+	model.AssigneeID = optionalIDValue(ticket.AssigneeID)
+	model.GroupID = optionalIDValue(ticket.GroupID)
+	model.TicketFormID = optionalIDValue(ticket.TicketFormID)
+	model.Priority = optionalStringValue(ticket.Priority)
+	model.Type = optionalStringValue(ticket.Type)
+	model.Status = optionalStringValue(ticket.Status)
+
+	if model.Tags != nil {
+		model.Tags = stringListValue(ticket.Tags)
+	}
+
+	if !model.CustomFields.IsNull() {
+		remote := make(map[string]interface{}, len(ticket.CustomFields))
+		for _, field := range ticket.CustomFields {
+			remote[strconv.FormatInt(field.ID, 10)] = field.Value
+		}
+		model.CustomFields, diags = flattenManagedFields(ctx, model.CustomFields, remote)
+	}
+
+	return diags
+}
+
+// keepConfiguredTicketValues restores the planned values business rules may change while a
+// ticket is created or updated, such as tags a trigger adds, as Terraform rejects a new state
+// that differs from a known planned value. Read picks up the changes on the next refresh.
+func keepConfiguredTicketValues(planned TicketResourceModel, model *TicketResourceModel) {
+	model.Tags = planned.Tags
+	if !planned.Priority.IsUnknown() {
+		model.Priority = planned.Priority
+	}
+	if !planned.Type.IsUnknown() {
+		model.Type = planned.Type
+	}
+	if !planned.Status.IsUnknown() {
+		model.Status = planned.Status
+	}
+}
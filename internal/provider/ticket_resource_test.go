@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// A trigger that raises the priority and adds a tag on creation, and escalates the ticket on
+// update. Applies keep the configured values, and refreshes read the changes back.
+func TestTicketBusinessRuleChanges(t *testing.T) {
+	p := newProtocolTest(t, "ticket_business_rules.json")
+
+	config := `{"subject": "Refund for order 1042", "comment": {"body": "I was charged twice."}, "tags": ["terraform_fixture"]}`
+	state := p.apply("zendesk_ticket", config, nil)
+	checkTicketAttributes(t, p, state, map[string]tftypes.Value{
+		"priority": tftypes.NewValue(tftypes.String, "urgent"),
+		"type":     tftypes.NewValue(tftypes.String, nil),
+		"group_id": tftypes.NewValue(tftypes.String, "1000020"),
+		"tags":     testTicketTags("terraform_fixture"),
+	})
+
+	state = p.refresh("zendesk_ticket", state)
+	checkTicketAttributes(t, p, state, map[string]tftypes.Value{
+		"tags": testTicketTags("terraform_fixture", "vip"),
+	})
+	p.planUnchanged("zendesk_ticket", `{"subject": "Refund for order 1042", "comment": {"body": "I was charged twice."}, "tags": ["terraform_fixture", "vip"]}`, state)
+
+	config = `{"subject": "Refund for order 1042", "comment": {"body": "I was charged twice."}, "priority": "high", "tags": ["terraform_fixture"]}`
+	state = p.apply("zendesk_ticket", config, state)
+	checkTicketAttributes(t, p, state, map[string]tftypes.Value{
+		"priority": tftypes.NewValue(tftypes.String, "high"),
+		"status":   tftypes.NewValue(tftypes.String, "new"),
+		"tags":     testTicketTags("terraform_fixture"),
+	})
+
+	state = p.refresh("zendesk_ticket", state)
+	checkTicketAttributes(t, p, state, map[string]tftypes.Value{
+		"priority": tftypes.NewValue(tftypes.String, "urgent"),
+		"status":   tftypes.NewValue(tftypes.String, "open"),
+		"tags":     testTicketTags("escalated", "terraform_fixture"),
+	})
+}
+
+func TestTicketValidation(t *testing.T) {
+	p := newProtocolTest(t, "")
+
+	for name, config := range map[string]string{
+		"priority": `{"subject": "Refund", "comment": {"body": "Refund"}, "priority": "critical"}`,
+		"type":     `{"subject": "Refund", "comment": {"body": "Refund"}, "type": "request"}`,
+	} {
+		if diags := p.validate("zendesk_ticket", config); !hasProtocolError(diags, "Invalid Attribute Value Match") {
+			t.Errorf("expected an error for an invalid %s, got %v", name, diags)
+		}
+	}
+}
+
+func testTicketTags(tags ...string) tftypes.Value {
+	values := make([]tftypes.Value, 0, len(tags))
+	for _, tag := range tags {
+		values = append(values, tftypes.NewValue(tftypes.String, tag))
+	}
+	return tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, values)
+}
+
+func checkTicketAttributes(t *testing.T, p *protocolTest, state *tfprotov6.DynamicValue, want map[string]tftypes.Value) {
+	t.Helper()
+
+	for name, value := range want {
+		if got := p.attribute("zendesk_ticket", state, name); !got.Equal(value) {
+			t.Errorf("expected %s %s, got %s", name, value, got)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &TriggerCategoriesDataSource{}
+)
+
+func NewTriggerCategoriesDataSource() datasource.DataSource {
+	return &TriggerCategoriesDataSource{}
+}
+
+type TriggerCategoriesDataSource struct {
+	client *Client
+}
+
+type TriggerCategoriesDataSourceModel struct {
+	Name       types.String                 `tfsdk:"name"`
+	Categories []TriggerCategoriesItemModel `tfsdk:"categories"`
+}
+
+type TriggerCategoriesItemModel struct {
+	ID       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Position types.Int64  `tfsdk:"position"`
+}
+
+func (d *TriggerCategoriesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_trigger_categories"
+}
+
+func (d *TriggerCategoriesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists Zendesk trigger categories, sorted by position.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Only return the categories with this exact name. An error is returned if none match.",
+				Optional:    true,
+			},
+			"categories": schema.ListNestedAttribute{
+				Description: "The trigger categories.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the trigger category.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the trigger category.",
+							Computed:    true,
+						},
+						"position": schema.Int64Attribute{
+							Description: "The position of the trigger category.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TriggerCategoriesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TriggerCategoriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config TriggerCategoriesDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	categories, err := d.client.ListTriggerCategories(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Trigger Categories",
+			fmt.Sprintf("Could not list trigger categories: %v", err),
+		)
+		return
+	}
+
+	sort.SliceStable(categories, func(i, j int) bool {
+		if categories[i].Position != categories[j].Position {
+			return categories[i].Position < categories[j].Position
+		}
+		return categories[i].ID < categories[j].ID
+	})
+
+	config.Categories = make([]TriggerCategoriesItemModel, 0, len(categories))
+	for _, category := range categories {
+		if !config.Name.IsNull() && category.Name != config.Name.ValueString() {
+			continue
+		}
+
+		config.Categories = append(config.Categories, TriggerCategoriesItemModel{
+			ID:       types.StringValue(category.ID),
+			Name:     types.StringValue(category.Name),
+			Position: types.Int64Value(category.Position),
+		})
+	}
+
+	if !config.Name.IsNull() && len(config.Categories) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Trigger Category Not Found",
+			fmt.Sprintf("No trigger category found with name %q.", config.Name.ValueString()),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                     = &TriggerDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &TriggerDataSource{}
+)
+
+func NewTriggerDataSource() datasource.DataSource {
+	return &TriggerDataSource{}
+}
+
+type TriggerDataSource struct {
+	client *Client
+}
+
+type TriggerDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Title          types.String `tfsdk:"title"`
+	Active         types.Bool   `tfsdk:"active"`
+	Position       types.Int64  `tfsdk:"position"`
+	CategoryID     types.String `tfsdk:"category_id"`
+	ConditionsJSON types.String `tfsdk:"conditions_json"`
+	ActionsJSON    types.String `tfsdk:"actions_json"`
+}
+
+func (d *TriggerDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_trigger"
+}
+
+func (d *TriggerDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a Zendesk trigger by ID or exact title.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the trigger. Exactly one of id or title must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"title": schema.StringAttribute{
+				Description: "The exact title of the trigger. Exactly one of id or title must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether the trigger is active.",
+				Computed:    true,
+			},
+			"position": schema.Int64Attribute{
+				Description: "The position of the trigger.",
+				Computed:    true,
+			},
+			"category_id": schema.StringAttribute{
+				Description: "The ID of the trigger category.",
+				Computed:    true,
+			},
+			"conditions_json": schema.StringAttribute{
+				Description: "The conditions of the trigger, as returned by the API, encoded as JSON.",
+				Computed:    true,
+			},
+			"actions_json": schema.StringAttribute{
+				Description: "The actions of the trigger, as returned by the API, encoded as JSON.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *TriggerDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("title"),
+		),
+	}
+}
+
+func (d *TriggerDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TriggerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config TriggerDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var trigger *Trigger
+	if !config.ID.IsNull() {
+		id, err := strconv.ParseInt(config.ID.ValueString(), 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Error Parsing Trigger ID",
+				fmt.Sprintf("Could not parse trigger ID: %v", err),
+			)
+			return
+		}
+
+		trigger, err = d.client.ReadTrigger(ctx, id)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Trigger",
+				fmt.Sprintf("Could not read trigger: %v", err),
+			)
+			return
+		}
+
+		if trigger == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Trigger Not Found",
+				fmt.Sprintf("No trigger found with id %q.", config.ID.ValueString()),
+			)
+			return
+		}
+	} else {
+		triggers, err := d.client.SearchTriggers(ctx, config.Title.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Searching Triggers",
+				fmt.Sprintf("Could not search triggers: %v", err),
+			)
+			return
+		}
+
+		var found []Trigger
+		for _, t := range triggers {
+			if t.Title == config.Title.ValueString() {
+				found = append(found, t)
+			}
+		}
+
+		switch len(found) {
+		case 0:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("title"),
+				"Trigger Not Found",
+				fmt.Sprintf("No trigger found with title %q.", config.Title.ValueString()),
+			)
+			return
+		case 1:
+			trigger = &found[0]
+		default:
+			ids := make([]string, 0, len(found))
+			for _, t := range found {
+				ids = append(ids, strconv.FormatInt(t.ID, 10))
+			}
+			resp.Diagnostics.AddAttributeError(
+				path.Root("title"),
+				"Multiple Triggers Found",
+				fmt.Sprintf("Found %d triggers with title %q (IDs: %s). Look the trigger up by id instead.", len(found), config.Title.ValueString(), strings.Join(ids, ", ")),
+			)
+			return
+		}
+	}
+
+	config.ID = types.StringValue(strconv.FormatInt(trigger.ID, 10))
+	config.Title = types.StringValue(trigger.Title)
+	config.Active = types.BoolValue(trigger.Active)
+	config.Position = types.Int64Value(positionValue(trigger.Position))
+	config.CategoryID = types.StringValue(trigger.CategoryID)
+	config.ConditionsJSON = jsonStringValue(trigger.Conditions)
+	config.ActionsJSON = jsonStringValue(trigger.Actions)
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,287 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &TriggerOrderResource{}
+	_ resource.ResourceWithImportState = &TriggerOrderResource{}
+	_ resource.ResourceWithModifyPlan  = &TriggerOrderResource{}
+)
+
+// triggerOrderID is the fixed ID of the trigger order, which is a singleton.
+const triggerOrderID = "trigger_order"
+
+func NewTriggerOrderResource() resource.Resource {
+	return &TriggerOrderResource{}
+}
+
+type TriggerOrderResource struct {
+	client *Client
+}
+
+type TriggerOrderResourceModel struct {
+	ID         types.String                `tfsdk:"id"`
+	Categories []TriggerOrderCategoryModel `tfsdk:"categories"`
+}
+
+type TriggerOrderCategoryModel struct {
+	CategoryID types.String   `tfsdk:"category_id"`
+	TriggerIDs []types.String `tfsdk:"trigger_ids"`
+}
+
+func (r *TriggerOrderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_trigger_order"
+}
+
+func (r *TriggerOrderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the order of trigger categories and of the triggers in them, applied in a single batch job. Triggers ordered here should not set position.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: fmt.Sprintf("Always %q, as the account has a single trigger order.", triggerOrderID),
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"categories": schema.ListNestedAttribute{
+				Description: "The trigger categories, in the order they run.",
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"category_id": schema.StringAttribute{
+							Description: "The ID of the trigger category.",
+							Required:    true,
+						},
+						"trigger_ids": schema.ListAttribute{
+							Description: "The IDs of the triggers of the category, in the order they fire. A trigger listed under another category is moved to this one.",
+							Required:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *TriggerOrderResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan rejects triggers and categories listed twice, and warns about triggers that also
+// set their own position.
+func (r *TriggerOrderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan TriggerOrderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seenCategories := map[string]int{}
+	for i, category := range plan.Categories {
+		if category.CategoryID.IsUnknown() {
+			continue
+		}
+		if first, ok := seenCategories[category.CategoryID.ValueString()]; ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("categories").AtListIndex(i).AtName("category_id"),
+				"Duplicate Trigger Category",
+				fmt.Sprintf("Trigger category %s is listed more than once; it is first listed at index %d.", category.CategoryID.ValueString(), first),
+			)
+			continue
+		}
+		seenCategories[category.CategoryID.ValueString()] = i
+	}
+
+	seenTriggers := map[string]path.Path{}
+	for i, category := range plan.Categories {
+		attr := path.Root("categories").AtListIndex(i).AtName("trigger_ids")
+		resp.Diagnostics.Append(duplicateIDDiagnostics(attr, category.TriggerIDs, seenTriggers)...)
+
+		if r.client == nil {
+			continue
+		}
+		for j, id := range category.TriggerIDs {
+			if !id.IsUnknown() && r.client.positionClaims.claimOrder("trigger", id.ValueString()) {
+				resp.Diagnostics.Append(positionConflictWarning(attr.AtListIndex(j), "trigger", id.ValueString(), "zendesk_trigger_order"))
+			}
+		}
+	}
+}
+
+func (r *TriggerOrderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TriggerOrderResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.ReorderTriggers(ctx, expandTriggerOrder(plan)); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Ordering Triggers",
+			fmt.Sprintf("Could not reorder triggers: %v", err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(triggerOrderID)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *TriggerOrderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TriggerOrderResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	categories, err := r.client.ListTriggerCategories(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Trigger Order",
+			fmt.Sprintf("Could not list trigger categories: %v", err),
+		)
+		return
+	}
+
+	triggers, err := r.client.ListTriggers(ctx, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Trigger Order",
+			fmt.Sprintf("Could not list triggers: %v", err),
+		)
+		return
+	}
+
+	flattenTriggerOrder(categories, triggers, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *TriggerOrderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TriggerOrderResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.ReorderTriggers(ctx, expandTriggerOrder(plan)); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Ordering Triggers",
+			fmt.Sprintf("Could not reorder triggers: %v", err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(triggerOrderID)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete leaves the triggers in their current order.
+func (r *TriggerOrderResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// ImportState reads the order of every category and trigger.
+func (r *TriggerOrderResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), triggerOrderID)...)
+}
+
+func expandTriggerOrder(model TriggerOrderResourceModel) []TriggerCategoryOrder {
+	order := make([]TriggerCategoryOrder, 0, len(model.Categories))
+	for _, category := range model.Categories {
+		order = append(order, TriggerCategoryOrder{
+			CategoryID: category.CategoryID.ValueString(),
+			TriggerIDs: expandStringList(category.TriggerIDs),
+		})
+	}
+	return order
+}
+
+// flattenTriggerOrder rebuilds the order of the categories and triggers in the state from their
+// positions. Triggers and categories the state does not list are left out, so that only the
+// order of the managed ones is compared. An empty state, as after import, lists them all.
+func flattenTriggerOrder(categories []TriggerCategory, triggers []Trigger, model *TriggerOrderResourceModel) {
+	all := len(model.Categories) == 0
+	declared := map[string]bool{}
+	managed := map[string]bool{}
+	for _, category := range model.Categories {
+		declared[category.CategoryID.ValueString()] = true
+		for _, id := range category.TriggerIDs {
+			managed[id.ValueString()] = true
+		}
+	}
+
+	sort.SliceStable(categories, func(i, j int) bool {
+		return categories[i].Position < categories[j].Position
+	})
+	sort.SliceStable(triggers, func(i, j int) bool {
+		if positionValue(triggers[i].Position) != positionValue(triggers[j].Position) {
+			return positionValue(triggers[i].Position) < positionValue(triggers[j].Position)
+		}
+		return triggers[i].ID < triggers[j].ID
+	})
+
+	model.ID = types.StringValue(triggerOrderID)
+	model.Categories = make([]TriggerOrderCategoryModel, 0, len(categories))
+	for _, category := range categories {
+		if !all && !declared[category.ID] {
+			continue
+		}
+
+		ids := []types.String{}
+		for _, trigger := range triggers {
+			id := strconv.FormatInt(trigger.ID, 10)
+			if trigger.CategoryID == category.ID && (all || managed[id]) {
+				ids = append(ids, types.StringValue(id))
+			}
+		}
+
+		model.Categories = append(model.Categories, TriggerOrderCategoryModel{
+			CategoryID: types.StringValue(category.ID),
+			TriggerIDs: ids,
+		})
+	}
+}
@@ -0,0 +1,320 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &TriggerResource{}
+	_ resource.ResourceWithImportState = &TriggerResource{}
+	_ resource.ResourceWithModifyPlan  = &TriggerResource{}
+)
+
+func NewTriggerResource() resource.Resource {
+	return &TriggerResource{}
+}
+
+type TriggerResource struct {
+	client *Client
+}
+
+type TriggerResourceModel struct {
+	ID                  types.String         `tfsdk:"id"`
+	Title               types.String         `tfsdk:"title"`
+	Active              types.Bool           `tfsdk:"active"`
+	Position            types.Int64          `tfsdk:"position"`
+	CategoryID          types.String         `tfsdk:"category_id"`
+	Description         types.String         `tfsdk:"description"`
+	Conditions          *RuleConditionsModel `tfsdk:"conditions"`
+	Actions             []RuleActionModel    `tfsdk:"actions"`
+	DeactivateOnDelete  types.Bool           `tfsdk:"deactivate_on_delete"`
+	IgnoreServerChanges types.Set            `tfsdk:"ignore_server_changes"`
+}
+
+func (r *TriggerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_trigger"
+}
+
+func (r *TriggerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Zendesk ticket trigger.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the trigger.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "The title of the trigger.",
+				Required:    true,
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether the trigger is active. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"position": schema.Int64Attribute{
+				Description: "The position of the trigger, which determines the order in which triggers fire.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"category_id": schema.StringAttribute{
+				Description: "The ID of the trigger category. Zendesk assigns the default category when not set.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the trigger.",
+				Optional:    true,
+			},
+			"conditions":            ruleConditionsAttribute("The conditions the ticket must meet for the trigger to fire."),
+			"actions":               ruleActionsAttribute("The actions performed on the ticket when the trigger fires."),
+			"deactivate_on_delete":  deactivateOnDeleteAttribute(),
+			"ignore_server_changes": ignoreServerChangesAttribute("position"),
+		},
+	}
+}
+
+func (r *TriggerResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TriggerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan TriggerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var position types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("position"), &position)...)
+	if !position.IsNull() && !plan.ID.IsUnknown() && r.client.positionClaims.claimPosition("trigger", plan.ID.ValueString()) {
+		resp.Diagnostics.Append(positionConflictWarning(path.Root("position"), "trigger", plan.ID.ValueString(), "zendesk_trigger_order"))
+	}
+
+	if r.client.validatePlaceholders {
+		resp.Diagnostics.Append(ruleActionPlaceholderDiagnostics(path.Root("actions"), plan.Actions)...)
+	}
+}
+
+func (r *TriggerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TriggerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	position := rulePosition(plan.Position, types.Int64Null(), plan.IgnoreServerChanges)
+	trigger, err := r.client.CreateTrigger(ctx, expandTrigger(plan, position))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Trigger",
+			fmt.Sprintf("Could not create trigger: %v", err),
+		)
+		return
+	}
+
+	planned := plan.Position
+	flattenTrigger(trigger, &plan)
+	if position == nil && !planned.IsUnknown() {
+		plan.Position = planned
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *TriggerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TriggerResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Trigger ID",
+			fmt.Sprintf("Could not parse trigger ID: %v", err),
+		)
+		return
+	}
+
+	trigger, err := r.client.ReadTrigger(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Trigger",
+			fmt.Sprintf("Could not read trigger: %v", err),
+		)
+		return
+	}
+
+	if trigger == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	position := state.Position
+	flattenTrigger(trigger, &state)
+	if ignoresServerChanges(state.IgnoreServerChanges, "position") && !position.IsNull() {
+		state.Position = position
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *TriggerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TriggerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The planned position of a trigger that does not configure one is the prior state, which
+	// zendesk_trigger_order may have changed since, so only the configured position is sent.
+	var configured, prior types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("position"), &configured)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("position"), &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(plan.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Trigger ID",
+			fmt.Sprintf("Could not parse trigger ID: %v", err),
+		)
+		return
+	}
+
+	position := rulePosition(configured, prior, plan.IgnoreServerChanges)
+	trigger, err := r.client.UpdateTrigger(ctx, id, expandTrigger(plan, position))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Trigger",
+			fmt.Sprintf("Could not update trigger: %v", err),
+		)
+		return
+	}
+
+	planned := plan.Position
+	flattenTrigger(trigger, &plan)
+	if position == nil && !planned.IsUnknown() {
+		plan.Position = planned
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *TriggerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TriggerResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Trigger ID",
+			fmt.Sprintf("Could not parse trigger ID: %v", err),
+		)
+		return
+	}
+
+	if deactivateOnDelete(r.client, state.DeactivateOnDelete) {
+		trigger := expandTrigger(state, nil)
+		trigger.Active = false
+
+		_, err = r.client.UpdateTrigger(ctx, id, trigger)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Deactivating Trigger",
+				fmt.Sprintf("Could not deactivate trigger: %v", err),
+			)
+		}
+		return
+	}
+
+	err = r.client.DeleteTrigger(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Trigger",
+			fmt.Sprintf("Could not delete trigger: %v", err),
+		)
+		return
+	}
+}
+
+func (r *TriggerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func expandTrigger(model TriggerResourceModel, position *int64) Trigger {
+	return Trigger{
+		Title:       model.Title.ValueString(),
+		Active:      model.Active.ValueBool(),
+		Position:    position,
+		CategoryID:  model.CategoryID.ValueString(),
+		Description: model.Description.ValueString(),
+		Conditions:  expandRuleConditions(model.Conditions),
+		Actions:     expandRuleActions(model.Actions),
+	}
+}
+
+func flattenTrigger(trigger *Trigger, model *TriggerResourceModel) {
+	model.ID = types.StringValue(strconv.FormatInt(trigger.ID, 10))
+	model.Title = types.StringValue(trigger.Title)
+	model.Active = types.BoolValue(trigger.Active)
+	model.Position = types.Int64Value(positionValue(trigger.Position))
+	model.CategoryID = optionalStringValue(trigger.CategoryID)
+	if trigger.Description != "" || !model.Description.IsNull() {
+		model.Description = types.StringValue(trigger.Description)
+	}
+	model.Conditions = flattenRuleConditions(trigger.Conditions, model.Conditions)
+	model.Actions = flattenRuleActions(trigger.Actions, model.Actions)
+}
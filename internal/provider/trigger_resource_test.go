@@ -0,0 +1,238 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// A trigger without a configured position keeps the one Zendesk assigned, so renumbering by
+// Zendesk refreshes into the state without a plan.
+func TestTriggerPositionRenumbered(t *testing.T) {
+	p := newProtocolTest(t, "trigger_position_renumbered.json")
+
+	state := p.read("zendesk_trigger", `{
+		"id": "1000001", "title": "Tag new tickets", "active": true, "position": 3, "category_id": "1000002",
+		"conditions": {"all": [{"field": "update_type", "operator": "is", "value": "Create"}]},
+		"actions": [{"field": "set_tags", "value": "new"}]
+	}`)
+	if got, want := p.attribute("zendesk_trigger", state, "position"), tftypes.NewValue(tftypes.Number, 4); !got.Equal(want) {
+		t.Fatalf("expected the refreshed position %s, got %s", want, got)
+	}
+
+	p.planUnchanged("zendesk_trigger", `{
+		"title": "Tag new tickets",
+		"conditions": {"all": [{"field": "update_type", "operator": "is", "value": "Create"}]},
+		"actions": [{"field": "set_tags", "value": "new"}]
+	}`, state)
+}
+
+func TestTriggerPositionRenumberedIgnored(t *testing.T) {
+	p := newProtocolTest(t, "trigger_position_renumbered_ignored.json")
+
+	state := p.read("zendesk_trigger", `{
+		"id": "1000001", "title": "Tag new tickets", "active": true, "position": 3, "category_id": "1000002",
+		"conditions": {"all": [{"field": "update_type", "operator": "is", "value": "Create"}]},
+		"actions": [{"field": "set_tags", "value": "new"}],
+		"ignore_server_changes": ["position"]
+	}`)
+
+	p.planUnchanged("zendesk_trigger", `{
+		"title": "Tag new tickets", "position": 3,
+		"conditions": {"all": [{"field": "update_type", "operator": "is", "value": "Create"}]},
+		"actions": [{"field": "set_tags", "value": "new"}],
+		"ignore_server_changes": ["position"]
+	}`, state)
+}
+
+// The planned position of a trigger without a configured one comes from the prior state and is
+// not sent, so that it does not undo an order set by zendesk_trigger_order. The fixture fails
+// the test if the request body has a position.
+func TestTriggerUpdateWithoutPosition(t *testing.T) {
+	p := newProtocolTest(t, "trigger_update_without_position.json")
+
+	state := p.read("zendesk_trigger", `{
+		"id": "1000001", "title": "Tag new tickets", "active": true, "position": 3, "category_id": "1000002",
+		"conditions": {"all": [{"field": "update_type", "operator": "is", "value": "Create"}]},
+		"actions": [{"field": "set_tags", "value": "new"}]
+	}`)
+
+	p.apply("zendesk_trigger", `{
+		"title": "Tag new tickets",
+		"conditions": {"all": [{"field": "update_type", "operator": "is", "value": "Create"}]},
+		"actions": [{"field": "set_tags", "value": "new"}, {"field": "priority", "value": "high"}]
+	}`, state)
+}
+
+func TestRulePosition(t *testing.T) {
+	ignored := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("position")})
+
+	cases := []struct {
+		name     string
+		position types.Int64
+		prior    types.Int64
+		ignored  types.Set
+		want     *int64
+	}{
+		{name: "null", position: types.Int64Null(), prior: types.Int64Value(3), ignored: types.SetNull(types.StringType)},
+		{name: "unknown", position: types.Int64Unknown(), prior: types.Int64Value(3), ignored: types.SetNull(types.StringType)},
+		{name: "configured", position: types.Int64Value(3), prior: types.Int64Value(3), ignored: types.SetNull(types.StringType), want: int64Pointer(3)},
+		{name: "ignored", position: types.Int64Value(3), prior: types.Int64Value(3), ignored: ignored},
+		{name: "ignored but changed", position: types.Int64Value(2), prior: types.Int64Value(3), ignored: ignored, want: int64Pointer(2)},
+		{name: "ignored on create", position: types.Int64Value(2), prior: types.Int64Null(), ignored: ignored, want: int64Pointer(2)},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := rulePosition(c.position, c.prior, c.ignored)
+			if (got == nil) != (c.want == nil) || (got != nil && *got != *c.want) {
+				t.Errorf("rulePosition() = %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func int64Pointer(v int64) *int64 {
+	return &v
+}
+
+func TestAccTrigger_deactivateOnDelete(t *testing.T) {
+	title := acctest.RandomWithPrefix("tf-acc-trigger")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckTriggersDeactivated,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTriggerConfig("", title, "deactivate_on_delete = true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("zendesk_trigger.test", "title", title),
+					resource.TestCheckResourceAttr("zendesk_trigger.test", "active", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTrigger_deactivateOnDeleteProviderDefault(t *testing.T) {
+	title := acctest.RandomWithPrefix("tf-acc-trigger")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckTriggersDeactivated,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTriggerConfig("deactivate_on_delete = true", title, ""),
+			},
+		},
+	})
+}
+
+// The resource argument overrides the provider setting, so the trigger is deleted.
+func TestAccTrigger_deactivateOnDeleteOverride(t *testing.T) {
+	title := acctest.RandomWithPrefix("tf-acc-trigger")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckTriggersDeleted,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTriggerConfig("deactivate_on_delete = true", title, "deactivate_on_delete = false"),
+			},
+		},
+	})
+}
+
+func testAccTriggerConfig(providerArguments, title, resourceArguments string) string {
+	return testAccProviderConfig(providerArguments) + fmt.Sprintf(`
+resource "zendesk_trigger" "test" {
+  title = %[1]q
+
+  conditions = {
+    all = [
+      { field = "update_type", operator = "is", value = "Create" },
+      { field = "current_tags", operator = "includes", value = "tf_acc_never_set" },
+    ]
+  }
+
+  actions = [
+    { field = "set_tags", value = "tf_acc" },
+  ]
+
+  %[2]s
+}
+`, title, resourceArguments)
+}
+
+// testAccCheckTriggersDeactivated checks that destroyed triggers still exist but are inactive, and
+// deletes them.
+func testAccCheckTriggersDeactivated(s *terraform.State) error {
+	ctx := context.Background()
+	client := testAccClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "zendesk_trigger" {
+			continue
+		}
+
+		id, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		trigger, err := client.ReadTrigger(ctx, id)
+		if err != nil {
+			return err
+		}
+		if trigger == nil {
+			return fmt.Errorf("trigger %d was deleted instead of deactivated", id)
+		}
+		if trigger.Active {
+			return fmt.Errorf("trigger %d is still active", id)
+		}
+
+		if err := client.DeleteTrigger(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckTriggersDeleted(s *terraform.State) error {
+	ctx := context.Background()
+	client := testAccClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "zendesk_trigger" {
+			continue
+		}
+
+		id, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		trigger, err := client.ReadTrigger(ctx, id)
+		if err != nil {
+			return err
+		}
+		if trigger != nil {
+			return fmt.Errorf("trigger %d still exists", id)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &TriggersDataSource{}
+)
+
+func NewTriggersDataSource() datasource.DataSource {
+	return &TriggersDataSource{}
+}
+
+type TriggersDataSource struct {
+	client *Client
+}
+
+type TriggersDataSourceModel struct {
+	CategoryID types.String        `tfsdk:"category_id"`
+	Active     types.Bool          `tfsdk:"active"`
+	Triggers   []TriggersItemModel `tfsdk:"triggers"`
+}
+
+type TriggersItemModel struct {
+	ID         types.String `tfsdk:"id"`
+	Title      types.String `tfsdk:"title"`
+	Position   types.Int64  `tfsdk:"position"`
+	CategoryID types.String `tfsdk:"category_id"`
+	UpdatedAt  types.String `tfsdk:"updated_at"`
+}
+
+func (d *TriggersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_triggers"
+}
+
+func (d *TriggersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists Zendesk triggers, sorted by position so the list reflects evaluation order.",
+		Attributes: map[string]schema.Attribute{
+			"category_id": schema.StringAttribute{
+				Description: "Only return triggers in this trigger category.",
+				Optional:    true,
+			},
+			"active": schema.BoolAttribute{
+				Description: "Only return active (true) or inactive (false) triggers.",
+				Optional:    true,
+			},
+			"triggers": schema.ListNestedAttribute{
+				Description: "The triggers.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the trigger.",
+							Computed:    true,
+						},
+						"title": schema.StringAttribute{
+							Description: "The title of the trigger.",
+							Computed:    true,
+						},
+						"position": schema.Int64Attribute{
+							Description: "The position of the trigger.",
+							Computed:    true,
+						},
+						"category_id": schema.StringAttribute{
+							Description: "The ID of the trigger category.",
+							Computed:    true,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "When the trigger was last updated.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TriggersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TriggersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config TriggersDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := url.Values{}
+	if !config.CategoryID.IsNull() {
+		params.Set("category_id", config.CategoryID.ValueString())
+	}
+	if !config.Active.IsNull() {
+		params.Set("active", strconv.FormatBool(config.Active.ValueBool()))
+	}
+
+	triggers, err := d.client.ListTriggers(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Triggers",
+			fmt.Sprintf("Could not list triggers: %v", err),
+		)
+		return
+	}
+
+	sort.SliceStable(triggers, func(i, j int) bool {
+		if positionValue(triggers[i].Position) != positionValue(triggers[j].Position) {
+			return positionValue(triggers[i].Position) < positionValue(triggers[j].Position)
+		}
+		return triggers[i].ID < triggers[j].ID
+	})
+
+	config.Triggers = make([]TriggersItemModel, 0, len(triggers))
+	for _, trigger := range triggers {
+		config.Triggers = append(config.Triggers, TriggersItemModel{
+			ID:         types.StringValue(strconv.FormatInt(trigger.ID, 10)),
+			Title:      types.StringValue(trigger.Title),
+			Position:   types.Int64Value(positionValue(trigger.Position)),
+			CategoryID: types.StringValue(trigger.CategoryID),
+			UpdatedAt:  types.StringValue(trigger.UpdatedAt),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
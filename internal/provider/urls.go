@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	subdomainPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+	objectIDPattern  = regexp.MustCompile(`^([0-9]+|\{\{\s*[a-z0-9_.]+\s*\}\})$`)
+)
+
+// adminObjectPaths maps the object types accepted by admin_object_url to their Admin Center
+// path, relative to /admin.
+var adminObjectPaths = map[string]string{
+	"automation":         "objects-rules/rules/automations",
+	"custom_role":        "people/team/roles",
+	"group":              "people/team/groups",
+	"macro":              "workspaces/agent-workspace/macros",
+	"organization_field": "people/configuration/organization-fields",
+	"ticket_field":       "objects-rules/tickets/ticket-fields",
+	"ticket_form":        "objects-rules/tickets/ticket-forms/edit",
+	"trigger":            "objects-rules/rules/triggers",
+	"user_field":         "people/configuration/user-fields",
+	"view":               "workspaces/agent-workspace/views",
+	"webhook":            "apps-integrations/webhooks/webhooks",
+}
+
+func adminObjectTypes() []string {
+	objectTypes := make([]string, 0, len(adminObjectPaths))
+	for objectType := range adminObjectPaths {
+		objectTypes = append(objectTypes, objectType)
+	}
+	sort.Strings(objectTypes)
+	return objectTypes
+}
+
+func validateSubdomain(subdomain string) error {
+	if !subdomainPattern.MatchString(subdomain) {
+		return fmt.Errorf("%q is not a valid Zendesk subdomain, expected e.g. \"company\" for company.zendesk.com", subdomain)
+	}
+	return nil
+}
+
+func validateAdminObjectType(objectType string) error {
+	if _, ok := adminObjectPaths[objectType]; !ok {
+		return fmt.Errorf("%q is not a supported object type, expected one of: %s", objectType, strings.Join(adminObjectTypes(), ", "))
+	}
+	return nil
+}
+
+// validateObjectID accepts numeric IDs and Liquid placeholders such as {{ticket.id}}, so the
+// URLs can be used in notification bodies.
+func validateObjectID(id string) error {
+	if !objectIDPattern.MatchString(id) {
+		return fmt.Errorf("%q is not a valid ID, expected a number or a placeholder such as {{ticket.id}}", id)
+	}
+	return nil
+}
+
+func agentTicketURL(subdomain, id string) (string, error) {
+	if err := validateSubdomain(subdomain); err != nil {
+		return "", err
+	}
+	if err := validateObjectID(id); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("https://%s.zendesk.com/agent/tickets/%s", subdomain, id), nil
+}
+
+func adminObjectURL(subdomain, objectType, id string) (string, error) {
+	if err := validateSubdomain(subdomain); err != nil {
+		return "", err
+	}
+
+	if err := validateAdminObjectType(objectType); err != nil {
+		return "", err
+	}
+	if err := validateObjectID(id); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("https://%s.zendesk.com/admin/%s/%s", subdomain, adminObjectPaths[objectType], id), nil
+}
@@ -0,0 +1,295 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                     = &UserDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &UserDataSource{}
+)
+
+func NewUserDataSource() datasource.DataSource {
+	return &UserDataSource{}
+}
+
+type UserDataSource struct {
+	client *Client
+}
+
+// UserModel holds the user attributes exported by the user data sources.
+type UserModel struct {
+	ID             types.String   `tfsdk:"id"`
+	Email          types.String   `tfsdk:"email"`
+	ExternalID     types.String   `tfsdk:"external_id"`
+	Name           types.String   `tfsdk:"name"`
+	Role           types.String   `tfsdk:"role"`
+	CustomRoleID   types.String   `tfsdk:"custom_role_id"`
+	DefaultGroupID types.String   `tfsdk:"default_group_id"`
+	OrganizationID types.String   `tfsdk:"organization_id"`
+	Phone          types.String   `tfsdk:"phone"`
+	TimeZone       types.String   `tfsdk:"time_zone"`
+	Locale         types.String   `tfsdk:"locale"`
+	Tags           []types.String `tfsdk:"tags"`
+	UserFields     types.Map      `tfsdk:"user_fields"`
+	Active         types.Bool     `tfsdk:"active"`
+	Suspended      types.Bool     `tfsdk:"suspended"`
+	Verified       types.Bool     `tfsdk:"verified"`
+}
+
+// userAttributes returns the schema of the exported user attributes. When lookup is true,
+// id, email and external_id can also be set to search for the user.
+func userAttributes(lookup bool) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the user.",
+			Optional:    lookup,
+			Computed:    true,
+		},
+		"email": schema.StringAttribute{
+			Description: "The primary email address of the user.",
+			Optional:    lookup,
+			Computed:    true,
+		},
+		"external_id": schema.StringAttribute{
+			Description: "The external ID of the user.",
+			Optional:    lookup,
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "The name of the user.",
+			Computed:    true,
+		},
+		"role": schema.StringAttribute{
+			Description: "The role of the user (end-user, agent or admin).",
+			Computed:    true,
+		},
+		"custom_role_id": schema.StringAttribute{
+			Description: "The ID of the user's custom role, if any.",
+			Computed:    true,
+		},
+		"default_group_id": schema.StringAttribute{
+			Description: "The ID of the user's default group, if any.",
+			Computed:    true,
+		},
+		"organization_id": schema.StringAttribute{
+			Description: "The ID of the user's organization, if any.",
+			Computed:    true,
+		},
+		"phone": schema.StringAttribute{
+			Description: "The primary phone number of the user.",
+			Computed:    true,
+		},
+		"time_zone": schema.StringAttribute{
+			Description: "The time zone of the user.",
+			Computed:    true,
+		},
+		"locale": schema.StringAttribute{
+			Description: "The locale of the user.",
+			Computed:    true,
+		},
+		"tags": schema.ListAttribute{
+			Description: "The tags of the user.",
+			Computed:    true,
+			ElementType: types.StringType,
+		},
+		"user_fields": schema.MapAttribute{
+			Description: "The values of the user's custom fields, keyed by field key.",
+			Computed:    true,
+			ElementType: types.StringType,
+		},
+		"active": schema.BoolAttribute{
+			Description: "Whether the user is active.",
+			Computed:    true,
+		},
+		"suspended": schema.BoolAttribute{
+			Description: "Whether the user is suspended.",
+			Computed:    true,
+		},
+		"verified": schema.BoolAttribute{
+			Description: "Whether the user's primary identity is verified.",
+			Computed:    true,
+		},
+	}
+}
+
+func flattenUser(ctx context.Context, user *User) (UserModel, diag.Diagnostics) {
+	userFields, diags := stringMapValue(ctx, user.UserFields)
+
+	return UserModel{
+		ID:             types.StringValue(strconv.FormatInt(user.ID, 10)),
+		Email:          types.StringValue(user.Email),
+		ExternalID:     types.StringValue(user.ExternalID),
+		Name:           types.StringValue(user.Name),
+		Role:           types.StringValue(user.Role),
+		CustomRoleID:   optionalIDValue(user.CustomRoleID),
+		DefaultGroupID: optionalIDValue(user.DefaultGroupID),
+		OrganizationID: optionalIDValue(user.OrganizationID),
+		Phone:          types.StringValue(user.Phone),
+		TimeZone:       types.StringValue(user.TimeZone),
+		Locale:         types.StringValue(user.Locale),
+		Tags:           stringListValue(user.Tags),
+		UserFields:     userFields,
+		Active:         types.BoolValue(user.Active),
+		Suspended:      types.BoolValue(user.Suspended),
+		Verified:       types.BoolValue(user.Verified),
+	}, diags
+}
+
+func (d *UserDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (d *UserDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a Zendesk user by ID, email, or external ID.",
+		Attributes:  userAttributes(true),
+	}
+}
+
+func (d *UserDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("email"),
+			path.MatchRoot("external_id"),
+		),
+	}
+}
+
+func (d *UserDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config UserModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var user *User
+	switch {
+	case !config.ID.IsNull():
+		id, err := strconv.ParseInt(config.ID.ValueString(), 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Error Parsing User ID",
+				fmt.Sprintf("Could not parse user ID: %v", err),
+			)
+			return
+		}
+
+		user, err = d.client.ReadUser(ctx, id)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading User",
+				fmt.Sprintf("Could not read user: %v", err),
+			)
+			return
+		}
+
+		if user == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"User Not Found",
+				fmt.Sprintf("No user found with id %q.", config.ID.ValueString()),
+			)
+			return
+		}
+	case !config.Email.IsNull():
+		email := config.Email.ValueString()
+		user = d.searchOne(ctx, &resp.Diagnostics, path.Root("email"), "email", email,
+			url.Values{"query": []string{"email:" + email}},
+			func(u User) bool { return strings.EqualFold(u.Email, email) },
+		)
+	default:
+		externalID := config.ExternalID.ValueString()
+		user = d.searchOne(ctx, &resp.Diagnostics, path.Root("external_id"), "external_id", externalID,
+			url.Values{"external_id": []string{externalID}},
+			func(u User) bool { return u.ExternalID == externalID },
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := flattenUser(ctx, user)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// searchOne searches users and returns the single result matching exactly, reporting an
+// error listing the candidates when there is no match or more than one.
+func (d *UserDataSource) searchOne(ctx context.Context, diags *diag.Diagnostics, attr path.Path, key, value string, params url.Values, matches func(User) bool) *User {
+	users, err := d.client.SearchUsers(ctx, params)
+	if err != nil {
+		diags.AddError(
+			"Error Searching Users",
+			fmt.Sprintf("Could not search users: %v", err),
+		)
+		return nil
+	}
+
+	var found []User
+	for _, user := range users {
+		if matches(user) {
+			found = append(found, user)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		diags.AddAttributeError(
+			attr,
+			"User Not Found",
+			fmt.Sprintf("No user found with %s %q.", key, value),
+		)
+		return nil
+	case 1:
+		return &found[0]
+	}
+
+	candidates := make([]string, 0, len(found))
+	for _, user := range found {
+		candidates = append(candidates, fmt.Sprintf("%d (%s <%s>)", user.ID, user.Name, user.Email))
+	}
+	diags.AddAttributeError(
+		attr,
+		"Multiple Users Found",
+		fmt.Sprintf("Found %d users with %s %q: %s. Look the user up by id instead.", len(found), key, value, strings.Join(candidates, ", ")),
+	)
+	return nil
+}
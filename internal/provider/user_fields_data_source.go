@@ -0,0 +1,12 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+func NewUserFieldsDataSource() datasource.DataSource {
+	return &KeyedFieldsDataSource{
+		kind: "user",
+		list: (*Client).ListUserFields,
+	}
+}
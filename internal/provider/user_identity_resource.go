@@ -0,0 +1,373 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &UserIdentityResource{}
+	_ resource.ResourceWithImportState = &UserIdentityResource{}
+	_ resource.ResourceWithModifyPlan  = &UserIdentityResource{}
+)
+
+func NewUserIdentityResource() resource.Resource {
+	return &UserIdentityResource{}
+}
+
+type UserIdentityResource struct {
+	client *Client
+}
+
+type UserIdentityResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	UserID   types.String `tfsdk:"user_id"`
+	Type     types.String `tfsdk:"type"`
+	Value    types.String `tfsdk:"value"`
+	Verified types.Bool   `tfsdk:"verified"`
+	Primary  types.Bool   `tfsdk:"primary"`
+}
+
+func (r *UserIdentityResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_identity"
+}
+
+func (r *UserIdentityResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an identity of a Zendesk user, such as a secondary email address or a phone number.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the identity.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Description: "The ID of the user.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of the identity: email, twitter, facebook, google, phone_number or agent_forwarding.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("email", "twitter", "facebook", "google", "phone_number", "agent_forwarding"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Description: "The value of the identity, such as the email address or the phone number.",
+				Required:    true,
+			},
+			"verified": schema.BoolAttribute{
+				Description: "Whether the identity is verified. Identities created as verified skip the verification email. A verified identity cannot be unverified, so setting this to false replaces it.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplaceIf(
+						func(_ context.Context, req planmodifier.BoolRequest, resp *boolplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = req.StateValue.ValueBool() && !req.PlanValue.IsUnknown() && !req.PlanValue.ValueBool()
+						},
+						"Replaces the identity when a verified identity is set to unverified.",
+						"Replaces the identity when a verified identity is set to unverified.",
+					),
+				},
+			},
+			"primary": schema.BoolAttribute{
+				Description: "Whether the identity is the primary identity of its type. Setting this to true demotes the previous primary identity.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan rejects demoting a primary identity, which Zendesk only does when another identity is
+// made primary.
+func (r *UserIdentityResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state UserIdentityResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.Primary.IsUnknown() {
+		return
+	}
+
+	if state.Primary.ValueBool() && !plan.Primary.ValueBool() && plan.UserID.Equal(state.UserID) && plan.Type.Equal(state.Type) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("primary"),
+			"Cannot Demote Primary Identity",
+			"A primary identity cannot be set to non-primary. Set primary to true on another identity of the user instead.",
+		)
+	}
+}
+
+func (r *UserIdentityResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *UserIdentityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan UserIdentityResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID, err := strconv.ParseInt(plan.UserID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing User ID",
+			fmt.Sprintf("Could not parse user ID: %v", err),
+		)
+		return
+	}
+
+	identity, err := r.client.CreateUserIdentity(ctx, userID, UserIdentity{
+		Type:     plan.Type.ValueString(),
+		Value:    plan.Value.ValueString(),
+		Verified: plan.Verified.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating User Identity",
+			fmt.Sprintf("Could not create user identity: %v", err),
+		)
+		return
+	}
+
+	if plan.Primary.ValueBool() && !identity.Primary {
+		primary, err := r.makePrimary(ctx, userID, identity.ID)
+		if err != nil {
+			// The identity was created, so it is kept in state and made primary by the next apply.
+			flattenUserIdentity(identity, &plan)
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			resp.Diagnostics.AddError(
+				"Error Making User Identity Primary",
+				fmt.Sprintf("Could not make user identity %d primary: %v", identity.ID, err),
+			)
+			return
+		}
+		identity = primary
+	}
+
+	flattenUserIdentity(identity, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *UserIdentityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state UserIdentityResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID, id, err := parseUserIdentityIDs(state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing User Identity IDs",
+			fmt.Sprintf("Could not parse user or identity ID: %v", err),
+		)
+		return
+	}
+
+	// Deleted users remain readable until they are permanently deleted, so the identities of an
+	// inactive user are treated as gone.
+	user, err := r.client.ReadUser(ctx, userID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading User",
+			fmt.Sprintf("Could not read user: %v", err),
+		)
+		return
+	}
+
+	if user == nil || !user.Active {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	identity, err := r.client.ReadUserIdentity(ctx, userID, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading User Identity",
+			fmt.Sprintf("Could not read user identity: %v", err),
+		)
+		return
+	}
+
+	if identity == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	flattenUserIdentity(identity, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *UserIdentityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state UserIdentityResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID, id, err := parseUserIdentityIDs(state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing User Identity IDs",
+			fmt.Sprintf("Could not parse user or identity ID: %v", err),
+		)
+		return
+	}
+
+	identity, err := r.client.UpdateUserIdentity(ctx, userID, id, UserIdentity{
+		Value:    plan.Value.ValueString(),
+		Verified: plan.Verified.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating User Identity",
+			fmt.Sprintf("Could not update user identity %d: %v", id, err),
+		)
+		return
+	}
+
+	if plan.Primary.ValueBool() && !identity.Primary {
+		identity, err = r.makePrimary(ctx, userID, id)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Making User Identity Primary",
+				fmt.Sprintf("Could not make user identity %d primary: %v", id, err),
+			)
+			return
+		}
+	}
+
+	flattenUserIdentity(identity, &plan)
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *UserIdentityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state UserIdentityResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID, id, err := parseUserIdentityIDs(state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing User Identity IDs",
+			fmt.Sprintf("Could not parse user or identity ID: %v", err),
+		)
+		return
+	}
+
+	err = r.client.DeleteUserIdentity(ctx, userID, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting User Identity",
+			fmt.Sprintf("Could not delete user identity %d: %v", id, err),
+		)
+		return
+	}
+}
+
+// ImportState accepts user_id/identity_id.
+func (r *UserIdentityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := splitImportID(req.ID, "user_id", "identity_id")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
+
+// makePrimary makes the identity primary and reads it back, since make_primary responds with all
+// the identities of the user.
+func (r *UserIdentityResource) makePrimary(ctx context.Context, userID, id int64) (*UserIdentity, error) {
+	if err := r.client.MakeUserIdentityPrimary(ctx, userID, id); err != nil {
+		return nil, err
+	}
+
+	identity, err := r.client.ReadUserIdentity(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if identity == nil {
+		return nil, fmt.Errorf("user identity %d was not found", id)
+	}
+
+	return identity, nil
+}
+
+func flattenUserIdentity(identity *UserIdentity, model *UserIdentityResourceModel) {
+	model.ID = types.StringValue(strconv.FormatInt(identity.ID, 10))
+	model.UserID = types.StringValue(strconv.FormatInt(identity.UserID, 10))
+	model.Type = types.StringValue(identity.Type)
+	model.Value = types.StringValue(identity.Value)
+	model.Verified = types.BoolValue(identity.Verified)
+	model.Primary = types.BoolValue(identity.Primary)
+}
+
+func parseUserIdentityIDs(model UserIdentityResourceModel) (int64, int64, error) {
+	userID, err := strconv.ParseInt(model.UserID.ValueString(), 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	id, err := strconv.ParseInt(model.ID.ValueString(), 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return userID, id, nil
+}
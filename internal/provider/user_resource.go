@@ -0,0 +1,346 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &UserResource{}
+	_ resource.ResourceWithImportState = &UserResource{}
+)
+
+func NewUserResource() resource.Resource {
+	return &UserResource{}
+}
+
+type UserResource struct {
+	client *Client
+}
+
+type UserResourceModel struct {
+	ID             types.String   `tfsdk:"id"`
+	Name           types.String   `tfsdk:"name"`
+	Email          types.String   `tfsdk:"email"`
+	Role           types.String   `tfsdk:"role"`
+	CustomRoleID   types.String   `tfsdk:"custom_role_id"`
+	DefaultGroupID types.String   `tfsdk:"default_group_id"`
+	OrganizationID types.String   `tfsdk:"organization_id"`
+	Phone          types.String   `tfsdk:"phone"`
+	TimeZone       types.String   `tfsdk:"time_zone"`
+	Locale         types.String   `tfsdk:"locale"`
+	Tags           []types.String `tfsdk:"tags"`
+	UserFields     types.Map      `tfsdk:"user_fields"`
+}
+
+func (r *UserResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *UserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// Attributes Zendesk fills in when they are not configured, such as the default group of an
+	// agent or the time zone of the account.
+	serverDefault := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Description: description,
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a Zendesk user, typically an agent. Destroying the resource deletes the user, which downgrades agents to end users; Zendesk keeps deleted users until they are permanently deleted.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the user.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the user.",
+				Required:    true,
+			},
+			"email": schema.StringAttribute{
+				Description: "The primary email address of the user. Zendesk only sets it on creation, so changing it forces a new resource.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Description: "The role of the user: end-user, agent or admin. Defaults to end-user.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("end-user"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("end-user", "agent", "admin"),
+				},
+			},
+			"custom_role_id":   serverDefault("The ID of the custom role of an agent, on plans with custom roles."),
+			"default_group_id": serverDefault("The ID of the default group of an agent."),
+			"organization_id":  serverDefault("The ID of the primary organization of the user."),
+			"phone":            serverDefault("The primary phone number of the user."),
+			"time_zone":        serverDefault("The time zone of the user. Defaults to the time zone of the account."),
+			"locale":           serverDefault("The locale of the user. Defaults to the locale of the account."),
+			"tags": schema.SetAttribute{
+				Description: "The tags of the user. Requires user tagging to be enabled on the account.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"user_fields": schema.MapAttribute{
+				Description: "Values of custom user fields, keyed by field key. Only the keys set here are managed; values are strings, as for the user fields data source.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *UserResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan UserResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, diags := expandUserResource(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	user.Email = plan.Email.ValueString()
+
+	created, err := r.client.CreateUser(ctx, user)
+	if err != nil {
+		// A user with the same email is reported as a validation error. Adopting it silently would
+		// bypass review, so the user is pointed to import instead.
+		if messages := validationMessages(err); messages != nil {
+			detail := strings.Join(messages, " ")
+			if strings.Contains(strings.ToLower(detail), "email") {
+				detail += fmt.Sprintf(" If a user with the email %q already exists, import it with its ID instead of creating it.", plan.Email.ValueString())
+			}
+			resp.Diagnostics.AddAttributeError(path.Root("email"), "Error Creating User", detail)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Creating User",
+			fmt.Sprintf("Could not create user: %v", err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flattenUserResource(ctx, created, &plan)...)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state UserResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing User ID",
+			fmt.Sprintf("Could not parse user ID: %v", err),
+		)
+		return
+	}
+
+	user, err := r.client.ReadUser(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading User",
+			fmt.Sprintf("Could not read user: %v", err),
+		)
+		return
+	}
+
+	// Deleted users are still returned, inactive, until they are permanently deleted.
+	if user == nil || !user.Active {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Email = types.StringValue(user.Email)
+	resp.Diagnostics.Append(flattenUserResource(ctx, user, &state)...)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan UserResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(plan.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing User ID",
+			fmt.Sprintf("Could not parse user ID: %v", err),
+		)
+		return
+	}
+
+	user, diags := expandUserResource(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateUser(ctx, id, user)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating User",
+			fmt.Sprintf("Could not update user: %v", err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flattenUserResource(ctx, updated, &plan)...)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state UserResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing User ID",
+			fmt.Sprintf("Could not parse user ID: %v", err),
+		)
+		return
+	}
+
+	err = r.client.DeleteUser(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting User",
+			fmt.Sprintf("Could not delete user: %v", err),
+		)
+		return
+	}
+}
+
+func (r *UserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// expandUserResource builds the request body of a user, leaving out the email, which can only be set
+// on creation.
+func expandUserResource(ctx context.Context, model UserResourceModel) (User, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	user := User{
+		Name:     model.Name.ValueString(),
+		Role:     model.Role.ValueString(),
+		Phone:    model.Phone.ValueString(),
+		TimeZone: model.TimeZone.ValueString(),
+		Locale:   model.Locale.ValueString(),
+	}
+
+	var d diag.Diagnostics
+	user.CustomRoleID, d = expandOptionalID(model.CustomRoleID, path.Root("custom_role_id"))
+	diags.Append(d...)
+	user.DefaultGroupID, d = expandOptionalID(model.DefaultGroupID, path.Root("default_group_id"))
+	diags.Append(d...)
+	user.OrganizationID, d = expandOptionalID(model.OrganizationID, path.Root("organization_id"))
+	diags.Append(d...)
+
+	if model.Tags != nil {
+		user.Tags = make([]string, 0, len(model.Tags))
+		for _, tag := range model.Tags {
+			user.Tags = append(user.Tags, tag.ValueString())
+		}
+	}
+
+	if !model.UserFields.IsNull() {
+		fields := map[string]string{}
+		diags.Append(model.UserFields.ElementsAs(ctx, &fields, false)...)
+
+		user.UserFields = make(map[string]interface{}, len(fields))
+		for key, value := range fields {
+			user.UserFields[key] = value
+		}
+	}
+
+	return user, diags
+}
+
+func flattenUserResource(ctx context.Context, user *User, model *UserResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.ID = types.StringValue(strconv.FormatInt(user.ID, 10))
+	model.Name = types.StringValue(user.Name)
+	model.Role = types.StringValue(user.Role)
+	model.CustomRoleID = optionalIDValue(user.CustomRoleID)
+	model.DefaultGroupID = optionalIDValue(user.DefaultGroupID)
+	model.OrganizationID = optionalIDValue(user.OrganizationID)
+	model.Phone = optionalStringValue(user.Phone)
+	model.TimeZone = optionalStringValue(user.TimeZone)
+	model.Locale = optionalStringValue(user.Locale)
+
+	if len(user.Tags) > 0 || model.Tags != nil {
+		model.Tags = stringListValue(user.Tags)
+	}
+
+	if !model.UserFields.IsNull() {
+		model.UserFields, diags = flattenManagedFields(ctx, model.UserFields, user.UserFields)
+	}
+
+	return diags
+}
@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &UsersDataSource{}
+)
+
+func NewUsersDataSource() datasource.DataSource {
+	return &UsersDataSource{}
+}
+
+type UsersDataSource struct {
+	client *Client
+}
+
+type UsersDataSourceModel struct {
+	Role           types.String `tfsdk:"role"`
+	CustomRoleID   types.String `tfsdk:"custom_role_id"`
+	GroupID        types.String `tfsdk:"group_id"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	Query          types.String `tfsdk:"query"`
+	Users          []UserModel  `tfsdk:"users"`
+}
+
+func (d *UsersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *UsersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists Zendesk users matching the given filters, sorted by ID. Filters that the chosen endpoint does not support natively are applied client-side.",
+		Attributes: map[string]schema.Attribute{
+			"role": schema.StringAttribute{
+				Description: "Only return users with this role (end-user, agent or admin).",
+				Optional:    true,
+			},
+			"custom_role_id": schema.StringAttribute{
+				Description: "Only return users with this custom role.",
+				Optional:    true,
+			},
+			"group_id": schema.StringAttribute{
+				Description: "Only return users that are members of this group.",
+				Optional:    true,
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "Only return users that belong to this organization.",
+				Optional:    true,
+			},
+			"query": schema.StringAttribute{
+				Description: "A free-text users search query (e.g., 'tags:vip').",
+				Optional:    true,
+			},
+			"users": schema.ListNestedAttribute{
+				Description: "The matching users.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: userAttributes(false),
+				},
+			},
+		},
+	}
+}
+
+func (d *UsersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config UsersDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var groupID, organizationID, customRoleID int64
+	for _, filter := range []struct {
+		attr  string
+		value types.String
+		out   *int64
+	}{
+		{"group_id", config.GroupID, &groupID},
+		{"organization_id", config.OrganizationID, &organizationID},
+		{"custom_role_id", config.CustomRoleID, &customRoleID},
+	} {
+		if filter.value.IsNull() {
+			continue
+		}
+
+		id, err := strconv.ParseInt(filter.value.ValueString(), 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(filter.attr),
+				"Error Parsing ID",
+				fmt.Sprintf("Could not parse %s: %v", filter.attr, err),
+			)
+			return
+		}
+		*filter.out = id
+	}
+
+	users, groupMembersFetched, err := d.fetchUsers(ctx, config, groupID, organizationID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Users",
+			fmt.Sprintf("Could not list users: %v", err),
+		)
+		return
+	}
+
+	// The search endpoint cannot filter by group membership, so intersect with the group's members.
+	var groupMembers map[int64]bool
+	if groupID != 0 && !groupMembersFetched {
+		members, err := d.client.ListUsers(ctx, fmt.Sprintf("/api/v2/groups/%d/users.json", groupID), nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Listing Group Members",
+				fmt.Sprintf("Could not list the members of group %d: %v", groupID, err),
+			)
+			return
+		}
+
+		groupMembers = make(map[int64]bool, len(members))
+		for _, member := range members {
+			groupMembers[member.ID] = true
+		}
+	}
+
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].ID < users[j].ID
+	})
+
+	config.Users = make([]UserModel, 0, len(users))
+	for i := range users {
+		user := &users[i]
+
+		if !config.Role.IsNull() && user.Role != config.Role.ValueString() {
+			continue
+		}
+		if customRoleID != 0 && (user.CustomRoleID == nil || *user.CustomRoleID != customRoleID) {
+			continue
+		}
+		if organizationID != 0 && (user.OrganizationID == nil || *user.OrganizationID != organizationID) {
+			continue
+		}
+		if groupMembers != nil && !groupMembers[user.ID] {
+			continue
+		}
+
+		model, diags := flattenUser(ctx, user)
+		resp.Diagnostics.Append(diags...)
+		config.Users = append(config.Users, model)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// fetchUsers picks the most selective endpoint for the configured filters. The returned
+// bool reports whether the users already are restricted to the members of the group.
+func (d *UsersDataSource) fetchUsers(ctx context.Context, config UsersDataSourceModel, groupID, organizationID int64) ([]User, bool, error) {
+	if !config.Query.IsNull() {
+		users, err := d.client.SearchUsers(ctx, url.Values{"query": []string{config.Query.ValueString()}})
+		return users, false, err
+	}
+
+	params := url.Values{}
+	if !config.Role.IsNull() {
+		params.Set("role", config.Role.ValueString())
+	}
+	if !config.CustomRoleID.IsNull() {
+		params.Set("permission_set", config.CustomRoleID.ValueString())
+	}
+
+	switch {
+	case groupID != 0:
+		users, err := d.client.ListUsers(ctx, fmt.Sprintf("/api/v2/groups/%d/users.json", groupID), params)
+		return users, true, err
+	case organizationID != 0:
+		users, err := d.client.ListUsers(ctx, fmt.Sprintf("/api/v2/organizations/%d/users.json", organizationID), params)
+		return users, false, err
+	default:
+		users, err := d.client.ListUsers(ctx, "/api/v2/users.json", params)
+		return users, false, err
+	}
+}
@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// optionalIDValue converts a nullable numeric ID returned by the API into a string value.
+func optionalIDValue(id *int64) types.String {
+	if id == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(strconv.FormatInt(*id, 10))
+}
+
+// expandOptionalID parses an optional string ID, returning nil when it is null or unknown.
+func expandOptionalID(value types.String, attr path.Path) (*int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if value.IsNull() || value.IsUnknown() {
+		return nil, diags
+	}
+
+	id, err := strconv.ParseInt(value.ValueString(), 10, 64)
+	if err != nil {
+		diags.AddAttributeError(attr, "Invalid ID", fmt.Sprintf("Could not parse ID %q: %v", value.ValueString(), err))
+		return nil, diags
+	}
+	return &id, diags
+}
+
+// expandOptionalInt64 returns the value of an optional number, or nil when it is null or unknown.
+func expandOptionalInt64(value types.Int64) *int64 {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	return value.ValueInt64Pointer()
+}
+
+// optionalStringValue converts a string returned by the API, which is empty when unset, into a
+// nullable string value.
+func optionalStringValue(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// stringifyValue converts a loosely typed JSON value into its string form.
+func stringifyValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		raw, _ := json.Marshal(v)
+		return string(raw)
+	}
+}
+
+// stringMapValue converts a loosely typed JSON object into a map of strings, dropping null values.
+func stringMapValue(ctx context.Context, values map[string]interface{}) (types.Map, diag.Diagnostics) {
+	result := make(map[string]string, len(values))
+	for key, value := range values {
+		if value == nil {
+			continue
+		}
+		result[key] = stringifyValue(value)
+	}
+	return types.MapValueFrom(ctx, types.StringType, result)
+}
+
+func stringListValue(values []string) []types.String {
+	result := make([]types.String, 0, len(values))
+	for _, value := range values {
+		result = append(result, types.StringValue(value))
+	}
+	return result
+}
+
+// expandStringList converts a list of strings, returning an empty rather than nil slice for a null
+// list so that it is sent to the API.
+func expandStringList(values []types.String) []string {
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		result = append(result, value.ValueString())
+	}
+	return result
+}
+
+func idListValue(ids []int64) []types.String {
+	result := make([]types.String, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, types.StringValue(strconv.FormatInt(id, 10)))
+	}
+	return result
+}
+
+// expandIDList parses a list of string IDs, reporting the invalid ones as errors on the list
+// attribute at attr.
+func expandIDList(values []types.String, attr path.Path) ([]int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	ids := make([]int64, 0, len(values))
+	for _, value := range values {
+		id, err := strconv.ParseInt(value.ValueString(), 10, 64)
+		if err != nil {
+			diags.AddAttributeError(attr, "Invalid ID", fmt.Sprintf("Could not parse ID %q: %v", value.ValueString(), err))
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids, diags
+}
+
+// flattenIDList is like idListValue, but keeps an unset list null when the API returns no IDs.
+func flattenIDList(ids []int64, prior []types.String) []types.String {
+	if len(ids) == 0 && prior == nil {
+		return nil
+	}
+	return idListValue(ids)
+}
+
+// jsonStringValue encodes a value as a JSON string, for attributes exposing raw API structures.
+func jsonStringValue(value interface{}) types.String {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return types.StringNull()
+	}
+	return types.StringValue(string(raw))
+}
+
+// flattenTimestamp converts an RFC 3339 timestamp returned by Zendesk, keeping the prior value
+// when it denotes the same instant in another offset. Empty timestamps are null.
+func flattenTimestamp(value string, prior types.String) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+
+	if !prior.IsNull() && !prior.IsUnknown() {
+		priorTime, priorErr := time.Parse(time.RFC3339, prior.ValueString())
+		valueTime, valueErr := time.Parse(time.RFC3339, value)
+		if priorErr == nil && valueErr == nil && priorTime.Equal(valueTime) {
+			return prior
+		}
+	}
+
+	return types.StringValue(value)
+}
+
+// flattenManagedFields only refreshes the field keys already present in state, so custom fields
+// that are not managed by Terraform do not show up as drift.
+func flattenManagedFields(ctx context.Context, prior types.Map, remote map[string]interface{}) (types.Map, diag.Diagnostics) {
+	managed := map[string]string{}
+	diags := prior.ElementsAs(ctx, &managed, false)
+
+	for key := range managed {
+		value, ok := remote[key]
+		if !ok || value == nil {
+			delete(managed, key)
+			continue
+		}
+		managed[key] = stringifyValue(value)
+	}
+
+	fields, d := types.MapValueFrom(ctx, types.StringType, managed)
+	diags.Append(d...)
+	return fields, diags
+}
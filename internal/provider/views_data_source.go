@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &ViewsDataSource{}
+)
+
+func NewViewsDataSource() datasource.DataSource {
+	return &ViewsDataSource{}
+}
+
+type ViewsDataSource struct {
+	client *Client
+}
+
+type ViewsDataSourceModel struct {
+	Active  types.Bool       `tfsdk:"active"`
+	GroupID types.String     `tfsdk:"group_id"`
+	Views   []ViewsItemModel `tfsdk:"views"`
+	Titles  types.Map        `tfsdk:"titles"`
+}
+
+type ViewsItemModel struct {
+	ID          types.String      `tfsdk:"id"`
+	Title       types.String      `tfsdk:"title"`
+	Active      types.Bool        `tfsdk:"active"`
+	Position    types.Int64       `tfsdk:"position"`
+	Restriction *RestrictionModel `tfsdk:"restriction"`
+}
+
+func (d *ViewsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_views"
+}
+
+func (d *ViewsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists Zendesk views, sorted by position.",
+		Attributes: map[string]schema.Attribute{
+			"active": schema.BoolAttribute{
+				Description: "Only return active (true) or inactive (false) views.",
+				Optional:    true,
+			},
+			"group_id": schema.StringAttribute{
+				Description: "Only return views available to this group.",
+				Optional:    true,
+			},
+			"views": schema.ListNestedAttribute{
+				Description: "The views.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the view.",
+							Computed:    true,
+						},
+						"title": schema.StringAttribute{
+							Description: "The title of the view.",
+							Computed:    true,
+						},
+						"active": schema.BoolAttribute{
+							Description: "Whether the view is active.",
+							Computed:    true,
+						},
+						"position": schema.Int64Attribute{
+							Description: "The position of the view.",
+							Computed:    true,
+						},
+						"restriction": restrictionDataSourceAttribute("Who may use the view. Null when the view is available to all agents."),
+					},
+				},
+			},
+			"titles": schema.MapAttribute{
+				Description: "The view IDs keyed by title. When several views share a title, the first one by position is used.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (d *ViewsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ViewsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ViewsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := url.Values{}
+	if !config.Active.IsNull() {
+		params.Set("active", strconv.FormatBool(config.Active.ValueBool()))
+	}
+	if !config.GroupID.IsNull() {
+		params.Set("group_id", config.GroupID.ValueString())
+	}
+
+	views, err := d.client.ListViews(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Views",
+			fmt.Sprintf("Could not list views: %v", err),
+		)
+		return
+	}
+
+	sort.SliceStable(views, func(i, j int) bool {
+		if views[i].Position != views[j].Position {
+			return views[i].Position < views[j].Position
+		}
+		return views[i].ID < views[j].ID
+	})
+
+	titles := make(map[string]string, len(views))
+	config.Views = make([]ViewsItemModel, 0, len(views))
+	for _, view := range views {
+		id := strconv.FormatInt(view.ID, 10)
+		if _, ok := titles[view.Title]; !ok {
+			titles[view.Title] = id
+		}
+
+		config.Views = append(config.Views, ViewsItemModel{
+			ID:          types.StringValue(id),
+			Title:       types.StringValue(view.Title),
+			Active:      types.BoolValue(view.Active),
+			Position:    types.Int64Value(view.Position),
+			Restriction: flattenRestriction(view.Restriction),
+		})
+	}
+
+	config.Titles, diags = types.MapValueFrom(ctx, types.StringType, titles)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// waitOptions configures waitFor.
+type waitOptions struct {
+	// Description names what is waited for in logs and errors, e.g. "job 123 to finish".
+	Description string
+	// Interval is the delay between two checks of the condition.
+	Interval time.Duration
+	// Timeout bounds the wait. When zero, only the deadline of the context applies, such as the
+	// one derived from a timeouts block.
+	Timeout time.Duration
+}
+
+// waitFor checks condition until it reports done or fails, the timeout expires, or ctx is
+// cancelled. It is the shared poller for asynchronous and eventually consistent states.
+func waitFor(ctx context.Context, opts waitOptions, condition func() (bool, error)) error {
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	for attempt := 1; ; attempt++ {
+		done, err := condition()
+		if err != nil {
+			return err
+		}
+		if done {
+			tflog.Debug(ctx, "Finished waiting for "+opts.Description, map[string]interface{}{
+				"attempts": attempt,
+				"elapsed":  time.Since(start).String(),
+			})
+			return nil
+		}
+
+		tflog.Info(ctx, "Waiting for "+opts.Description, map[string]interface{}{
+			"attempt": attempt,
+			"elapsed": time.Since(start).String(),
+		})
+
+		timer := time.NewTimer(opts.Interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				elapsed := opts.Timeout
+				if elapsed == 0 {
+					elapsed = time.Since(start).Round(time.Second)
+				}
+				return fmt.Errorf("timed out after %s waiting for %s", elapsed, opts.Description)
+			}
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWaitForSucceedsAfterPolling(t *testing.T) {
+	checks := 0
+	err := waitFor(context.Background(), waitOptions{Description: "job 1 to finish", Interval: time.Millisecond, Timeout: time.Second}, func() (bool, error) {
+		checks++
+		return checks == 3, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if checks != 3 {
+		t.Errorf("expected 3 checks, got %d", checks)
+	}
+}
+
+func TestWaitForConditionError(t *testing.T) {
+	failure := errors.New("job failed")
+
+	checks := 0
+	err := waitFor(context.Background(), waitOptions{Description: "job 1 to finish", Interval: time.Millisecond}, func() (bool, error) {
+		checks++
+		if checks == 2 {
+			return false, failure
+		}
+		return false, nil
+	})
+	if !errors.Is(err, failure) {
+		t.Fatalf("expected the condition error, got %v", err)
+	}
+	if checks != 2 {
+		t.Errorf("expected 2 checks, got %d", checks)
+	}
+}
+
+func TestWaitForTimeout(t *testing.T) {
+	err := waitFor(context.Background(), waitOptions{Description: "job 1 to finish", Interval: time.Millisecond, Timeout: 20 * time.Millisecond}, func() (bool, error) {
+		return false, nil
+	})
+	if err == nil {
+		t.Fatal("expected a timeout error")
+	}
+	if want := "timed out after 20ms waiting for job 1 to finish"; err.Error() != want {
+		t.Errorf("expected %q, got %q", want, err.Error())
+	}
+}
+
+// Without a timeout, the deadline of the context, such as the one of a timeouts block, bounds
+// the wait.
+func TestWaitForContextDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err := waitFor(ctx, waitOptions{Description: "job 1 to finish", Interval: time.Millisecond}, func() (bool, error) {
+		return false, nil
+	})
+	if err == nil || !strings.HasPrefix(err.Error(), "timed out after ") {
+		t.Fatalf("expected a timeout error, got %v", err)
+	}
+}
+
+func TestWaitForCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	checks := 0
+	err := waitFor(ctx, waitOptions{Description: "job 1 to finish", Interval: time.Hour}, func() (bool, error) {
+		checks++
+		cancel()
+		return false, nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the context to be cancelled, got %v", err)
+	}
+	if checks != 1 {
+		t.Errorf("expected 1 check, got %d", checks)
+	}
+}
@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &WebhooksDataSource{}
+)
+
+func NewWebhooksDataSource() datasource.DataSource {
+	return &WebhooksDataSource{}
+}
+
+type WebhooksDataSource struct {
+	client *Client
+}
+
+type WebhooksDataSourceModel struct {
+	NameContains types.String        `tfsdk:"name_contains"`
+	Status       types.String        `tfsdk:"status"`
+	Webhooks     []WebhooksItemModel `tfsdk:"webhooks"`
+}
+
+type WebhooksItemModel struct {
+	ID            types.String   `tfsdk:"id"`
+	Name          types.String   `tfsdk:"name"`
+	Endpoint      types.String   `tfsdk:"endpoint"`
+	HTTPMethod    types.String   `tfsdk:"http_method"`
+	Status        types.String   `tfsdk:"status"`
+	CreatedBy     types.String   `tfsdk:"created_by"`
+	Subscriptions []types.String `tfsdk:"subscriptions"`
+}
+
+func (d *WebhooksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhooks"
+}
+
+func (d *WebhooksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists Zendesk webhooks, sorted by name. Signing secrets are not exposed.",
+		Attributes: map[string]schema.Attribute{
+			"name_contains": schema.StringAttribute{
+				Description: "Only return webhooks whose name contains this string.",
+				Optional:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Only return webhooks with this status, 'active' or 'inactive'.",
+				Optional:    true,
+			},
+			"webhooks": schema.ListNestedAttribute{
+				Description: "The webhooks.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the webhook.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the webhook.",
+							Computed:    true,
+						},
+						"endpoint": schema.StringAttribute{
+							Description: "The destination URL of the webhook.",
+							Computed:    true,
+						},
+						"http_method": schema.StringAttribute{
+							Description: "The HTTP method used to call the endpoint.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The status of the webhook.",
+							Computed:    true,
+						},
+						"created_by": schema.StringAttribute{
+							Description: "The ID of the user who created the webhook.",
+							Computed:    true,
+						},
+						"subscriptions": schema.ListAttribute{
+							Description: "The event subscriptions of the webhook.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *WebhooksDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *WebhooksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config WebhooksDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := url.Values{}
+	if !config.NameContains.IsNull() {
+		params.Set("filter[name_contains]", config.NameContains.ValueString())
+	}
+	if !config.Status.IsNull() {
+		params.Set("filter[status]", config.Status.ValueString())
+	}
+
+	webhooks, err := d.client.ListWebhooks(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Webhooks",
+			fmt.Sprintf("Could not list webhooks: %v", err),
+		)
+		return
+	}
+
+	sort.SliceStable(webhooks, func(i, j int) bool {
+		if webhooks[i].Name != webhooks[j].Name {
+			return webhooks[i].Name < webhooks[j].Name
+		}
+		return webhooks[i].ID < webhooks[j].ID
+	})
+
+	config.Webhooks = make([]WebhooksItemModel, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		config.Webhooks = append(config.Webhooks, WebhooksItemModel{
+			ID:            types.StringValue(webhook.ID),
+			Name:          types.StringValue(webhook.Name),
+			Endpoint:      types.StringValue(webhook.Endpoint),
+			HTTPMethod:    types.StringValue(webhook.HTTPMethod),
+			Status:        types.StringValue(webhook.Status),
+			CreatedBy:     types.StringValue(webhook.CreatedBy),
+			Subscriptions: stringListValue(webhook.Subscriptions),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
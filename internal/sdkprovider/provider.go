@@ -0,0 +1,100 @@
+// Package sdkprovider serves resources written with terraform-plugin-sdk/v2. It is muxed with the
+// plugin-framework provider in main.go under the same provider address, so both kinds of
+// resources are available to Terraform side by side. New resources should use the framework;
+// this provider exists for contributions that depend on the SDK.
+package sdkprovider
+
+import (
+	"context"
+
+	"github.com/diogocosta/terraform-provider-zendesk/internal/provider"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// New returns the SDK provider. Its schema must match the one of the framework provider exactly,
+// including descriptions, or the mux server refuses to start.
+func New(version string) func() *schema.Provider {
+	return func() *schema.Provider {
+		p := &schema.Provider{
+			Schema: map[string]*schema.Schema{
+				"subdomain": {
+					Type:        schema.TypeString,
+					Description: "The Zendesk subdomain (e.g., company in company.zendesk.com)",
+					Required:    true,
+				},
+				"email": {
+					Type:        schema.TypeString,
+					Description: "The email address associated with the Zendesk account",
+					Required:    true,
+				},
+				"api_token": {
+					Type:        schema.TypeString,
+					Description: "The API token for authentication",
+					Required:    true,
+					Sensitive:   true,
+				},
+				"deactivate_on_delete": {
+					Type:        schema.TypeBool,
+					Description: "Whether destroying triggers, automations, macros and object triggers deactivates them instead of deleting them, keeping their audit history. Resources can override it with their own deactivate_on_delete argument. Defaults to false.",
+					Optional:    true,
+				},
+				"validate_placeholders": {
+					Type:        schema.TypeBool,
+					Description: "Whether to check the Liquid placeholders of notification bodies and macro comments at plan time against the catalog of Zendesk placeholders. Malformed placeholders are errors and unknown ones warnings. Defaults to false.",
+					Optional:    true,
+				},
+				"validate_references": {
+					Type:        schema.TypeBool,
+					Description: "Whether to check at plan time that the fields referenced by the conditions of object triggers exist on their custom object, which costs one API request per object trigger. Unknown fields are warnings. Defaults to false.",
+					Optional:    true,
+				},
+				"max_retries": {
+					Type:         schema.TypeInt,
+					Description:  "The number of times a request rejected by the Zendesk rate limit (429 Too Many Requests) is retried, waiting for the delay given by its Retry-After header, before failing. Defaults to 5.",
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+			},
+			ResourcesMap:         map[string]*schema.Resource{},
+			DataSourcesMap:       map[string]*schema.Resource{},
+			ConfigureContextFunc: configure,
+		}
+
+		p.UserAgent("terraform-provider-zendesk", version)
+
+		return p
+	}
+}
+
+// configure gives SDK resources the same API client as the framework resources, configured with
+// the same provider arguments.
+func configure(_ context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	client := provider.NewClientWithOptions(
+		d.Get("subdomain").(string),
+		d.Get("email").(string),
+		d.Get("api_token").(string),
+		clientOptions(d),
+	)
+	return client, nil
+}
+
+// clientOptions reads the provider arguments that configure the client besides its credentials.
+// max_retries is read from the raw configuration, where an explicit 0 differs from an unset value.
+func clientOptions(d *schema.ResourceData) provider.ClientOptions {
+	opts := provider.ClientOptions{
+		DeactivateOnDelete:   d.Get("deactivate_on_delete").(bool),
+		ValidatePlaceholders: d.Get("validate_placeholders").(bool),
+		ValidateReferences:   d.Get("validate_references").(bool),
+	}
+
+	if raw := d.GetRawConfig(); !raw.IsNull() {
+		if maxRetries := raw.GetAttr("max_retries"); maxRetries.IsKnown() && !maxRetries.IsNull() {
+			value, _ := maxRetries.AsBigFloat().Int64()
+			opts.MaxRetries = &value
+		}
+	}
+
+	return opts
+}
@@ -0,0 +1,94 @@
+package sdkprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/diogocosta/terraform-provider-zendesk/internal/provider"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// configureOptions configures the provider through its protocol server, as Terraform does, and
+// returns the client options read from the configuration. Arguments left out are null.
+func configureOptions(t *testing.T, arguments map[string]tftypes.Value) provider.ClientOptions {
+	t.Helper()
+
+	var opts provider.ClientOptions
+	p := New("test")()
+	p.ConfigureContextFunc = func(_ context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		opts = clientOptions(d)
+		return nil, nil
+	}
+
+	attributeTypes := map[string]tftypes.Type{
+		"subdomain":             tftypes.String,
+		"email":                 tftypes.String,
+		"api_token":             tftypes.String,
+		"deactivate_on_delete":  tftypes.Bool,
+		"validate_placeholders": tftypes.Bool,
+		"validate_references":   tftypes.Bool,
+		"max_retries":           tftypes.Number,
+	}
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range attributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+		if value, ok := arguments[name]; ok {
+			values[name] = value
+		}
+	}
+
+	configType := tftypes.Object{AttributeTypes: attributeTypes}
+	config, err := tfprotov5.NewDynamicValue(configType, tftypes.NewValue(configType, values))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := p.GRPCProvider().ConfigureProvider(context.Background(), &tfprotov5.ConfigureProviderRequest{Config: &config})
+	if err != nil {
+		t.Fatalf("ConfigureProvider: %v", err)
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			t.Fatalf("unexpected error: %s: %s", d.Summary, d.Detail)
+		}
+	}
+
+	return opts
+}
+
+func TestClientOptions(t *testing.T) {
+	opts := configureOptions(t, map[string]tftypes.Value{
+		"subdomain":             tftypes.NewValue(tftypes.String, "example"),
+		"email":                 tftypes.NewValue(tftypes.String, "agent@example.com"),
+		"api_token":             tftypes.NewValue(tftypes.String, "token"),
+		"deactivate_on_delete":  tftypes.NewValue(tftypes.Bool, true),
+		"validate_placeholders": tftypes.NewValue(tftypes.Bool, true),
+		"validate_references":   tftypes.NewValue(tftypes.Bool, true),
+		"max_retries":           tftypes.NewValue(tftypes.Number, 0),
+	})
+
+	if !opts.DeactivateOnDelete || !opts.ValidatePlaceholders || !opts.ValidateReferences {
+		t.Errorf("expected all options set, got %+v", opts)
+	}
+	if opts.MaxRetries == nil || *opts.MaxRetries != 0 {
+		t.Errorf("expected an explicit max_retries of 0, got %v", opts.MaxRetries)
+	}
+}
+
+func TestClientOptionsDefaults(t *testing.T) {
+	opts := configureOptions(t, map[string]tftypes.Value{
+		"subdomain": tftypes.NewValue(tftypes.String, "example"),
+		"email":     tftypes.NewValue(tftypes.String, "agent@example.com"),
+		"api_token": tftypes.NewValue(tftypes.String, "token"),
+	})
+
+	if opts.DeactivateOnDelete || opts.ValidatePlaceholders || opts.ValidateReferences {
+		t.Errorf("expected no options set, got %+v", opts)
+	}
+	if opts.MaxRetries != nil {
+		t.Errorf("expected max_retries unset, got %d", *opts.MaxRetries)
+	}
+}
@@ -1,31 +1,70 @@
-package main
-
-import (
-	"context"
-	"flag"
-	"log"
-
-	"github.com/diogocosta/terraform-provider-zendesk/internal/provider"
-	"github.com/hashicorp/terraform-plugin-framework/providerserver"
-)
-
-var (
-	version string = "dev"
-)
-
-func main() {
-	var debug bool
-
-	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
-	flag.Parse()
-
-	opts := providerserver.ServeOpts{
-		Address: "registry.terraform.io/diogocosta/terraform-provider-zendesk",
-		Debug:   debug,
-	}
-
-	err := providerserver.Serve(context.Background(), provider.New(version), opts)
-	if err != nil {
-		log.Fatal(err.Error())
-	}
-} 
\ No newline at end of file
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"github.com/diogocosta/terraform-provider-zendesk/internal/provider"
+	"github.com/diogocosta/terraform-provider-zendesk/internal/sdkprovider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	version string = "dev"
+)
+
+func main() {
+	var debug bool
+
+	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
+	flag.Parse()
+
+	ctx := context.Background()
+
+	providerServer, err := newProviderServer(ctx, sdkprovider.New(version)())
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	var serveOpts []tf6server.ServeOpt
+	if debug {
+		serveOpts = append(serveOpts, tf6server.WithManagedDebug())
+	}
+
+	err = tf6server.Serve("registry.terraform.io/diogocosta/terraform-provider-zendesk", providerServer, serveOpts...)
+	provider.LogRateLimitSummary()
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+}
+
+// newProviderServer combines the framework provider and the SDK provider into a single provider
+// server, so that resources of both are served under the same provider address.
+func newProviderServer(ctx context.Context, sdkProvider *schema.Provider) (func() tfprotov6.ProviderServer, error) {
+	// The SDK provider speaks protocol 5, so it is upgraded to protocol 6 before being muxed with
+	// the framework provider.
+	sdkServer, err := tf5to6server.UpgradeServer(ctx, sdkProvider.GRPCProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	providers := []func() tfprotov6.ProviderServer{
+		providerserver.NewProtocol6(provider.New(version)()),
+		func() tfprotov6.ProviderServer {
+			return sdkServer
+		},
+	}
+
+	muxServer, err := tf6muxserver.NewMuxServer(ctx, providers...)
+	if err != nil {
+		return nil, err
+	}
+
+	return muxServer.ProviderServer, nil
+}
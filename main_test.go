@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/diogocosta/terraform-provider-zendesk/internal/sdkprovider"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestProviderServerServesBothProviders(t *testing.T) {
+	ctx := context.Background()
+
+	sdkProvider := sdkprovider.New("test")()
+	sdkProvider.ResourcesMap["zendesk_sdk_stub"] = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+
+	providerServer, err := newProviderServer(ctx, sdkProvider)
+	if err != nil {
+		t.Fatalf("newProviderServer: %v", err)
+	}
+
+	resp, err := providerServer().GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema: %v", err)
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Fatalf("unexpected error: %s: %s", d.Summary, d.Detail)
+		}
+	}
+
+	for _, typeName := range []string{"zendesk_trigger", "zendesk_sdk_stub"} {
+		if _, ok := resp.ResourceSchemas[typeName]; !ok {
+			t.Errorf("expected a schema for %s", typeName)
+		}
+	}
+}